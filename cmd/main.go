@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/json"
+	"flag"
 	"fmt"
 	"log"
 	"os"
@@ -10,13 +11,20 @@ import (
 )
 
 func main() {
-	if len(os.Args) < 2 {
-		fmt.Printf("Usage: %s <manifest file>\n", os.Args[0])
+	targetFramework := flag.String("target-framework", "", "the .NET target framework to evaluate MSBuild conditions for, e.g. net48")
+	configuration := flag.String("configuration", "", "the .NET build configuration to evaluate MSBuild conditions for, e.g. Release")
+	flag.Parse()
+	if flag.NArg() < 1 {
+		fmt.Printf("Usage: %s [flags] <manifest file>\n", os.Args[0])
 		os.Exit(1)
 	}
-	manifestFile := os.Args[1]
+	manifestFile := flag.Arg(0)
 
-	p := parser.ParsersFactory(manifestFile)
+	p := parser.ParsersFactory(manifestFile, parser.WithTargetFramework(*targetFramework), parser.WithConfiguration(*configuration))
+	if p == nil {
+		// Executables are recognized by their content rather than their name
+		p = parser.BinaryParsersFactory(manifestFile)
+	}
 	if p == nil {
 		log.Fatalf("Unsupported manifest type: %s", manifestFile)
 	}
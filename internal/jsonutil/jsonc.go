@@ -0,0 +1,55 @@
+package jsonutil
+
+// Standardize converts JSON with comments and trailing commas (JSONC), e.g. deno.jsonc or bun.lock, into standard JSON.
+// Comments and trailing commas are replaced by spaces, so the offsets and lines of all other tokens are kept.
+func Standardize(content []byte) []byte {
+	out := make([]byte, len(content))
+	copy(out, content)
+
+	blank := func(from, to int) {
+		for i := from; i < to; i++ {
+			if out[i] != '\n' && out[i] != '\r' {
+				out[i] = ' '
+			}
+		}
+	}
+
+	// lastComma is the offset of a comma that is not yet followed by a value
+	lastComma := -1
+	for i := 0; i < len(out); i++ {
+		switch c := out[i]; {
+		case c == '"':
+			lastComma = -1
+			for i++; i < len(out) && out[i] != '"'; i++ {
+				if out[i] == '\\' {
+					i++
+				}
+			}
+		case c == '/' && i+1 < len(out) && out[i+1] == '/':
+			end := i
+			for end < len(out) && out[end] != '\n' {
+				end++
+			}
+			blank(i, end)
+			i = end - 1
+		case c == '/' && i+1 < len(out) && out[i+1] == '*':
+			end := i + 2
+			for end+1 < len(out) && !(out[end] == '*' && out[end+1] == '/') {
+				end++
+			}
+			end = min(end+2, len(out))
+			blank(i, end)
+			i = end - 1
+		case c == ',':
+			lastComma = i
+		case c == '}' || c == ']':
+			if lastComma >= 0 {
+				out[lastComma] = ' '
+			}
+			lastComma = -1
+		case c != ' ' && c != '\t' && c != '\r' && c != '\n':
+			lastComma = -1
+		}
+	}
+	return out
+}
@@ -0,0 +1,30 @@
+package jsonutil
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStandardize(t *testing.T) {
+	content := `{
+  // line comment with "quotes"
+  "url": "https://example.com/a,b//c", /* block
+  comment */ "list": [1, 2,],
+  "nested": {"a": "}",},
+}`
+	want := `{
+                               
+  "url": "https://example.com/a,b//c",         
+             "list": [1, 2 ],
+  "nested": {"a": "}" } 
+}`
+
+	got := Standardize([]byte(content))
+	if string(got) != want {
+		t.Errorf("Standardize:\ngot  %q\nwant %q", got, want)
+	}
+	var parsed map[string]interface{}
+	if err := json.Unmarshal(got, &parsed); err != nil {
+		t.Errorf("Standardized content must be valid JSON: %v", err)
+	}
+}
@@ -0,0 +1,148 @@
+// Package jsonutil locates the members of a JSON document.
+// The JSON decoder does not expose positions, so locations are tracked from the byte offsets of its tokens.
+package jsonutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// Locations maps JSON pointers (RFC 6901) of the members of a document to their locations
+type Locations map[string]models.Location
+
+// Pointer builds the JSON pointer of a member from its path, e.g. Pointer("require", "monolog/monolog")
+func Pointer(path ...string) string {
+	var pointer strings.Builder
+	for _, key := range path {
+		pointer.WriteByte('/')
+		key = strings.ReplaceAll(key, "~", "~0")
+		pointer.WriteString(strings.ReplaceAll(key, "/", "~1"))
+	}
+	return pointer.String()
+}
+
+// Find returns the location of a member, e.g. Find("require", "monolog/monolog")
+func (l Locations) Find(path ...string) (models.Location, bool) {
+	location, ok := l[Pointer(path...)]
+	return location, ok
+}
+
+// locator walks the tokens of a document and records the location of every member
+type locator struct {
+	content    []byte
+	decoder    *json.Decoder
+	lineStarts []int
+	locations  Locations
+}
+
+// position converts a byte offset into a 0-based line and column
+func (l *locator) position(offset int) (line, column int) {
+	line = sort.SearchInts(l.lineStarts, offset+1) - 1
+	return line, offset - l.lineStarts[line]
+}
+
+// keyStart finds the opening quote of the key that ends at an offset
+func (l *locator) keyStart(end int) int {
+	for i := end - 2; i >= 0; i-- {
+		if l.content[i] == '"' && (i == 0 || l.content[i-1] != '\\') {
+			return i
+		}
+	}
+	return 0
+}
+
+// valueStart skips the whitespace and comma between the end of the previous token and the start of a value
+func (l *locator) valueStart(offset int) int {
+	for offset < len(l.content) && strings.ContainsRune(" \t\r\n,", rune(l.content[offset])) {
+		offset++
+	}
+	return offset
+}
+
+// record stores the location of a member from the start of its key to the end of its value.
+// Values that end on the same line include a directly following comma, values spanning lines end with the key.
+func (l *locator) record(pointer string, keyStart, keyEnd, valueEnd int) {
+	line, startIndex := l.position(keyStart)
+	endLine, endIndex := l.position(valueEnd)
+	if endLine != line {
+		_, endIndex = l.position(keyEnd)
+	} else if valueEnd < len(l.content) && l.content[valueEnd] == ',' {
+		endIndex++
+	}
+	l.locations[pointer] = models.Location{Line: line, StartIndex: startIndex, EndIndex: endIndex}
+}
+
+// walkValue reads the value that starts with a token, descending into objects and arrays
+func (l *locator) walkValue(token json.Token, pointer string) error {
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return nil
+	}
+
+	index := 0
+	for l.decoder.More() {
+		var memberPointer string
+		keyStart, keyEnd := -1, 0
+		if delim == '{' {
+			key, err := l.decoder.Token()
+			if err != nil {
+				return err
+			}
+			name, _ := key.(string)
+			keyEnd = int(l.decoder.InputOffset())
+			keyStart = l.keyStart(keyEnd)
+			memberPointer = pointer + Pointer(name)
+		} else {
+			// Array elements are located by their value, elements spanning lines by their first character
+			memberPointer = pointer + "/" + strconv.Itoa(index)
+			keyStart = l.valueStart(int(l.decoder.InputOffset()))
+			keyEnd = keyStart + 1
+			index++
+		}
+
+		value, err := l.decoder.Token()
+		if err != nil {
+			return err
+		}
+		if err := l.walkValue(value, memberPointer); err != nil {
+			return err
+		}
+		if keyStart >= 0 {
+			l.record(memberPointer, keyStart, keyEnd, int(l.decoder.InputOffset()))
+		}
+	}
+
+	// The closing delimiter of the object or array
+	_, err := l.decoder.Token()
+	return err
+}
+
+// FindLocations returns the locations of all object members and array elements of a JSON document, keyed by their JSON pointer
+func FindLocations(content []byte) (Locations, error) {
+	l := &locator{
+		content:    content,
+		decoder:    json.NewDecoder(bytes.NewReader(content)),
+		lineStarts: []int{0},
+		locations:  make(Locations),
+	}
+	for i, c := range content {
+		if c == '\n' {
+			l.lineStarts = append(l.lineStarts, i+1)
+		}
+	}
+
+	token, err := l.decoder.Token()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	if err := l.walkValue(token, ""); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	return l.locations, nil
+}
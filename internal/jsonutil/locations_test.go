@@ -0,0 +1,66 @@
+package jsonutil
+
+import (
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestFindLocations(t *testing.T) {
+	content := `{
+  "name": "acme/app",
+  "require": {
+    "php": ">=8.1",
+    "monolog/monolog" : "^3.0"
+  },
+  "list": [{"a~b": 1}, {"c": true}],
+  "names": ["fmt",
+    "zlib"]
+}`
+
+	locations, err := FindLocations([]byte(content))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		path []string
+		want models.Location
+	}{
+		{[]string{"name"}, models.Location{Line: 1, StartIndex: 2, EndIndex: 21}},
+		{[]string{"require"}, models.Location{Line: 2, StartIndex: 2, EndIndex: 11}},
+		{[]string{"require", "php"}, models.Location{Line: 3, StartIndex: 4, EndIndex: 19}},
+		{[]string{"require", "monolog/monolog"}, models.Location{Line: 4, StartIndex: 4, EndIndex: 30}},
+		{[]string{"list"}, models.Location{Line: 6, StartIndex: 2, EndIndex: 36}},
+		{[]string{"list", "0"}, models.Location{Line: 6, StartIndex: 11, EndIndex: 22}},
+		{[]string{"list", "0", "a~b"}, models.Location{Line: 6, StartIndex: 12, EndIndex: 20}},
+		{[]string{"list", "1", "c"}, models.Location{Line: 6, StartIndex: 24, EndIndex: 33}},
+		{[]string{"names", "0"}, models.Location{Line: 7, StartIndex: 12, EndIndex: 18}},
+		{[]string{"names", "1"}, models.Location{Line: 8, StartIndex: 4, EndIndex: 10}},
+	}
+	for _, tt := range tests {
+		got, ok := locations.Find(tt.path...)
+		if !ok {
+			t.Errorf("Find(%v): member not found", tt.path)
+			continue
+		}
+		testdata.CompareLocations(t, []models.Location{got}, []models.Location{tt.want})
+	}
+
+	if _, ok := locations.Find("require", "missing"); ok {
+		t.Errorf("Find of a missing member must fail")
+	}
+}
+
+func TestPointer(t *testing.T) {
+	if got := Pointer("require", "monolog/monolog", "a~b"); got != "/require/monolog~1monolog/a~0b" {
+		t.Errorf("Pointer: got %q", got)
+	}
+}
+
+func TestFindLocations_InvalidJSON(t *testing.T) {
+	if _, err := FindLocations([]byte(`{"a": `)); err == nil {
+		t.Errorf("Expected an error for invalid JSON")
+	}
+}
@@ -0,0 +1,144 @@
+package actions
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Checkmarx/manifest-parser/internal/parsers/docker"
+	"github.com/Checkmarx/manifest-parser/internal/yamlutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// WorkflowParser implements parsing of the actions and reusable workflows used by GitHub Actions workflows (.github/workflows/*.yml)
+type WorkflowParser struct{}
+
+// dockerScheme prefixes the images steps run directly, e.g. docker://alpine:3.19
+const dockerScheme = "docker://"
+
+const (
+	// shaRef is a ref pinned to a commit, the only ref that cannot be moved
+	shaRef = "sha"
+	// tagRef is a ref that names a version, e.g. v4 or v4.1.1
+	tagRef = "tag"
+	// branchRef is any other ref, e.g. main
+	branchRef = "branch"
+)
+
+var (
+	// commitPattern matches full commit SHAs
+	commitPattern = regexp.MustCompile(`^[0-9a-f]{40}$`)
+	// versionPattern matches refs and comments that name a version, e.g. v4, 1.2.3 or v.2.0.36
+	versionPattern = regexp.MustCompile(`^v?\.?\d+(\.\d+)*([-+.][0-9A-Za-z.-]+)?$`)
+)
+
+// refType classifies the ref of an action as pinned to a commit, a tag or a branch.
+// Tags and branches cannot be told apart from the workflow, refs that look like versions are taken for tags.
+func refType(ref string) string {
+	switch {
+	case commitPattern.MatchString(ref):
+		return shaRef
+	case versionPattern.MatchString(ref):
+		return tagRef
+	}
+	return branchRef
+}
+
+// lineComment returns the comment at the end of a line, e.g. the version of a pinned commit: @b4ffde6... # v4.1.1
+func lineComment(doc *yamlutil.Document, line int) string {
+	if line < 0 || line >= len(doc.Lines) {
+		return ""
+	}
+	text := strings.TrimRight(doc.Lines[line], " \t\r")
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(text[len(yamlutil.StripComment(text)):]), "#"))
+}
+
+// actionPackage creates the package of a uses entry: owner/repo@ref, owner/repo/path@ref or docker://image.
+// Actions of the same repository, e.g. ./.github/actions/setup, are not packages.
+func actionPackage(manifestFile string, doc *yamlutil.Document, key, value *yaml.Node, job string) (models.Package, bool) {
+	uses := strings.TrimSpace(value.Value)
+	location := doc.EntryLocation(key, value)
+
+	if image, ok := strings.CutPrefix(uses, dockerScheme); ok {
+		pkg, ok := docker.NewImagePackage(image, manifestFile, location)
+		if !ok {
+			return models.Package{}, false
+		}
+		if pkg.Metadata == nil {
+			pkg.Metadata = make(map[string]string)
+		}
+		pkg.Metadata["job"] = job
+		pkg.Metadata["refType"] = tagRef
+		if pkg.Metadata["digest"] != "" {
+			pkg.Metadata["refType"] = shaRef
+		}
+		return pkg, true
+	}
+
+	action, ref, ok := strings.Cut(uses, "@")
+	parts := strings.SplitN(action, "/", 3)
+	if !ok || ref == "" || len(parts) < 2 || parts[0] == "" || parts[0] == "." || parts[0] == ".." || parts[1] == "" {
+		return models.Package{}, false
+	}
+
+	metadata := map[string]string{"job": job, "refType": refType(ref)}
+	if len(parts) == 3 && parts[2] != "" {
+		// Actions in a directory of their repository, or reusable workflows, e.g. owner/repo/.github/workflows/build.yml
+		metadata["path"] = parts[2]
+	}
+	if comment := lineComment(doc, location.Line); metadata["refType"] == shaRef && comment != "" {
+		if version := strings.Fields(comment)[0]; versionPattern.MatchString(version) {
+			metadata["tag"] = version
+		}
+	}
+
+	return models.Package{
+		PackageManager: "github-actions",
+		PackageName:    parts[0] + "/" + parts[1],
+		Version:        ref,
+		FilePath:       manifestFile,
+		Locations:      []models.Location{location},
+		Metadata:       metadata,
+	}, true
+}
+
+// Parse implements the Parser interface for GitHub Actions workflows
+func (p *WorkflowParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	doc, err := yamlutil.Parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var packages []models.Package
+	addUses := func(node *yaml.Node, job string) {
+		key, value := yamlutil.Lookup(node, "uses")
+		if key == nil || value.Kind != yaml.ScalarNode {
+			return
+		}
+		if pkg, ok := actionPackage(manifestFile, doc, key, value, job); ok {
+			packages = append(packages, pkg)
+		}
+	}
+
+	_, jobs := yamlutil.Lookup(doc.Root, "jobs")
+	for _, job := range yamlutil.Pairs(jobs) {
+		// Jobs either call a reusable workflow or run steps
+		addUses(job.Value, job.Key.Value)
+		_, steps := yamlutil.Lookup(job.Value, "steps")
+		if steps == nil || steps.Kind != yaml.SequenceNode {
+			continue
+		}
+		for _, step := range steps.Content {
+			addUses(step, job.Key.Value)
+		}
+	}
+	return packages, nil
+}
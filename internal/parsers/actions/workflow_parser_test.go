@@ -0,0 +1,93 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestWorkflowParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/workflow.yml"
+
+	packages, err := (&WorkflowParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Local actions are part of the repository and have no package
+	expectedPackages := []models.Package{
+		{PackageManager: "github-actions", PackageName: "acme/shared-workflows", Version: "main", FilePath: manifestFile, Locations: []models.Location{{Line: 8, StartIndex: 4, EndIndex: 63}}},
+		{PackageManager: "github-actions", PackageName: "actions/checkout", Version: "b4ffde65f46336ab88eb53be808477a3936bae11", FilePath: manifestFile, Locations: []models.Location{{Line: 13, StartIndex: 8, EndIndex: 71}}},
+		{PackageManager: "github-actions", PackageName: "actions/setup-go", Version: "v5", FilePath: manifestFile, Locations: []models.Location{{Line: 15, StartIndex: 8, EndIndex: 33}}},
+		{PackageManager: "github-actions", PackageName: "github/codeql-action", Version: "v3.24.0", FilePath: manifestFile, Locations: []models.Location{{Line: 20, StartIndex: 8, EndIndex: 49}}},
+		{PackageManager: "docker", PackageName: "alpine", Version: "3.19", FilePath: manifestFile, Locations: []models.Location{{Line: 22, StartIndex: 8, EndIndex: 34}}},
+		{PackageManager: "github-actions", PackageName: "acme/shared-workflows", Version: "2f1e0d9c8b7a6f5e4d3c2b1a0f9e8d7c6b5a4f3e", FilePath: manifestFile, Locations: []models.Location{{Line: 26, StartIndex: 4, EndIndex: 102}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	wantMetadata := []map[string]string{
+		{"job": "lint", "path": ".github/workflows/lint.yml", "refType": "branch"},
+		{"job": "build", "refType": "sha", "tag": "v4.1.1"},
+		{"job": "build", "refType": "tag"},
+		{"job": "build", "path": "init", "refType": "tag"},
+		{"job": "build", "refType": "tag"},
+		{"job": "release", "path": ".github/workflows/release.yml", "refType": "sha"},
+	}
+	for i, pkg := range packages {
+		if !reflect.DeepEqual(pkg.Metadata, wantMetadata[i]) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, wantMetadata[i])
+		}
+	}
+}
+
+func TestWorkflowParser_DockerDigest(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "scan.yml")
+	content := `jobs:
+  scan:
+    steps:
+      - uses: docker://ghcr.io/acme/scanner@sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08
+      - uses: actions/cache
+`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write scan.yml: %v", err)
+	}
+
+	packages, err := (&WorkflowParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Actions without a ref are invalid and skipped
+	expectedPackages := []models.Package{
+		{PackageManager: "docker", PackageName: "ghcr.io/acme/scanner", Version: "sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", FilePath: manifestFile, Locations: []models.Location{{Line: 3, StartIndex: 8, EndIndex: 115}}},
+	}
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) == 1 && packages[0].Metadata["refType"] != "sha" {
+		t.Errorf("Images pinned by digest must be pinned to a sha, got %v", packages[0].Metadata)
+	}
+}
+
+func TestRefType(t *testing.T) {
+	tests := map[string]string{
+		"b4ffde65f46336ab88eb53be808477a3936bae11": "sha",
+		"v4":           "tag",
+		"v4.1.1":       "tag",
+		"1.0.0-beta.1": "tag",
+		"main":         "branch",
+		"release/v1":   "branch",
+		"b4ffde6":      "branch",
+	}
+	for ref, want := range tests {
+		if got := refType(ref); got != want {
+			t.Errorf("refType(%q) = %q; want %q", ref, got, want)
+		}
+	}
+}
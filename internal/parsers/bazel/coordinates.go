@@ -0,0 +1,63 @@
+package bazel
+
+import (
+	"strings"
+)
+
+// coordinates are the Maven coordinates of an artifact of rules_jvm_external,
+// e.g. group:artifact:version or group:artifact:packaging:classifier:version
+type coordinates struct {
+	group      string
+	artifact   string
+	packaging  string
+	classifier string
+	version    string
+}
+
+// parseCoordinates reads Maven coordinates. Versioned coordinates end with the version unless they only name the artifact,
+// the keys of maven_install.json have no version, e.g. group:artifact:packaging:classifier.
+func parseCoordinates(text string, versioned bool) (coordinates, bool) {
+	parts := strings.Split(strings.TrimSpace(text), ":")
+	if len(parts) < 2 || len(parts) > 5 || parts[0] == "" || parts[1] == "" {
+		return coordinates{}, false
+	}
+
+	coords := coordinates{group: parts[0], artifact: parts[1]}
+	rest := parts[2:]
+	if versioned && len(rest) > 0 {
+		coords.version = rest[len(rest)-1]
+		rest = rest[:len(rest)-1]
+	}
+	if len(rest) > 0 {
+		coords.packaging = rest[0]
+	}
+	if len(rest) > 1 {
+		coords.classifier = rest[1]
+	}
+	return coords, true
+}
+
+// name returns the package name of an artifact, group:artifact
+func (c coordinates) name() string {
+	return c.group + ":" + c.artifact
+}
+
+// metadata describes artifacts that are not the default jar of their version
+func (c coordinates) metadata() map[string]string {
+	metadata := make(map[string]string)
+	if c.packaging != "" && c.packaging != "jar" {
+		metadata["packaging"] = c.packaging
+	}
+	if c.classifier != "" {
+		metadata["classifier"] = c.classifier
+	}
+	return metadata
+}
+
+// exactVersion returns a version unless it is a range, e.g. [2.0,3.0), or a dynamic version, e.g. 1.+ or latest.release
+func exactVersion(version string) string {
+	if version == "" || strings.ContainsAny(version, "[]()+,") || strings.HasPrefix(version, "latest.") {
+		return ""
+	}
+	return version
+}
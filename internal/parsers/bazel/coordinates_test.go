@@ -0,0 +1,42 @@
+package bazel
+
+import (
+	"testing"
+)
+
+func TestParseCoordinates(t *testing.T) {
+	tests := []struct {
+		text      string
+		versioned bool
+		want      coordinates
+		ok        bool
+	}{
+		{"junit:junit:4.13.2", true, coordinates{group: "junit", artifact: "junit", version: "4.13.2"}, true},
+		{"com.google.guava:guava", true, coordinates{group: "com.google.guava", artifact: "guava"}, true},
+		{"io.netty:netty-transport-native-epoll:jar:linux-x86_64:4.1.100.Final", true, coordinates{group: "io.netty", artifact: "netty-transport-native-epoll", packaging: "jar", classifier: "linux-x86_64", version: "4.1.100.Final"}, true},
+		{"androidx.core:core:aar:1.12.0", true, coordinates{group: "androidx.core", artifact: "core", packaging: "aar", version: "1.12.0"}, true},
+		{"io.netty:netty-transport-native-epoll:jar:linux-x86_64", false, coordinates{group: "io.netty", artifact: "netty-transport-native-epoll", packaging: "jar", classifier: "linux-x86_64"}, true},
+		{"guava", true, coordinates{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseCoordinates(tt.text, tt.versioned)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("parseCoordinates(%q, %v) = %+v, %v; want %+v, %v", tt.text, tt.versioned, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestExactVersion(t *testing.T) {
+	tests := map[string]string{
+		"4.13.2":         "4.13.2",
+		"[2.0,3.0)":      "",
+		"2.+":            "",
+		"latest.release": "",
+		"":               "",
+	}
+	for version, want := range tests {
+		if got := exactVersion(version); got != want {
+			t.Errorf("exactVersion(%q) = %q; want %q", version, got, want)
+		}
+	}
+}
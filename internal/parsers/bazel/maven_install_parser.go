@@ -0,0 +1,213 @@
+package bazel
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/jsonutil"
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// MavenInstallParser implements parsing of rules_jvm_external pin files (maven_install.json)
+type MavenInstallParser struct{}
+
+// MavenInstallJson is the default name of rules_jvm_external pin files
+const MavenInstallJson = "maven_install.json"
+
+// mavenCentral are the URLs of Maven Central, which artifacts are fetched from by default
+var mavenCentral = map[string]bool{
+	"https://repo1.maven.org/maven2":       true,
+	"https://repo.maven.apache.org/maven2": true,
+}
+
+// mavenInstall represents both formats of maven_install.json: version 2 maps artifact keys to their version and
+// shasums, older pin files list the resolved coordinates in a dependency tree
+type mavenInstall struct {
+	Artifacts map[string]struct {
+		Shasums map[string]*string `json:"shasums"`
+		Version string             `json:"version"`
+	} `json:"artifacts"`
+	Dependencies   map[string][]string `json:"dependencies"`
+	Repositories   map[string][]string `json:"repositories"`
+	DependencyTree *struct {
+		Dependencies []struct {
+			Coord              string   `json:"coord"`
+			Sha256             string   `json:"sha256"`
+			URL                string   `json:"url"`
+			DirectDependencies []string `json:"directDependencies"`
+		} `json:"dependencies"`
+	} `json:"dependency_tree"`
+}
+
+// pinnedArtifact is a resolved artifact of a pin file
+type pinnedArtifact struct {
+	coords       coordinates
+	hashes       []string
+	dependencies []string
+	repository   string
+	locations    []models.Location
+}
+
+// dependencyNames converts the coordinates an artifact depends on into sorted, unique package names
+func dependencyNames(dependencies []string, versioned bool) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, dependency := range dependencies {
+		coords, ok := parseCoordinates(dependency, versioned)
+		if !ok || seen[coords.name()] {
+			continue
+		}
+		seen[coords.name()] = true
+		names = append(names, coords.name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// readMavenInstall reads the artifacts of a pin file in the order of the file
+func readMavenInstall(pinFile string) ([]pinnedArtifact, error) {
+	content, err := os.ReadFile(pinFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	var pins mavenInstall
+	if err := json.Unmarshal(content, &pins); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	locations, err := jsonutil.FindLocations(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var artifacts []pinnedArtifact
+	if pins.DependencyTree != nil {
+		for i, resolved := range pins.DependencyTree.Dependencies {
+			coords, ok := parseCoordinates(resolved.Coord, true)
+			if !ok {
+				continue
+			}
+			artifact := pinnedArtifact{coords: coords, dependencies: dependencyNames(resolved.DirectDependencies, true)}
+			artifact.hashes = pkgutil.HexSRIHash("sha256", resolved.Sha256)
+			if location, ok := locations.Find("dependency_tree", "dependencies", strconv.Itoa(i), "coord"); ok {
+				artifact.locations = []models.Location{location}
+			}
+			artifacts = append(artifacts, artifact)
+		}
+		return artifacts, nil
+	}
+
+	// Repositories list the artifacts they serve by their keys
+	repositories := make(map[string]string)
+	for url, keys := range pins.Repositories {
+		for _, key := range keys {
+			if current, ok := repositories[key]; !ok || url < current {
+				repositories[key] = url
+			}
+		}
+	}
+
+	for key, pinned := range pins.Artifacts {
+		coords, ok := parseCoordinates(key, false)
+		if !ok {
+			continue
+		}
+		coords.version = pinned.Version
+
+		artifact := pinnedArtifact{coords: coords, dependencies: dependencyNames(pins.Dependencies[key], false)}
+		// Classified variants of an artifact are pinned by the shasums of their classifier
+		var classifiers []string
+		for classifier, shasum := range pinned.Shasums {
+			if shasum != nil {
+				classifiers = append(classifiers, classifier)
+			}
+		}
+		sort.Strings(classifiers)
+		for _, classifier := range classifiers {
+			artifact.hashes = append(artifact.hashes, pkgutil.HexSRIHash("sha256", *pinned.Shasums[classifier])...)
+		}
+
+		artifact.repository = repositories[key]
+		if artifact.repository == "" {
+			for served, url := range repositories {
+				if servedCoords, ok := parseCoordinates(served, false); ok && servedCoords.name() == coords.name() &&
+					(artifact.repository == "" || url < artifact.repository) {
+					artifact.repository = url
+				}
+			}
+		}
+
+		for _, path := range [][]string{{"artifacts", key}, {"artifacts", key, "version"}} {
+			if location, ok := locations.Find(path...); ok {
+				artifact.locations = append(artifact.locations, location)
+			}
+		}
+		artifacts = append(artifacts, artifact)
+	}
+	sort.SliceStable(artifacts, func(i, j int) bool {
+		return pkgutil.FirstLine(artifacts[i].locations) < pkgutil.FirstLine(artifacts[j].locations)
+	})
+	return artifacts, nil
+}
+
+// loadDeclaredArtifacts reads the MODULE.bazel next to a pin file and collects the names of the artifacts it declares
+func loadDeclaredArtifacts(pinFile string) map[string]bool {
+	moduleFile := filepath.Join(filepath.Dir(pinFile), ModuleBazel)
+	content, err := os.ReadFile(moduleFile)
+	if err != nil {
+		return nil
+	}
+
+	calls, err := parseCalls(string(content))
+	if err != nil {
+		log.Printf("Failed to read the artifacts declared in %s: %v", moduleFile, err)
+		return nil
+	}
+
+	declared := make(map[string]bool)
+	for _, artifact := range readMavenArtifacts(calls) {
+		declared[artifact.coords.name()] = true
+	}
+	return declared
+}
+
+// Parse implements the Parser interface for maven_install.json files
+func (p *MavenInstallParser) Parse(manifestFile string) ([]models.Package, error) {
+	artifacts, err := readMavenInstall(manifestFile)
+	if err != nil {
+		return nil, err
+	}
+
+	declared := loadDeclaredArtifacts(manifestFile)
+
+	var packages []models.Package
+	for _, artifact := range artifacts {
+		metadata := artifact.coords.metadata()
+		if artifact.repository != "" && !mavenCentral[strings.TrimSuffix(artifact.repository, "/")] {
+			metadata["registry"] = artifact.repository
+		}
+		if len(metadata) == 0 {
+			metadata = nil
+		}
+
+		packages = append(packages, models.Package{
+			PackageManager: "mvn",
+			PackageName:    artifact.coords.name(),
+			Version:        artifact.coords.version,
+			FilePath:       manifestFile,
+			Locations:      artifact.locations,
+			Transitive:     declared != nil && !declared[artifact.coords.name()],
+			Hashes:         artifact.hashes,
+			Dependencies:   artifact.dependencies,
+			Metadata:       metadata,
+		})
+	}
+	return packages, nil
+}
@@ -0,0 +1,112 @@
+package bazel
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestMavenInstallParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/maven_install.json"
+
+	packages, err := (&MavenInstallParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "mvn", PackageName: "com.fasterxml.jackson.core:jackson-databind", Version: "2.16.0", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 4, EndIndex: 49}, {Line: 9, StartIndex: 6, EndIndex: 25}}},
+		{PackageManager: "mvn", PackageName: "com.google.guava:failureaccess", Version: "1.0.1", FilePath: manifestFile, Locations: []models.Location{{Line: 11, StartIndex: 4, EndIndex: 36}, {Line: 15, StartIndex: 6, EndIndex: 24}}},
+		{PackageManager: "mvn", PackageName: "com.google.guava:guava", Version: "32.1.3-jre", FilePath: manifestFile, Locations: []models.Location{{Line: 17, StartIndex: 4, EndIndex: 28}, {Line: 21, StartIndex: 6, EndIndex: 29}}},
+		{PackageManager: "mvn", PackageName: "io.netty:netty-transport-native-epoll", Version: "4.1.100.Final", FilePath: manifestFile, Locations: []models.Location{{Line: 23, StartIndex: 4, EndIndex: 43}, {Line: 28, StartIndex: 6, EndIndex: 32}}},
+		{PackageManager: "mvn", PackageName: "junit:junit", Version: "4.13.2", FilePath: manifestFile, Locations: []models.Location{{Line: 30, StartIndex: 4, EndIndex: 17}, {Line: 34, StartIndex: 6, EndIndex: 25}}},
+		{PackageManager: "mvn", PackageName: "org.hamcrest:hamcrest-core", Version: "1.3", FilePath: manifestFile, Locations: []models.Location{{Line: 36, StartIndex: 4, EndIndex: 32}, {Line: 41, StartIndex: 6, EndIndex: 22}}},
+		{PackageManager: "mvn", PackageName: "org.slf4j:slf4j-api", Version: "2.0.9", FilePath: manifestFile, Locations: []models.Location{{Line: 43, StartIndex: 4, EndIndex: 25}, {Line: 47, StartIndex: 6, EndIndex: 24}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	// Artifacts not declared in MODULE.bazel are transitive
+	for i, want := range []bool{false, true, false, false, false, true, false} {
+		if packages[i].Transitive != want {
+			t.Errorf("%s Transitive: got %v, want %v", packages[i].PackageName, packages[i].Transitive, want)
+		}
+	}
+
+	if want := []string{"com.google.guava:failureaccess"}; !reflect.DeepEqual(packages[2].Dependencies, want) {
+		t.Errorf("guava Dependencies: got %v, want %v", packages[2].Dependencies, want)
+	}
+	if want := map[string]string{"registry": "https://maven.example.com/releases/"}; !reflect.DeepEqual(packages[0].Metadata, want) {
+		t.Errorf("jackson-databind Metadata: got %v, want %v", packages[0].Metadata, want)
+	}
+	if packages[2].Metadata != nil {
+		t.Errorf("guava Metadata: got %v, want nil", packages[2].Metadata)
+	}
+	if want := []string{"sha256-DicVDLH8EMg2blkkFgt9PDa8BF7fYUmJtRNBNr1xkYg="}; !reflect.DeepEqual(packages[2].Hashes, want) {
+		t.Errorf("guava Hashes: got %v, want %v", packages[2].Hashes, want)
+	}
+	// Classified variants add their own shasums, unavailable ones are null
+	if len(packages[3].Hashes) != 2 || len(packages[5].Hashes) != 1 {
+		t.Errorf("Unexpected hashes: netty %v, hamcrest %v", packages[3].Hashes, packages[5].Hashes)
+	}
+}
+
+func TestMavenInstallParser_Parse_DependencyTree(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), MavenInstallJson)
+	content := `{
+  "dependency_tree": {
+    "__AUTOGENERATED_FILE_DO_NOT_MODIFY_THIS_FILE_MANUALLY": 1,
+    "dependencies": [
+      {
+        "coord": "junit:junit:4.13.2",
+        "dependencies": ["org.hamcrest:hamcrest-core:1.3"],
+        "directDependencies": ["org.hamcrest:hamcrest-core:1.3"],
+        "sha256": "8e495b634469d64fb8acfa3495a065cbacc8a0fff55ce1e31007be4c16dc57d3",
+        "url": "https://repo1.maven.org/maven2/junit/junit/4.13.2/junit-4.13.2.jar"
+      },
+      {
+        "coord": "org.hamcrest:hamcrest-core:1.3",
+        "directDependencies": [],
+        "sha256": "66fdef91e9739348df7a096aa384a5685f4e875584cce89386a7a47251c4d8e9"
+      }
+    ],
+    "version": "0.1.0"
+  }
+}
+`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+
+	packages, err := (&MavenInstallParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "mvn", PackageName: "junit:junit", Version: "4.13.2", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 8, EndIndex: 38}}},
+		{PackageManager: "mvn", PackageName: "org.hamcrest:hamcrest-core", Version: "1.3", FilePath: manifestFile, Locations: []models.Location{{Line: 12, StartIndex: 8, EndIndex: 50}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	if want := []string{"org.hamcrest:hamcrest-core"}; !reflect.DeepEqual(packages[0].Dependencies, want) {
+		t.Errorf("junit Dependencies: got %v, want %v", packages[0].Dependencies, want)
+	}
+	if want := []string{"sha256-jklbY0Rp1k+4rPo0laBly6zIoP/1XOHjEAe+TBbcV9M="}; !reflect.DeepEqual(packages[0].Hashes, want) {
+		t.Errorf("junit Hashes: got %v, want %v", packages[0].Hashes, want)
+	}
+	if packages[0].Transitive || packages[1].Transitive {
+		t.Errorf("Packages must not be transitive without MODULE.bazel")
+	}
+}
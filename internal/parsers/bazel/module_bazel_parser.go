@@ -0,0 +1,323 @@
+package bazel
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// ModuleBazelParser implements parsing of Bazel module files (MODULE.bazel): bazel_dep modules and
+// the Maven artifacts installed with rules_jvm_external
+type ModuleBazelParser struct{}
+
+// ModuleBazel is the file name of Bazel module files
+const ModuleBazel = "MODULE.bazel"
+
+// devDependency is the scope of modules and extensions used with dev_dependency = True
+const devDependency = "dev_dependency"
+
+// defaultInstall is the name of the repository rules_jvm_external installs artifacts into by default
+const defaultInstall = "maven"
+
+// mavenArtifact is an artifact declared with maven.install(artifacts = [...]) or maven.artifact(...)
+type mavenArtifact struct {
+	coords   coordinates
+	install  string
+	dev      bool
+	location models.Location
+}
+
+// callLocation returns the location of a call
+func callLocation(call *starlarkCall) models.Location {
+	return models.Location{Line: call.line, StartIndex: call.start, EndIndex: call.end}
+}
+
+// argumentLocation returns the location of an argument
+func argumentLocation(arg *starlarkArgument) models.Location {
+	return models.Location{Line: arg.line, StartIndex: arg.start, EndIndex: arg.end}
+}
+
+// mavenExtensions maps the names of rules_jvm_external maven extensions, e.g.
+// maven = use_extension("@rules_jvm_external//:extensions.bzl", "maven"), to whether they are dev dependencies
+func mavenExtensions(calls []*starlarkCall) map[string]bool {
+	extensions := make(map[string]bool)
+	for _, call := range calls {
+		if call.function == "use_extension" && call.target != "" &&
+			strings.Contains(call.positional(0), "rules_jvm_external") && call.positional(1) == "maven" {
+			extensions[call.target] = call.isTrue(devDependency)
+		}
+	}
+	return extensions
+}
+
+// extensionTag splits a tag of a maven extension, e.g. maven.install, into the extension and the tag name
+func extensionTag(call *starlarkCall, extensions map[string]bool) (string, bool, bool) {
+	extension, tag, ok := strings.Cut(call.function, ".")
+	if !ok {
+		return "", false, false
+	}
+	dev, ok := extensions[extension]
+	return tag, dev, ok
+}
+
+// installName returns the repository an install or artifact tag adds its artifacts to
+func installName(call *starlarkCall) string {
+	if name := call.stringArgument("name"); name != "" {
+		return name
+	}
+	return defaultInstall
+}
+
+// readMavenArtifacts reads the artifacts of the install and artifact tags of maven extensions
+func readMavenArtifacts(calls []*starlarkCall) []mavenArtifact {
+	extensions := mavenExtensions(calls)
+
+	var artifacts []mavenArtifact
+	for _, call := range calls {
+		tag, dev, ok := extensionTag(call, extensions)
+		if !ok {
+			continue
+		}
+		switch tag {
+		case "install":
+			arg := call.argument("artifacts")
+			if arg == nil {
+				continue
+			}
+			for _, item := range arg.value.items {
+				if item.kind != stringValue {
+					continue
+				}
+				if coords, ok := parseCoordinates(item.text, true); ok {
+					artifacts = append(artifacts, mavenArtifact{
+						coords:   coords,
+						install:  installName(call),
+						dev:      dev,
+						location: models.Location{Line: item.line, StartIndex: item.start, EndIndex: item.end},
+					})
+				}
+			}
+		case "artifact":
+			coords := coordinates{
+				group:      call.stringArgument("group"),
+				artifact:   call.stringArgument("artifact"),
+				packaging:  call.stringArgument("packaging"),
+				classifier: call.stringArgument("classifier"),
+				version:    call.stringArgument("version"),
+			}
+			if coords.group != "" && coords.artifact != "" {
+				artifacts = append(artifacts, mavenArtifact{coords: coords, install: installName(call), dev: dev, location: callLocation(call)})
+			}
+		}
+	}
+	return artifacts
+}
+
+// labelPath resolves a label of the main repository, e.g. //:maven_install.json or //third_party:maven_install.json,
+// into a path relative to the directory of MODULE.bazel
+func labelPath(moduleDir, label string) (string, bool) {
+	label = strings.TrimPrefix(label, "@")
+	rest, ok := strings.CutPrefix(label, "//")
+	if !ok {
+		return "", false
+	}
+	pkg, target, found := strings.Cut(rest, ":")
+	if !found {
+		target = filepath.Base(pkg)
+	}
+	return filepath.Join(moduleDir, filepath.FromSlash(pkg), filepath.FromSlash(target)), true
+}
+
+// loadPinnedVersions reads the pin files of the install tags and maps the repositories they are installed into
+// to the pinned versions of their artifacts
+func loadPinnedVersions(manifestFile string, calls []*starlarkCall) map[string]map[string]string {
+	extensions := mavenExtensions(calls)
+
+	pinned := make(map[string]map[string]string)
+	for _, call := range calls {
+		if tag, _, ok := extensionTag(call, extensions); !ok || tag != "install" {
+			continue
+		}
+		pinFile, ok := labelPath(filepath.Dir(manifestFile), call.stringArgument("lock_file"))
+		if !ok {
+			continue
+		}
+		artifacts, err := readMavenInstall(pinFile)
+		if err != nil {
+			continue
+		}
+
+		versions := pinned[installName(call)]
+		if versions == nil {
+			versions = make(map[string]string)
+			pinned[installName(call)] = versions
+		}
+		for _, artifact := range artifacts {
+			versions[artifact.coords.name()] = artifact.coords.version
+		}
+	}
+	return pinned
+}
+
+// overrideMetadata describes where an overridden module comes from and returns the version it pins
+// and the hashes of its archive
+func overrideMetadata(override *starlarkCall, metadata map[string]string) (string, []string) {
+	var hashes []string
+	switch override.function {
+	case "single_version_override":
+		if registry := override.stringArgument("registry"); registry != "" {
+			metadata["registry"] = registry
+		}
+		return override.stringArgument("version"), nil
+	case "git_override":
+		metadata["source"] = "git"
+		metadata["git"] = override.stringArgument("remote")
+		if commit := override.stringArgument("commit"); commit != "" {
+			metadata["rev"] = commit
+		}
+		for _, ref := range []string{"tag", "branch"} {
+			if value := override.stringArgument(ref); value != "" {
+				metadata["ref"] = value
+			}
+		}
+	case "local_path_override":
+		metadata["source"] = "path"
+		metadata["path"] = override.stringArgument("path")
+	case "archive_override":
+		metadata["source"] = "archive"
+		url := override.stringArgument("url")
+		if arg := override.argument("urls"); url == "" && arg != nil && len(arg.value.items) > 0 {
+			url = arg.value.items[0].text
+		}
+		metadata["url"] = url
+		// Integrity is a subresource integrity hash of the archive
+		if integrity := override.stringArgument("integrity"); integrity != "" {
+			hashes = []string{integrity}
+		}
+	}
+	return "", hashes
+}
+
+// modulePackage reads a bazel_dep, e.g. bazel_dep(name = "rules_go", version = "0.44.0", dev_dependency = True),
+// together with an override of the module
+func modulePackage(manifestFile string, call *starlarkCall, overrides map[string]*starlarkCall) (models.Package, bool) {
+	name := call.stringArgument("name")
+	if name == "" {
+		return models.Package{}, false
+	}
+
+	locations := []models.Location{callLocation(call)}
+	if version := call.argument("version"); version != nil && version.line != call.line {
+		locations = append(locations, argumentLocation(version))
+	}
+
+	metadata := make(map[string]string)
+	if repoName := call.stringArgument("repo_name"); repoName != "" && repoName != name {
+		metadata["alias"] = repoName
+	}
+
+	version := call.stringArgument("version")
+	var hashes []string
+	if override, ok := overrides[name]; ok {
+		var overrideVersion string
+		overrideVersion, hashes = overrideMetadata(override, metadata)
+		if overrideVersion != "" {
+			version = overrideVersion
+		}
+		locations = append(locations, callLocation(override))
+	}
+	if version == "" {
+		version = "latest"
+	}
+	if len(metadata) == 0 {
+		metadata = nil
+	}
+
+	var scopes []string
+	if call.isTrue(devDependency) {
+		scopes = []string{devDependency}
+	}
+
+	return models.Package{
+		PackageManager: "bazel",
+		PackageName:    name,
+		Version:        version,
+		FilePath:       manifestFile,
+		Locations:      locations,
+		Scopes:         scopes,
+		Hashes:         hashes,
+		Metadata:       metadata,
+	}, true
+}
+
+// artifactPackage converts a declared Maven artifact into a package. Ranges and artifacts without a version
+// are resolved by the pin file of their repository.
+func artifactPackage(manifestFile string, artifact mavenArtifact, pinned map[string]map[string]string) models.Package {
+	version := exactVersion(artifact.coords.version)
+	if pinnedVersion, ok := pinned[artifact.install][artifact.coords.name()]; ok && version == "" {
+		version = pinnedVersion
+	}
+	if version == "" {
+		version = "latest"
+	}
+
+	metadata := artifact.coords.metadata()
+	if len(metadata) == 0 {
+		metadata = nil
+	}
+
+	var scopes []string
+	if artifact.dev {
+		scopes = []string{devDependency}
+	}
+
+	return models.Package{
+		PackageManager: "mvn",
+		PackageName:    artifact.coords.name(),
+		Version:        version,
+		FilePath:       manifestFile,
+		Locations:      []models.Location{artifact.location},
+		Scopes:         scopes,
+		Metadata:       metadata,
+	}
+}
+
+// Parse implements the Parser interface for MODULE.bazel files
+func (p *ModuleBazelParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	calls, err := parseCalls(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Starlark: %w", err)
+	}
+
+	// Overrides may follow the bazel_dep of the module they apply to
+	overrides := make(map[string]*starlarkCall)
+	for _, call := range calls {
+		if strings.HasSuffix(call.function, "_override") && call.stringArgument("module_name") != "" {
+			overrides[call.stringArgument("module_name")] = call
+		}
+	}
+
+	var packages []models.Package
+	for _, call := range calls {
+		if call.function != "bazel_dep" {
+			continue
+		}
+		if pkg, ok := modulePackage(manifestFile, call, overrides); ok {
+			packages = append(packages, pkg)
+		}
+	}
+
+	pinned := loadPinnedVersions(manifestFile, calls)
+	for _, artifact := range readMavenArtifacts(calls) {
+		packages = append(packages, artifactPackage(manifestFile, artifact, pinned))
+	}
+	return packages, nil
+}
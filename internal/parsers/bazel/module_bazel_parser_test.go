@@ -0,0 +1,134 @@
+package bazel
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestModuleBazelParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/MODULE.bazel"
+
+	packages, err := (&ModuleBazelParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "bazel", PackageName: "rules_java", Version: "7.3.2", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 0, EndIndex: 49}}},
+		{PackageManager: "bazel", PackageName: "rules_jvm_external", Version: "6.0", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 0, EndIndex: 55}}},
+		{PackageManager: "bazel", PackageName: "protobuf", Version: "21.7", FilePath: manifestFile, Locations: []models.Location{{Line: 7, StartIndex: 0, EndIndex: 10}, {Line: 9, StartIndex: 4, EndIndex: 20}}},
+		{PackageManager: "bazel", PackageName: "googletest", Version: "1.14.0", FilePath: manifestFile, Locations: []models.Location{{Line: 12, StartIndex: 0, EndIndex: 73}}},
+		{PackageManager: "bazel", PackageName: "rules_go", Version: "0.44.0", FilePath: manifestFile, Locations: []models.Location{{Line: 13, StartIndex: 0, EndIndex: 28}, {Line: 15, StartIndex: 0, EndIndex: 24}}},
+		{PackageManager: "bazel", PackageName: "abseil-cpp", Version: "20230802.0", FilePath: manifestFile, Locations: []models.Location{{Line: 20, StartIndex: 0, EndIndex: 54}, {Line: 21, StartIndex: 0, EndIndex: 13}}},
+		{PackageManager: "mvn", PackageName: "com.google.guava:guava", Version: "32.1.3-jre", FilePath: manifestFile, Locations: []models.Location{{Line: 30, StartIndex: 8, EndIndex: 43}}},
+		{PackageManager: "mvn", PackageName: "io.netty:netty-transport-native-epoll", Version: "4.1.100.Final", FilePath: manifestFile, Locations: []models.Location{{Line: 31, StartIndex: 8, EndIndex: 78}}},
+		{PackageManager: "mvn", PackageName: "org.slf4j:slf4j-api", Version: "2.0.9", FilePath: manifestFile, Locations: []models.Location{{Line: 32, StartIndex: 8, EndIndex: 39}}},
+		{PackageManager: "mvn", PackageName: "com.fasterxml.jackson.core:jackson-databind", Version: "2.16.0", FilePath: manifestFile, Locations: []models.Location{{Line: 40, StartIndex: 0, EndIndex: 15}}},
+		{PackageManager: "mvn", PackageName: "junit:junit", Version: "4.13.2", FilePath: manifestFile, Locations: []models.Location{{Line: 48, StartIndex: 32, EndIndex: 52}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	expectedMetadata := map[int]map[string]string{
+		2: {"alias": "com_google_protobuf"},
+		5: {"source": "git", "git": "https://github.com/abseil/abseil-cpp.git", "rev": "fb3621f4f897824c0dbe0615fa94543df6192f30"},
+		7: {"classifier": "linux-x86_64"},
+	}
+	for i, pkg := range packages {
+		if want := expectedMetadata[i]; !reflect.DeepEqual(pkg.Metadata, want) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, want)
+		}
+	}
+
+	for _, i := range []int{3, 10} {
+		if want := []string{"dev_dependency"}; !reflect.DeepEqual(packages[i].Scopes, want) {
+			t.Errorf("%s Scopes: got %v, want %v", packages[i].PackageName, packages[i].Scopes, want)
+		}
+	}
+}
+
+func TestModuleBazelParser_Parse_Overrides(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), ModuleBazel)
+	content := `bazel_dep(name = "my_lib")
+local_path_override(module_name = "my_lib", path = "../my_lib")
+
+bazel_dep(name = "zlib", version = "1.3")
+archive_override(
+    module_name = "zlib",
+    urls = ["https://example.com/zlib-1.3.tar.gz"],
+    integrity = "sha256-/wUkDqL5KMbXn43mCVvwa+m9+3PqJd5dhkrAvFWx7jM=",
+)
+
+ext = use_extension("@rules_jvm_external//:extensions.bzl", "maven")
+ext.install(artifacts = ["com.google.guava:guava", "org.slf4j:slf4j-api:2.+"])
+`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+
+	packages, err := (&ModuleBazelParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "bazel", PackageName: "my_lib", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 0, StartIndex: 0, EndIndex: 26}, {Line: 1, StartIndex: 0, EndIndex: 63}}},
+		{PackageManager: "bazel", PackageName: "zlib", Version: "1.3", FilePath: manifestFile, Locations: []models.Location{{Line: 3, StartIndex: 0, EndIndex: 41}, {Line: 4, StartIndex: 0, EndIndex: 17}}},
+		{PackageManager: "mvn", PackageName: "com.google.guava:guava", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 11, StartIndex: 25, EndIndex: 49}}},
+		{PackageManager: "mvn", PackageName: "org.slf4j:slf4j-api", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 11, StartIndex: 51, EndIndex: 76}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	if want := map[string]string{"source": "path", "path": "../my_lib"}; !reflect.DeepEqual(packages[0].Metadata, want) {
+		t.Errorf("my_lib Metadata: got %v, want %v", packages[0].Metadata, want)
+	}
+	if want := map[string]string{"source": "archive", "url": "https://example.com/zlib-1.3.tar.gz"}; !reflect.DeepEqual(packages[1].Metadata, want) {
+		t.Errorf("zlib Metadata: got %v, want %v", packages[1].Metadata, want)
+	}
+	if want := []string{"sha256-/wUkDqL5KMbXn43mCVvwa+m9+3PqJd5dhkrAvFWx7jM="}; !reflect.DeepEqual(packages[1].Hashes, want) {
+		t.Errorf("zlib Hashes: got %v, want %v", packages[1].Hashes, want)
+	}
+}
+
+func TestModuleBazelParser_Parse_Truncated(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), ModuleBazel)
+	content := `bazel_dep(name = "rules_go", version = "0.44.0")
+bazel_dep(name = "a", version = `
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write MODULE.bazel: %v", err)
+	}
+
+	if _, err := (&ModuleBazelParser{}).Parse(manifestFile); err == nil {
+		t.Error("Expected error but got none")
+	}
+}
+
+func TestLabelPath(t *testing.T) {
+	tests := []struct {
+		label string
+		want  string
+		ok    bool
+	}{
+		{"//:maven_install.json", filepath.Join("repo", "maven_install.json"), true},
+		{"@//third_party:maven_install.json", filepath.Join("repo", "third_party", "maven_install.json"), true},
+		{"maven_install.json", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := labelPath("repo", tt.label)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("labelPath(%q) = %q, %v; want %q, %v", tt.label, got, ok, tt.want, tt.ok)
+		}
+	}
+}
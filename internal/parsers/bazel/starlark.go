@@ -0,0 +1,352 @@
+package bazel
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MODULE.bazel files are written in a restricted dialect of Starlark without control flow: every statement is a call,
+// e.g. bazel_dep(name = "rules_go", version = "0.44.0"), or the assignment of a call to a name,
+// e.g. maven = use_extension(...). Only these statements are read; string, list and keyword arguments keep their positions.
+
+// tokenKind is the kind of a Starlark token
+type tokenKind int
+
+const (
+	identToken tokenKind = iota
+	stringToken
+	punctToken
+	otherToken
+)
+
+// starlarkToken is a token of a Starlark file, located by its 0-based line and columns
+type starlarkToken struct {
+	kind  tokenKind
+	text  string
+	line  int
+	start int
+	end   int
+}
+
+// valueKind is the kind of an argument value
+type valueKind int
+
+const (
+	otherValue valueKind = iota
+	stringValue
+	listValue
+)
+
+// starlarkValue is an argument value: a string, a list or any other expression, e.g. True or a call
+type starlarkValue struct {
+	kind  valueKind
+	text  string
+	items []*starlarkValue
+	line  int
+	start int
+	end   int
+}
+
+// starlarkArgument is a keyword or positional argument of a call; positional arguments have no name
+type starlarkArgument struct {
+	name  string
+	value *starlarkValue
+	line  int
+	start int
+	end   int
+}
+
+// starlarkCall is a call statement, e.g. bazel_dep(...) or maven.install(...), with the name it is assigned to
+type starlarkCall struct {
+	function string
+	target   string
+	args     []*starlarkArgument
+	line     int
+	start    int
+	end      int
+}
+
+// argument returns a keyword argument of a call, or nil if it is not passed
+func (c *starlarkCall) argument(name string) *starlarkArgument {
+	for _, arg := range c.args {
+		if arg.name == name {
+			return arg
+		}
+	}
+	return nil
+}
+
+// stringArgument returns the value of a string keyword argument, or "" if it is missing or not a string
+func (c *starlarkCall) stringArgument(name string) string {
+	if arg := c.argument(name); arg != nil && arg.value.kind == stringValue {
+		return arg.value.text
+	}
+	return ""
+}
+
+// positional returns the string value of a positional argument, or "" if it is missing or not a string
+func (c *starlarkCall) positional(index int) string {
+	for _, arg := range c.args {
+		if arg.name != "" {
+			continue
+		}
+		if index == 0 {
+			if arg.value.kind == stringValue {
+				return arg.value.text
+			}
+			return ""
+		}
+		index--
+	}
+	return ""
+}
+
+// isTrue reports whether a keyword argument is passed as True
+func (c *starlarkCall) isTrue(name string) bool {
+	arg := c.argument(name)
+	return arg != nil && arg.value.kind == otherValue && arg.value.text == "True"
+}
+
+// isIdentByte reports whether a byte is part of an identifier; dots join attribute accesses such as maven.install
+func isIdentByte(b byte) bool {
+	return b == '_' || b == '.' || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9')
+}
+
+// tokenize splits Starlark content into tokens, dropping whitespace and comments
+func tokenize(content string) []starlarkToken {
+	var tokens []starlarkToken
+	lines := strings.Split(content, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		for col := 0; col < len(line); {
+			c := line[col]
+			switch {
+			case c == ' ' || c == '\t' || c == '\r' || c == '\\':
+				col++
+			case c == '#':
+				col = len(line)
+			case c == '"' || c == '\'':
+				token, endLine, endCol := readString(lines, i, col, col)
+				tokens = append(tokens, token)
+				i, line, col = endLine, lines[endLine], endCol
+			case isIdentByte(c):
+				end := col
+				for end < len(line) && isIdentByte(line[end]) {
+					end++
+				}
+				// String prefixes, e.g. r"..." for raw strings
+				if prefix := strings.ToLower(line[col:end]); end < len(line) && (line[end] == '"' || line[end] == '\'') &&
+					(prefix == "r" || prefix == "b" || prefix == "rb" || prefix == "br") {
+					token, endLine, endCol := readString(lines, i, end, col)
+					tokens = append(tokens, token)
+					i, line, col = endLine, lines[endLine], endCol
+					continue
+				}
+				tokens = append(tokens, starlarkToken{kind: identToken, text: line[col:end], line: i, start: col, end: end})
+				col = end
+			case strings.IndexByte("()[]{},=:", c) >= 0:
+				tokens = append(tokens, starlarkToken{kind: punctToken, text: line[col : col+1], line: i, start: col, end: col + 1})
+				col++
+			default:
+				tokens = append(tokens, starlarkToken{kind: otherToken, text: line[col : col+1], line: i, start: col, end: col + 1})
+				col++
+			}
+		}
+	}
+	return tokens
+}
+
+// readString reads a string literal whose quote is at column quote of a line; start is the column of its prefix.
+// Triple quoted strings may span lines, the token is then located on its first line up to the end of that line.
+// It returns the token with the line and column after the closing quote.
+func readString(lines []string, lineNum, quote, start int) (starlarkToken, int, int) {
+	line := lines[lineNum]
+	delimiter := line[quote : quote+1]
+	if strings.HasPrefix(line[quote:], strings.Repeat(delimiter, 3)) {
+		delimiter = strings.Repeat(delimiter, 3)
+	}
+
+	var text strings.Builder
+	for i, col := lineNum, quote+len(delimiter); i < len(lines); i, col = i+1, 0 {
+		current := lines[i]
+		for col < len(current) {
+			if strings.HasPrefix(current[col:], delimiter) {
+				end := col + len(delimiter)
+				token := starlarkToken{kind: stringToken, text: text.String(), line: lineNum, start: start, end: end}
+				if i != lineNum {
+					token.end = len(line)
+				}
+				return token, i, end
+			}
+			if current[col] == '\\' && col+1 < len(current) {
+				col++
+			}
+			text.WriteByte(current[col])
+			col++
+		}
+		// Only triple quoted strings continue on the next line
+		if len(delimiter) == 1 {
+			break
+		}
+		text.WriteByte('\n')
+	}
+	return starlarkToken{kind: stringToken, text: text.String(), line: lineNum, start: start, end: len(line)}, lineNum, len(line)
+}
+
+// starlarkParser reads the call statements of a token stream
+type starlarkParser struct {
+	tokens []starlarkToken
+	pos    int
+}
+
+// peek returns the current token, or nil at the end of the tokens
+func (p *starlarkParser) peek() *starlarkToken {
+	if p.pos < len(p.tokens) {
+		return &p.tokens[p.pos]
+	}
+	return nil
+}
+
+// isPunct reports whether the token at an offset from the current one is a punctuation character
+func (p *starlarkParser) isPunct(offset int, text string) bool {
+	index := p.pos + offset
+	return index < len(p.tokens) && p.tokens[index].kind == punctToken && p.tokens[index].text == text
+}
+
+// parseCalls reads the call statements of Starlark content; other statements are skipped.
+// It fails for calls that are not closed or have arguments without a value, e.g. a truncated file.
+func parseCalls(content string) ([]*starlarkCall, error) {
+	p := &starlarkParser{tokens: tokenize(content)}
+	var calls []*starlarkCall
+	for token := p.peek(); token != nil; token = p.peek() {
+		var function starlarkToken
+		var target string
+		switch {
+		case token.kind == identToken && p.isPunct(1, "("):
+			function = *token
+			p.pos++
+		case token.kind == identToken && p.isPunct(1, "=") && p.pos+2 < len(p.tokens) &&
+			p.tokens[p.pos+2].kind == identToken && p.isPunct(3, "("):
+			target = token.text
+			p.pos += 2
+			function = *p.peek()
+			p.pos++
+		default:
+			p.pos++
+			continue
+		}
+
+		call, err := p.parseCall(function, target)
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, call)
+	}
+	return calls, nil
+}
+
+// parseCall reads the arguments of a call whose opening parenthesis is the current token.
+// The call is located from its function name to the closing parenthesis, or to the end of the opening one
+// for calls that span several lines.
+func (p *starlarkParser) parseCall(function starlarkToken, target string) (*starlarkCall, error) {
+	call := &starlarkCall{function: function.text, target: target, line: function.line, start: function.start, end: p.peek().end}
+	p.pos++
+	for {
+		for p.isPunct(0, ",") {
+			p.pos++
+		}
+		token := p.peek()
+		if token == nil {
+			return nil, fmt.Errorf("call of %s on line %d is not closed", call.function, call.line+1)
+		}
+		if p.isPunct(0, ")") {
+			if token.line == call.line {
+				call.end = token.end
+			}
+			p.pos++
+			return call, nil
+		}
+
+		arg := &starlarkArgument{line: token.line, start: token.start}
+		if token.kind == identToken && p.isPunct(1, "=") {
+			arg.name = token.text
+			p.pos += 2
+		}
+		value, err := p.parseExpression()
+		if err != nil {
+			return nil, fmt.Errorf("call of %s on line %d: %w", call.function, call.line+1, err)
+		}
+		arg.value = value
+		arg.end = arg.value.end
+		if arg.value.line != arg.line {
+			arg.end = token.end
+		}
+		call.args = append(call.args, arg)
+	}
+}
+
+// parseExpression reads a string or a list and skips other expressions up to the next argument.
+// Values that span several lines end on their first line. It fails at the end of the tokens and at
+// closing punctuation, e.g. for name = ) or a list that is not closed.
+func (p *starlarkParser) parseExpression() (*starlarkValue, error) {
+	token := p.peek()
+	if token == nil {
+		return nil, fmt.Errorf("unexpected end of file, expected a value")
+	}
+	if token.kind == punctToken && strings.Contains(",)]}", token.text) {
+		return nil, fmt.Errorf("unexpected %q on line %d, expected a value", token.text, token.line+1)
+	}
+	value := &starlarkValue{kind: otherValue, text: token.text, line: token.line, start: token.start, end: token.end}
+	p.pos++
+
+	switch {
+	case token.kind == stringToken:
+		value.kind = stringValue
+	case token.kind == punctToken && token.text == "[":
+		value.kind, value.text = listValue, ""
+		for {
+			for p.isPunct(0, ",") {
+				p.pos++
+			}
+			item := p.peek()
+			if item == nil {
+				return nil, fmt.Errorf("list on line %d is not closed", value.line+1)
+			}
+			if p.isPunct(0, "]") {
+				if item.line == value.line {
+					value.end = item.end
+				}
+				p.pos++
+				break
+			}
+			listItem, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			value.items = append(value.items, listItem)
+		}
+	case token.kind == punctToken && (token.text == "(" || token.text == "{"):
+		// Parenthesized expressions and dicts are skipped as a whole
+		p.pos--
+	}
+
+	// The rest of the expression, e.g. "a" + VERSION or a call, is skipped up to the next argument
+	depth := 0
+	for token := p.peek(); token != nil; token = p.peek() {
+		if depth == 0 && (p.isPunct(0, ",") || p.isPunct(0, ")") || p.isPunct(0, "]") || p.isPunct(0, "}")) {
+			break
+		}
+		switch {
+		case p.isPunct(0, "(") || p.isPunct(0, "[") || p.isPunct(0, "{"):
+			depth++
+		case p.isPunct(0, ")") || p.isPunct(0, "]") || p.isPunct(0, "}"):
+			depth--
+		}
+		value.kind = otherValue
+		if token.line == value.line {
+			value.end = token.end
+		}
+		p.pos++
+	}
+	return value, nil
+}
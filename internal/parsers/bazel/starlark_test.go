@@ -0,0 +1,71 @@
+package bazel
+
+import (
+	"testing"
+)
+
+func TestParseCalls(t *testing.T) {
+	content := `# comment
+load("@rules_jvm_external//:defs.bzl", "artifact")
+
+VERSION = "1.0"
+
+maven = use_extension("@rules_jvm_external//:extensions.bzl", "maven")
+maven.install(
+    artifacts = ["a:b:" + VERSION, r"c:d:1.0"],  # comment
+    fetch_sources = True,
+    excluded = {"x": ["y"]},
+    description = """multi
+line""",
+)
+`
+	calls, err := parseCalls(content)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(calls) != 3 {
+		t.Fatalf("Expected 3 calls, got %d", len(calls))
+	}
+
+	extension := calls[1]
+	if extension.function != "use_extension" || extension.target != "maven" || extension.positional(1) != "maven" {
+		t.Errorf("Unexpected extension: %+v", extension)
+	}
+
+	install := calls[2]
+	if install.function != "maven.install" || len(install.args) != 4 {
+		t.Fatalf("Expected maven.install with 4 arguments, got %s with %d", install.function, len(install.args))
+	}
+	artifacts := install.argument("artifacts").value
+	if len(artifacts.items) != 2 || artifacts.items[0].kind != otherValue || artifacts.items[1].text != "c:d:1.0" {
+		t.Errorf("Unexpected artifacts: %+v", artifacts.items)
+	}
+	if item := artifacts.items[1]; item.line != 7 || item.start != 35 || item.end != 45 {
+		t.Errorf("Unexpected location of r\"c:d:1.0\": line %d, %d-%d", item.line, item.start, item.end)
+	}
+	if !install.isTrue("fetch_sources") {
+		t.Errorf("Expected fetch_sources to be True")
+	}
+	if description := install.stringArgument("description"); description != "multi\nline" {
+		t.Errorf("Unexpected description: %q", description)
+	}
+	if install.line != 6 || install.start != 0 || install.end != 14 {
+		t.Errorf("Unexpected location of maven.install: line %d, %d-%d", install.line, install.start, install.end)
+	}
+}
+
+func TestParseCalls_Invalid(t *testing.T) {
+	for _, content := range []string{
+		`bazel_dep(name = "a", version = `,
+		`bazel_dep(name = "a", version =`,
+		`bazel_dep(name = "a"`,
+		`bazel_dep(name = )`,
+		`maven = use_extension(`,
+		`maven.install(artifacts = ["a:b:1.0", `,
+		`maven.install(artifacts = ["a:b:1.0")`,
+	} {
+		if calls, err := parseCalls(content); err == nil {
+			t.Errorf("parseCalls(%q) = %d calls; want an error", content, len(calls))
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package bun
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/jsonutil"
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// BunLockParser implements parsing of Bun text lock files (bun.lock)
+type BunLockParser struct{}
+
+// BunLock is the file name of Bun text lock files
+const BunLock = "bun.lock"
+
+// dependencySections are the sections of the package.json files of the workspaces, which scope their dependencies
+var dependencySections = []string{"dependencies", "devDependencies", "optionalDependencies", "peerDependencies"}
+
+// bunLock represents the bun.lock structure
+type bunLock struct {
+	Workspaces map[string]map[string]json.RawMessage `json:"workspaces"`
+	// Packages are tuples of the resolved package, its registry, its metadata and its integrity, e.g.
+	// "react": ["react@18.2.0", "", {"dependencies": {...}}, "sha512-..."]. Git packages have no registry.
+	Packages map[string][]json.RawMessage `json:"packages"`
+}
+
+// packageInfo is the metadata of a locked package
+type packageInfo struct {
+	Dependencies         map[string]string `json:"dependencies"`
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
+}
+
+// declaredScopes maps the dependencies of the workspaces to the sections that declare them, by the name of the workspace.
+// Dependencies of all workspaces are also mapped by "", they are installed at the top level unless their versions conflict.
+func (l *bunLock) declaredScopes() map[string]map[string][]string {
+	scopes := map[string]map[string][]string{"": {}}
+	for _, section := range dependencySections {
+		for _, workspace := range l.Workspaces {
+			var name string
+			var deps map[string]string
+			_ = json.Unmarshal(workspace["name"], &name)
+			if err := json.Unmarshal(workspace[section], &deps); err != nil {
+				continue
+			}
+			if scopes[name] == nil {
+				scopes[name] = make(map[string][]string)
+			}
+			for dep := range deps {
+				for _, declared := range []map[string][]string{scopes[""], scopes[name]} {
+					if !slices.Contains(declared[dep], section) {
+						declared[dep] = append(declared[dep], section)
+					}
+				}
+			}
+		}
+	}
+	return scopes
+}
+
+// packagePath splits the key of a locked package into the names of the packages it is nested in,
+// e.g. @babel/core/semver is semver installed below @babel/core
+func packagePath(key string) []string {
+	var path []string
+	segments := strings.Split(key, "/")
+	for i := 0; i < len(segments); i++ {
+		name := segments[i]
+		if strings.HasPrefix(name, "@") && i+1 < len(segments) {
+			i++
+			name += "/" + segments[i]
+		}
+		path = append(path, name)
+	}
+	return path
+}
+
+// splitIdent splits the resolved package of a lock entry into its name and version, e.g. @types/node@20.11.5
+func splitIdent(ident string) (name, version string) {
+	at := strings.Index(ident[min(1, len(ident)):], "@")
+	if at < 0 {
+		return ident, ""
+	}
+	return ident[:at+1], ident[at+2:]
+}
+
+// gitSource describes a git version, e.g. github:colinhacks/zod#8f3c2a1 or git+https://host/repo.git#8f3c2a1,
+// and returns the revision that identifies it
+func gitSource(version string, metadata map[string]string) (string, bool) {
+	url, rev, _ := strings.Cut(version, "#")
+	switch {
+	case strings.HasPrefix(url, "github:"):
+		url = "https://github.com/" + strings.TrimPrefix(url, "github:")
+	case strings.HasPrefix(url, "git+"), strings.HasPrefix(url, "git:"):
+		url = strings.TrimPrefix(url, "git+")
+	default:
+		return "", false
+	}
+	metadata["source"] = "git"
+	metadata["git"] = url
+	if rev != "" {
+		metadata["rev"] = rev
+	}
+	return rev, true
+}
+
+// Parse implements the Parser interface for bun.lock files
+func (p *BunLockParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	// bun.lock is JSON with trailing commas
+	content = jsonutil.Standardize(content)
+	var lock bunLock
+	if err := json.Unmarshal(content, &lock); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	locations, err := jsonutil.FindLocations(content)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without workspaces, no package is known to be transitive
+	var declared map[string]map[string][]string
+	if len(lock.Workspaces) > 0 {
+		declared = lock.declaredScopes()
+	}
+
+	var packages []models.Package
+	for key, entry := range lock.Packages {
+		var ident string
+		if len(entry) == 0 || json.Unmarshal(entry[0], &ident) != nil {
+			continue
+		}
+		name, version := splitIdent(ident)
+		metadata := make(map[string]string)
+
+		// Workspace members, links and local files are not packages of a registry
+		if strings.Contains(version, ":") {
+			rev, ok := gitSource(version, metadata)
+			if !ok {
+				continue
+			}
+			version = rev
+		}
+
+		path := packagePath(key)
+		if local := path[len(path)-1]; local != name {
+			metadata["alias"] = local
+		}
+
+		var info packageInfo
+		var registry, integrity string
+		for i, raw := range entry[1:] {
+			if strings.HasPrefix(string(raw), "{") {
+				_ = json.Unmarshal(raw, &info)
+				continue
+			}
+			// The registry precedes the metadata of npm packages, their integrity follows it
+			switch i {
+			case 0:
+				_ = json.Unmarshal(raw, &registry)
+			case 2:
+				_ = json.Unmarshal(raw, &integrity)
+			}
+		}
+		if registry != "" {
+			metadata["registry"] = registry
+		}
+		if len(metadata) == 0 {
+			metadata = nil
+		}
+
+		var dependencies []string
+		for dep := range info.Dependencies {
+			dependencies = append(dependencies, dep)
+		}
+		for dep := range info.OptionalDependencies {
+			dependencies = append(dependencies, dep)
+		}
+		sort.Strings(dependencies)
+
+		var hashes []string
+		if integrity != "" {
+			hashes = []string{integrity}
+		}
+
+		// Packages installed at the top level, or below the workspace that depends on them, are direct
+		var scopes []string
+		switch {
+		case len(path) == 1:
+			scopes = declared[""][path[0]]
+		case len(path) == 2 && path[0] != "":
+			scopes = declared[path[0]][path[1]]
+		}
+
+		var pkgLocations []models.Location
+		if location, ok := locations.Find("packages", key); ok {
+			pkgLocations = []models.Location{location}
+		}
+
+		packages = append(packages, models.Package{
+			PackageManager: "npm",
+			PackageName:    name,
+			Version:        version,
+			FilePath:       manifestFile,
+			Locations:      pkgLocations,
+			Scopes:         scopes,
+			Transitive:     declared != nil && len(scopes) == 0,
+			Hashes:         hashes,
+			Dependencies:   dependencies,
+			Metadata:       metadata,
+		})
+	}
+
+	// Packages are read from an object, they are put back in the order of the lock file
+	pkgutil.SortByFirstLine(packages)
+	return packages, nil
+}
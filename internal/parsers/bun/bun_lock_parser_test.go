@@ -0,0 +1,104 @@
+package bun
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestBunLockParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/bun.lock"
+
+	packages, err := (&BunLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Workspace members are not packages of a registry
+	expectedPackages := []models.Package{
+		{PackageManager: "npm", PackageName: "js-tokens", Version: "4.0.0", FilePath: manifestFile, Locations: []models.Location{{Line: 24, StartIndex: 4, EndIndex: 144}}},
+		{PackageManager: "npm", PackageName: "lodash-es", Version: "4.17.21", FilePath: manifestFile, Locations: []models.Location{{Line: 26, StartIndex: 4, EndIndex: 143}}},
+		{PackageManager: "npm", PackageName: "loose-envify", Version: "1.4.0", FilePath: manifestFile, Locations: []models.Location{{Line: 28, StartIndex: 4, EndIndex: 240}}},
+		{PackageManager: "npm", PackageName: "react", Version: "18.2.0", FilePath: manifestFile, Locations: []models.Location{{Line: 30, StartIndex: 4, EndIndex: 233}}},
+		{PackageManager: "npm", PackageName: "typescript", Version: "5.3.3", FilePath: manifestFile, Locations: []models.Location{{Line: 32, StartIndex: 4, EndIndex: 203}}},
+		{PackageManager: "npm", PackageName: "zod", Version: "8f3c2a1", FilePath: manifestFile, Locations: []models.Location{{Line: 34, StartIndex: 4, EndIndex: 79}}},
+		{PackageManager: "npm", PackageName: "react", Version: "18.3.1", FilePath: manifestFile, Locations: []models.Location{{Line: 36, StartIndex: 4, EndIndex: 191}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	// react@18.3.1 is installed below the @acme/ui workspace, which depends on it
+	wantScopes := [][]string{nil, {"dependencies"}, nil, {"dependencies"}, {"devDependencies"}, {"dependencies"}, {"dependencies"}}
+	for i, pkg := range packages {
+		if !reflect.DeepEqual(pkg.Scopes, wantScopes[i]) {
+			t.Errorf("%s Scopes: got %v, want %v", pkg.PackageName, pkg.Scopes, wantScopes[i])
+		}
+		if pkg.Transitive != (wantScopes[i] == nil) {
+			t.Errorf("%s Transitive: got %v", pkg.PackageName, pkg.Transitive)
+		}
+	}
+
+	wantMetadata := []map[string]string{
+		nil,
+		{"alias": "lodash"},
+		nil,
+		{"registry": "https://npm.acme.internal/react/-/react-18.2.0.tgz"},
+		nil,
+		{"source": "git", "git": "https://github.com/colinhacks/zod", "rev": "8f3c2a1"},
+		nil,
+	}
+	for i, pkg := range packages {
+		if !reflect.DeepEqual(pkg.Metadata, wantMetadata[i]) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, wantMetadata[i])
+		}
+	}
+
+	if want := []string{"js-tokens"}; !reflect.DeepEqual(packages[2].Dependencies, want) {
+		t.Errorf("loose-envify Dependencies: got %v, want %v", packages[2].Dependencies, want)
+	}
+	if want := []string{"sha512-mKnC+QJ9pWVzv+C4/U3rRsHapFfHvQFoFB92e52xeyGMcX6/OlIl78je1u8vePzYZSkkogMPJ2yjxxsb89cxyw=="}; !reflect.DeepEqual(packages[1].Hashes, want) {
+		t.Errorf("lodash-es Hashes: got %v, want %v", packages[1].Hashes, want)
+	}
+	if packages[5].Hashes != nil {
+		t.Errorf("zod Hashes: got %v, want nil", packages[5].Hashes)
+	}
+}
+
+func TestBunLockParser_WithoutWorkspaces(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "bun.lock")
+	content := `{
+  "lockfileVersion": 1,
+  "packages": {
+    "chalk": ["chalk@5.3.0", "", {}, "sha512-abc="],
+  },
+}`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write bun.lock: %v", err)
+	}
+
+	packages, err := (&BunLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "npm", PackageName: "chalk", Version: "5.3.0", FilePath: manifestFile, Locations: []models.Location{{Line: 3, StartIndex: 4, EndIndex: 51}}},
+	}
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) == 1 && packages[0].Transitive {
+		t.Errorf("Without workspaces no package must be transitive")
+	}
+}
+
+func TestPackagePath(t *testing.T) {
+	if got, want := packagePath("@babel/core/@babel/types/semver"), []string{"@babel/core", "@babel/types", "semver"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("packagePath: got %v, want %v", got, want)
+	}
+}
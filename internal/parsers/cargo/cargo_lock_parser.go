@@ -0,0 +1,183 @@
+package cargo
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/internal/tomlutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// CargoLockParser implements parsing of Cargo lock files (Cargo.lock), format versions 1 to 4
+type CargoLockParser struct{}
+
+// Source kinds of locked packages, e.g. "registry+https://github.com/rust-lang/crates.io-index"
+const (
+	registrySourcePrefix = "registry+"
+	sparseSourcePrefix   = "sparse+"
+	gitSourcePrefix      = "git+"
+)
+
+// checksumKeyPrefix prefixes the keys of the [metadata] checksum table of version 1 lock files
+const checksumKeyPrefix = "checksum "
+
+// lockPackage represents a [[package]] entry of Cargo.lock
+type lockPackage struct {
+	Name         string   `toml:"name"`
+	Version      string   `toml:"version"`
+	Source       string   `toml:"source"`
+	Checksum     string   `toml:"checksum"`
+	Dependencies []string `toml:"dependencies"`
+}
+
+// cargoLock represents the Cargo.lock structure
+type cargoLock struct {
+	Version  int               `toml:"version"`
+	Packages []lockPackage     `toml:"package"`
+	Metadata map[string]string `toml:"metadata"`
+}
+
+// parseDependencyReference splits a dependency of a locked package.
+// Version 1 uses "name version (source)", later versions only add the version when the name is ambiguous.
+func parseDependencyReference(reference string) (name, version string) {
+	fields := strings.Fields(reference)
+	if len(fields) > 0 {
+		name = fields[0]
+	}
+	if len(fields) > 1 {
+		version = fields[1]
+	}
+	return name, version
+}
+
+// directDependencies collects the dependencies of the workspace members, the packages without a source
+func directDependencies(packages []lockPackage) map[string]bool {
+	direct := make(map[string]bool)
+	for _, pkg := range packages {
+		if pkg.Source != "" {
+			continue
+		}
+		for _, reference := range pkg.Dependencies {
+			name, version := parseDependencyReference(reference)
+			direct[name] = true
+			if version != "" {
+				direct[name+"@"+version] = true
+			}
+		}
+	}
+	return direct
+}
+
+// isDirect reports whether a locked package is a dependency of a workspace member
+func isDirect(direct map[string]bool, pkg lockPackage) bool {
+	if direct[pkg.Name+"@"+pkg.Version] {
+		return true
+	}
+	// References without a version are unambiguous, so the name is enough
+	return direct[pkg.Name] && !hasVersionedReference(direct, pkg.Name)
+}
+
+// hasVersionedReference reports whether a crate is referenced with explicit versions
+func hasVersionedReference(direct map[string]bool, name string) bool {
+	for key := range direct {
+		if strings.HasPrefix(key, name+"@") {
+			return true
+		}
+	}
+	return false
+}
+
+// sourceMetadata describes where a locked package comes from
+func sourceMetadata(source string) map[string]string {
+	switch {
+	case strings.HasPrefix(source, gitSourcePrefix):
+		// git+https://github.com/owner/repo?branch=main#commit
+		url, commit, _ := strings.Cut(strings.TrimPrefix(source, gitSourcePrefix), "#")
+		metadata := map[string]string{"source": "git", "git": url}
+		if commit != "" {
+			metadata["rev"] = commit
+		}
+		return metadata
+	case strings.HasPrefix(source, registrySourcePrefix), strings.HasPrefix(source, sparseSourcePrefix):
+		return map[string]string{"registry": source}
+	default:
+		return nil
+	}
+}
+
+// packageLocations finds the [[package]] entry of a locked package and returns the locations of its name and version
+func packageLocations(lines []string, pkg lockPackage) []models.Location {
+	namePattern := fmt.Sprintf("name = %q", pkg.Name)
+	versionPattern := fmt.Sprintf("version = %q", pkg.Version)
+
+	for i := 0; i < len(lines); i++ {
+		if strings.TrimSpace(tomlutil.StripComment(lines[i])) != namePattern {
+			continue
+		}
+
+		// The version follows the name within the same entry
+		for j := i + 1; j < len(lines); j++ {
+			line := strings.TrimSpace(tomlutil.StripComment(lines[j]))
+			if strings.HasPrefix(line, "[") {
+				break
+			}
+			if line == versionPattern {
+				return []models.Location{lineLocation(lines[i], i), lineLocation(lines[j], j)}
+			}
+		}
+	}
+	return nil
+}
+
+// lineLocation returns the location of the content of a line, without indentation
+func lineLocation(raw string, lineNum int) models.Location {
+	content := tomlutil.StripComment(raw)
+	startIdx := strings.Index(raw, content)
+	return models.Location{Line: lineNum, StartIndex: startIdx, EndIndex: startIdx + len(content)}
+}
+
+// Parse implements the Parser interface for Cargo.lock files
+func (p *CargoLockParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	var lock cargoLock
+	if _, err := toml.Decode(string(content), &lock); err != nil {
+		return nil, fmt.Errorf("failed to parse TOML: %w", err)
+	}
+
+	lines := strings.Split(string(content), "\n")
+	direct := directDependencies(lock.Packages)
+
+	var packages []models.Package
+	for _, locked := range lock.Packages {
+		// Packages without a source are the workspace members themselves
+		if locked.Source == "" {
+			continue
+		}
+
+		checksum := locked.Checksum
+		if checksum == "" {
+			// Version 1 keeps the checksums in the [metadata] table
+			checksum = lock.Metadata[fmt.Sprintf("%s%s %s (%s)", checksumKeyPrefix, locked.Name, locked.Version, locked.Source)]
+		}
+
+		packages = append(packages, models.Package{
+			PackageManager: "cargo",
+			PackageName:    locked.Name,
+			Version:        locked.Version,
+			FilePath:       manifestFile,
+			Locations:      packageLocations(lines, locked),
+			Transitive:     !isDirect(direct, locked),
+			Hashes:         pkgutil.HexSRIHash("sha256", checksum),
+			Metadata:       sourceMetadata(locked.Source),
+		})
+	}
+	return packages, nil
+}
@@ -0,0 +1,95 @@
+package cargo
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestCargoLockParser_Parse(t *testing.T) {
+	parser := &CargoLockParser{}
+	manifestFile := "../../../internal/testdata/Cargo.lock"
+
+	packages, err := parser.Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Error parsing manifest file: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{
+			PackageManager: "cargo",
+			PackageName:    "clap",
+			Version:        "4.5.7",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 20, StartIndex: 0, EndIndex: 13}, {Line: 21, StartIndex: 0, EndIndex: 17}},
+		},
+		{
+			PackageManager: "cargo",
+			PackageName:    "clap_builder",
+			Version:        "4.5.7",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 29, StartIndex: 0, EndIndex: 21}, {Line: 30, StartIndex: 0, EndIndex: 17}},
+		},
+		{
+			PackageManager: "cargo",
+			PackageName:    "regex",
+			Version:        "1.10.5",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 35, StartIndex: 0, EndIndex: 14}, {Line: 36, StartIndex: 0, EndIndex: 18}},
+		},
+		{
+			PackageManager: "cargo",
+			PackageName:    "tokio",
+			Version:        "1.38.0",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 40, StartIndex: 0, EndIndex: 14}, {Line: 41, StartIndex: 0, EndIndex: 18}},
+		},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+
+	wantTransitive := []bool{false, true, false, false}
+	for i, pkg := range packages {
+		if pkg.Transitive != wantTransitive[i] {
+			t.Errorf("%s Transitive: got %v, want %v", pkg.PackageName, pkg.Transitive, wantTransitive[i])
+		}
+	}
+	if want := "sha256-Xbg9ztNGOK1HTznyUNf+qVmL3SOerO0b30XVl9oPQz8="; len(packages[0].Hashes) != 1 || packages[0].Hashes[0] != want {
+		t.Errorf("clap Hashes: got %v, want [%s]", packages[0].Hashes, want)
+	}
+	if packages[2].Metadata["source"] != "git" || packages[2].Metadata["rev"] != "b5372f91f9e0b0e2ab0e9e8a0d1c0d9f1e7a6b2c" {
+		t.Errorf("Unexpected regex metadata: %v", packages[2].Metadata)
+	}
+}
+
+func TestCargoLockParser_Version1(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), CargoLock)
+	writeFile(t, manifestFile, `[[package]]
+name = "app"
+version = "0.1.0"
+dependencies = [
+ "libc 0.2.155 (registry+https://github.com/rust-lang/crates.io-index)",
+]
+
+[[package]]
+name = "libc"
+version = "0.2.155"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+
+[metadata]
+"checksum libc 0.2.155 (registry+https://github.com/rust-lang/crates.io-index)" = "97b3888a4aecf77e811145cadf6eef5901f4782c53886191b2f693f24761847c"
+`)
+
+	packages, err := (&CargoLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(packages) != 1 {
+		t.Fatalf("Expected 1 package, got %d: %+v", len(packages), packages)
+	}
+	if packages[0].Transitive || len(packages[0].Hashes) != 1 {
+		t.Errorf("Expected a direct libc with checksum, got %+v", packages[0])
+	}
+}
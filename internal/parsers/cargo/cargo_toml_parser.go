@@ -0,0 +1,325 @@
+package cargo
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/internal/tomlutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// CargoTomlParser implements parsing of Rust package manifests (Cargo.toml)
+type CargoTomlParser struct{}
+
+// Cargo file names
+const (
+	CargoToml = "Cargo.toml"
+	CargoLock = "Cargo.lock"
+)
+
+// Dependency tables of a Cargo manifest; the underscore spellings are accepted by Cargo as well
+var dependencyTables = []struct {
+	name  string
+	scope string
+}{
+	{"dependencies", "dependencies"},
+	{"dev-dependencies", "dev-dependencies"},
+	{"dev_dependencies", "dev-dependencies"},
+	{"build-dependencies", "build-dependencies"},
+	{"build_dependencies", "build-dependencies"},
+}
+
+// workspaceScope is the scope of the shared dependency declarations of a workspace
+const workspaceScope = "workspace"
+
+// dependency is a dependency declaration, either a version string or a table
+type dependency struct {
+	Version   string
+	Package   string
+	Git       string
+	Branch    string
+	Tag       string
+	Rev       string
+	Path      string
+	Registry  string
+	Workspace bool
+	Optional  bool
+}
+
+// decodeDependency reads a dependency declaration: serde = "1.0" or serde = { version = "1.0", ... }
+func decodeDependency(value any) dependency {
+	var dep dependency
+	switch v := value.(type) {
+	case string:
+		dep.Version = v
+	case map[string]any:
+		dep.Version, _ = v["version"].(string)
+		dep.Package, _ = v["package"].(string)
+		dep.Git, _ = v["git"].(string)
+		dep.Branch, _ = v["branch"].(string)
+		dep.Tag, _ = v["tag"].(string)
+		dep.Rev, _ = v["rev"].(string)
+		dep.Path, _ = v["path"].(string)
+		dep.Registry, _ = v["registry"].(string)
+		dep.Workspace, _ = v["workspace"].(bool)
+		dep.Optional, _ = v["optional"].(bool)
+	}
+	return dep
+}
+
+// inherit fills a `workspace = true` dependency with the declaration of the workspace.
+// Only features and optional may be set on the member itself.
+func (d dependency) inherit(shared dependency) dependency {
+	shared.Optional = d.Optional
+	return shared
+}
+
+// parseVersion handles version resolution for Cargo version requirements
+// - Returns the version for exact ("=1.2.3") requirements
+// - Returns "latest" for all other requirements: bare ("1.2.3") requirements are caret requirements (^1.2.3),
+// like operators, wildcards and multiple requirements they are resolved from Cargo.lock when it is present
+func parseVersion(requirement string) string {
+	exact, found := strings.CutPrefix(strings.TrimSpace(requirement), "=")
+	exact = strings.TrimSpace(exact)
+	if !found || exact == "" || strings.ContainsAny(exact, "^~*<>=, ") {
+		return "latest"
+	}
+	return exact
+}
+
+// workspaceManifest holds the [workspace.dependencies] of the workspace root manifest
+type workspaceManifest struct {
+	filePath     string
+	lines        []string
+	dependencies map[string]any
+}
+
+// readManifest decodes a Cargo manifest and splits it into lines
+func readManifest(manifestFile string) (map[string]any, []string, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	var manifest map[string]any
+	if _, err := toml.Decode(string(content), &manifest); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse TOML: %w", err)
+	}
+	return manifest, strings.Split(string(content), "\n"), nil
+}
+
+// workspaceDependencies returns the [workspace.dependencies] table of a decoded manifest
+func workspaceDependencies(manifest map[string]any) (map[string]any, bool) {
+	workspace, ok := manifest["workspace"].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	dependencies, _ := workspace["dependencies"].(map[string]any)
+	return dependencies, true
+}
+
+// findWorkspace finds the manifest of the workspace a package belongs to.
+// Cargo uses the nearest Cargo.toml with a [workspace] table above the package.
+func findWorkspace(manifestFile string, manifest map[string]any, lines []string) *workspaceManifest {
+	if dependencies, ok := workspaceDependencies(manifest); ok {
+		return &workspaceManifest{filePath: manifestFile, lines: lines, dependencies: dependencies}
+	}
+
+	dir := filepath.Dir(manifestFile)
+	for {
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil
+		}
+		dir = parent
+
+		candidate := filepath.Join(dir, CargoToml)
+		if _, err := os.Stat(candidate); err != nil {
+			continue
+		}
+		rootManifest, rootLines, err := readManifest(candidate)
+		if err != nil {
+			continue
+		}
+		if dependencies, ok := workspaceDependencies(rootManifest); ok {
+			return &workspaceManifest{filePath: candidate, lines: rootLines, dependencies: dependencies}
+		}
+	}
+}
+
+// parser holds the state of parsing one Cargo manifest
+type parser struct {
+	manifestFile string
+	lines        []string
+	workspace    *workspaceManifest
+	locked       map[string]string
+}
+
+// newPackage converts a dependency declaration into a package
+func (p *parser) newPackage(key string, dep dependency, table []string, scope string) models.Package {
+	name := key
+	metadata := make(map[string]string)
+	if dep.Package != "" {
+		name = dep.Package
+		metadata["alias"] = key
+	}
+
+	version := parseVersion(dep.Version)
+	if lockedVersion, ok := p.locked[name]; ok && !strings.HasPrefix(strings.TrimSpace(dep.Version), "=") {
+		version = lockedVersion
+	}
+
+	switch {
+	case dep.Git != "":
+		metadata["source"] = "git"
+		metadata["git"] = dep.Git
+		for refKind, ref := range map[string]string{"branch": dep.Branch, "tag": dep.Tag, "rev": dep.Rev} {
+			if ref != "" {
+				metadata[refKind] = ref
+			}
+		}
+	case dep.Path != "":
+		metadata["source"] = "path"
+		metadata["path"] = dep.Path
+	case dep.Registry != "":
+		metadata["registry"] = dep.Registry
+	}
+	if dep.Optional {
+		metadata["optional"] = "true"
+	}
+	if len(table) > 2 && table[0] == "target" {
+		metadata["target"] = table[1]
+	}
+	if len(metadata) == 0 {
+		metadata = nil
+	}
+
+	return models.Package{
+		PackageManager: "cargo",
+		PackageName:    name,
+		Version:        version,
+		FilePath:       p.manifestFile,
+		Locations:      tomlutil.FindEntryLocations(p.lines, table, key),
+		Scopes:         []string{scope},
+		Metadata:       metadata,
+	}
+}
+
+// parseTable converts the dependencies of a dependency table into packages
+func (p *parser) parseTable(dependencies map[string]any, table []string, scope string) []models.Package {
+	var packages []models.Package
+	for _, key := range pkgutil.SortedKeys(dependencies) {
+		dep := decodeDependency(dependencies[key])
+		inherited := dep.Workspace
+
+		// Inherit the declaration from [workspace.dependencies]
+		var sharedLocations []models.Location
+		if inherited && p.workspace != nil {
+			if shared, ok := p.workspace.dependencies[key]; ok {
+				dep = dep.inherit(decodeDependency(shared))
+				sharedLocations = tomlutil.FindEntryLocations(p.workspace.lines, []string{"workspace", "dependencies"}, key)
+				if p.workspace.filePath != p.manifestFile {
+					for i := range sharedLocations {
+						sharedLocations[i].FilePath = p.workspace.filePath
+					}
+				}
+			}
+		}
+
+		pkg := p.newPackage(key, dep, table, scope)
+		pkg.Locations = append(pkg.Locations, sharedLocations...)
+		if inherited {
+			if pkg.Metadata == nil {
+				pkg.Metadata = make(map[string]string)
+			}
+			pkg.Metadata["workspace"] = "true"
+		}
+		packages = append(packages, pkg)
+	}
+	return packages
+}
+
+// parseDependencyTables converts the dependency tables below a prefix into packages, e.g. target.'cfg(unix)'
+func (p *parser) parseDependencyTables(tables map[string]any, prefix []string) []models.Package {
+	var packages []models.Package
+	for _, table := range dependencyTables {
+		dependencies, ok := tables[table.name].(map[string]any)
+		if !ok {
+			continue
+		}
+		path := append(append([]string{}, prefix...), table.name)
+		packages = append(packages, p.parseTable(dependencies, path, table.scope)...)
+	}
+	return packages
+}
+
+// loadLockedVersions reads the Cargo.lock file of a package and maps crate names to their versions.
+// Cargo writes a single lock file next to the workspace root, so members use the root's lock file.
+// Crates locked in several versions are left out since the requirement decides which one is used.
+func loadLockedVersions(manifestFile string, workspace *workspaceManifest) map[string]string {
+	lockDir := filepath.Dir(manifestFile)
+	if workspace != nil {
+		lockDir = filepath.Dir(workspace.filePath)
+	}
+	lockFile := filepath.Join(lockDir, CargoLock)
+	if _, err := os.Stat(lockFile); err != nil {
+		return nil
+	}
+	packages, err := (&CargoLockParser{}).Parse(lockFile)
+	if err != nil {
+		return nil
+	}
+
+	locked := make(map[string]string)
+	ambiguous := make(map[string]bool)
+	for _, pkg := range packages {
+		if version, exists := locked[pkg.PackageName]; exists && version != pkg.Version {
+			ambiguous[pkg.PackageName] = true
+		}
+		locked[pkg.PackageName] = pkg.Version
+	}
+	for name := range ambiguous {
+		delete(locked, name)
+	}
+	return locked
+}
+
+// Parse implements the Parser interface for Cargo.toml files
+func (p *CargoTomlParser) Parse(manifestFile string) ([]models.Package, error) {
+	manifest, lines, err := readManifest(manifestFile)
+	if err != nil {
+		return nil, err
+	}
+
+	workspace := findWorkspace(manifestFile, manifest, lines)
+	state := &parser{
+		manifestFile: manifestFile,
+		lines:        lines,
+		workspace:    workspace,
+		locked:       loadLockedVersions(manifestFile, workspace),
+	}
+
+	packages := state.parseDependencyTables(manifest, nil)
+
+	// Platform specific dependencies: [target.'cfg(windows)'.dependencies]
+	if targets, ok := manifest["target"].(map[string]any); ok {
+		for _, target := range pkgutil.SortedKeys(targets) {
+			if tables, ok := targets[target].(map[string]any); ok {
+				packages = append(packages, state.parseDependencyTables(tables, []string{"target", target})...)
+			}
+		}
+	}
+
+	// Shared declarations of a workspace root
+	if dependencies, ok := workspaceDependencies(manifest); ok {
+		packages = append(packages, state.parseTable(dependencies, []string{"workspace", "dependencies"}, workspaceScope)...)
+	}
+
+	pkgutil.SortByFirstLine(packages)
+	return packages, nil
+}
@@ -0,0 +1,295 @@
+package cargo
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write %s: %v", path, err)
+	}
+}
+
+func TestCargoTomlParser_Parse(t *testing.T) {
+	parser := &CargoTomlParser{}
+	manifestFile := "../../../internal/testdata/Cargo.toml"
+
+	packages, err := parser.Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Error parsing manifest file: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{
+			PackageManager: "cargo",
+			PackageName:    "tokio",
+			Version:        "1.38.0",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 9, StartIndex: 0, EndIndex: 49}},
+		},
+		{
+			PackageManager: "cargo",
+			PackageName:    "serde",
+			Version:        "latest",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 12, StartIndex: 0, EndIndex: 17}},
+		},
+		{
+			PackageManager: "cargo",
+			PackageName:    "serde_json",
+			Version:        "1.0.117",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 13, StartIndex: 0, EndIndex: 55}},
+		},
+		{
+			PackageManager: "cargo",
+			PackageName:    "tokio",
+			Version:        "1.38.0",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 14, StartIndex: 0, EndIndex: 45}, {Line: 9, StartIndex: 0, EndIndex: 49}},
+		},
+		{
+			PackageManager: "cargo",
+			PackageName:    "regex",
+			Version:        "1.10.5",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 15, StartIndex: 0, EndIndex: 70}},
+		},
+		{
+			PackageManager: "cargo",
+			PackageName:    "utils",
+			Version:        "latest",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 16, StartIndex: 0, EndIndex: 33}},
+		},
+		{
+			PackageManager: "cargo",
+			PackageName:    "clap",
+			Version:        "4.5.7",
+			FilePath:       manifestFile,
+			Locations: []models.Location{
+				{Line: 17, StartIndex: 0, EndIndex: 38},
+				{Line: 18, StartIndex: 4, EndIndex: 13},
+				{Line: 19, StartIndex: 4, EndIndex: 10},
+				{Line: 20, StartIndex: 0, EndIndex: 3},
+			},
+		},
+		{
+			PackageManager: "cargo",
+			PackageName:    "criterion",
+			Version:        "latest",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 23, StartIndex: 0, EndIndex: 19}},
+		},
+		{
+			PackageManager: "cargo",
+			PackageName:    "cc",
+			Version:        "latest",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 25, StartIndex: 0, EndIndex: 23}},
+		},
+		{
+			PackageManager: "cargo",
+			PackageName:    "winapi",
+			Version:        "latest",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 29, StartIndex: 0, EndIndex: 54}},
+		},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+
+	wantScopes := []string{"workspace", "dependencies", "dependencies", "dependencies", "dependencies",
+		"dependencies", "dependencies", "dev-dependencies", "build-dependencies", "dependencies"}
+	for i, pkg := range packages {
+		if len(pkg.Scopes) != 1 || pkg.Scopes[0] != wantScopes[i] {
+			t.Errorf("%s Scopes: got %v, want [%s]", pkg.PackageName, pkg.Scopes, wantScopes[i])
+		}
+	}
+
+	wantMetadata := map[int]map[string]string{
+		2: {"alias": "json"},
+		3: {"workspace": "true", "optional": "true"},
+		4: {"source": "git", "git": "https://github.com/rust-lang/regex", "tag": "1.10.5"},
+		5: {"source": "path", "path": "crates/utils"},
+		9: {"target": "cfg(windows)"},
+	}
+	for i, metadata := range wantMetadata {
+		for key, value := range metadata {
+			if got := packages[i].Metadata[key]; got != value {
+				t.Errorf("%s Metadata[%s]: got %q, want %q", packages[i].PackageName, key, got, value)
+			}
+		}
+	}
+}
+
+func TestCargoTomlParser_WorkspaceMember(t *testing.T) {
+	root := t.TempDir()
+	rootManifest := filepath.Join(root, CargoToml)
+	writeFile(t, rootManifest, `[workspace]
+members = ["crates/*"]
+
+[workspace.dependencies]
+anyhow = "1.0.86"
+`)
+	manifestFile := filepath.Join(root, "crates", "cli", CargoToml)
+	writeFile(t, manifestFile, `[package]
+name = "cli"
+
+[dependencies]
+anyhow.workspace = true
+log = "^0.4"
+`)
+
+	packages, err := (&CargoTomlParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{
+			PackageManager: "cargo",
+			PackageName:    "anyhow",
+			Version:        "latest",
+			FilePath:       manifestFile,
+			Locations: []models.Location{
+				{Line: 4, StartIndex: 0, EndIndex: 23},
+				{Line: 4, StartIndex: 0, EndIndex: 17, FilePath: rootManifest},
+			},
+		},
+		{
+			PackageManager: "cargo",
+			PackageName:    "log",
+			Version:        "latest",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 5, StartIndex: 0, EndIndex: 12}},
+		},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+}
+
+func TestCargoTomlParser_WorkspaceMemberLockFile(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, CargoToml), `[workspace]
+members = ["crates/*"]
+
+[workspace.dependencies]
+anyhow = "1.0.86"
+`)
+	writeFile(t, filepath.Join(root, CargoLock), `version = 3
+
+[[package]]
+name = "anyhow"
+version = "1.0.89"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+
+[[package]]
+name = "log"
+version = "0.4.22"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+`)
+	manifestFile := filepath.Join(root, "crates", "cli", CargoToml)
+	writeFile(t, manifestFile, `[package]
+name = "cli"
+
+[dependencies]
+anyhow.workspace = true
+log = "^0.4"
+`)
+
+	packages, err := (&CargoTomlParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Members have no lock file of their own, versions come from the lock file of the workspace root
+	want := map[string]string{"anyhow": "1.0.89", "log": "0.4.22"}
+	if len(packages) != len(want) {
+		t.Fatalf("Expected %d packages, got %d", len(want), len(packages))
+	}
+	for _, pkg := range packages {
+		if pkg.Version != want[pkg.PackageName] {
+			t.Errorf("%s Version: got %q, want %q", pkg.PackageName, pkg.Version, want[pkg.PackageName])
+		}
+	}
+}
+
+func TestCargoTomlParser_BareRequirementsFromLock(t *testing.T) {
+	dir := t.TempDir()
+	manifestFile := filepath.Join(dir, CargoToml)
+	writeFile(t, manifestFile, `[package]
+name = "app"
+
+[dependencies]
+serde = "1.0"
+log = "=0.4.21"
+rand = "0.8"
+`)
+	writeFile(t, filepath.Join(dir, CargoLock), `version = 3
+
+[[package]]
+name = "log"
+version = "0.4.22"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+
+[[package]]
+name = "serde"
+version = "1.0.203"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+`)
+
+	packages, err := (&CargoTomlParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// A bare requirement is a caret requirement, only the exact one keeps its version over the lock file
+	expectedPackages := []models.Package{
+		{PackageManager: "cargo", PackageName: "serde", Version: "1.0.203", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 0, EndIndex: 13}}},
+		{PackageManager: "cargo", PackageName: "log", Version: "0.4.21", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 0, EndIndex: 15}}},
+		{PackageManager: "cargo", PackageName: "rand", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 0, EndIndex: 12}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+}
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		requirement string
+		want        string
+	}{
+		{"=1.0.117", "1.0.117"},
+		{"= 1.0.117", "1.0.117"},
+		{"1.0", "latest"},
+		{"1.0.203", "latest"},
+		{"^1.0", "latest"},
+		{"~0.4", "latest"},
+		{">=1.2, <1.5", "latest"},
+		{"*", "latest"},
+		{"", "latest"},
+	}
+	for _, tt := range tests {
+		if got := parseVersion(tt.requirement); got != tt.want {
+			t.Errorf("parseVersion(%q) = %q; want %q", tt.requirement, got, tt.want)
+		}
+	}
+}
+
+func TestCargoTomlParser_InvalidToml(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), CargoToml)
+	writeFile(t, manifestFile, "[dependencies\nserde = \"1\"\n")
+
+	if _, err := (&CargoTomlParser{}).Parse(manifestFile); err == nil {
+		t.Error("Expected error but got none")
+	}
+}
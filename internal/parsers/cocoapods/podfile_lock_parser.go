@@ -0,0 +1,180 @@
+package cocoapods
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// CocoaPodsPodfileLockParser implements parsing of CocoaPods lock files (Podfile.lock)
+type CocoaPodsPodfileLockParser struct{}
+
+// Sections of a Podfile.lock
+const (
+	podsSection            = "PODS"
+	dependenciesSection    = "DEPENDENCIES"
+	specReposSection       = "SPEC REPOS"
+	externalSourcesSection = "EXTERNAL SOURCES"
+	checkoutOptionsSection = "CHECKOUT OPTIONS"
+	specChecksumsSection   = "SPEC CHECKSUMS"
+)
+
+// Indentation of the entries of a Podfile.lock
+const (
+	podIndent               = "  - "
+	podDependencyIndent     = "    - "
+	sourceOptionIndent      = "    :"
+	sectionEntryIndentWidth = 2
+)
+
+// lockedPod is an entry of the PODS section
+type lockedPod struct {
+	name         string
+	version      string
+	location     models.Location
+	dependencies []string
+}
+
+// parsePodEntry parses an entry of a list, e.g. - "Firebase/Core (10.18.0)": or - MyLib (from `../MyLib`)
+func parsePodEntry(entry string) (name, version string) {
+	entry = strings.TrimSuffix(strings.TrimSpace(entry), ":")
+	entry = strings.Trim(entry, `"'`)
+	name, rest, _ := strings.Cut(entry, " (")
+	version = strings.TrimSuffix(rest, ")")
+	return name, version
+}
+
+// entryLocation returns the location of an entry of a list, without the "- " marker and the trailing colon
+func entryLocation(line string, lineNum int) models.Location {
+	content := strings.TrimSuffix(line, ":")
+	start := strings.Index(content, "- ") + len("- ")
+	return models.Location{Line: lineNum, StartIndex: start, EndIndex: len(content)}
+}
+
+// sourceMetadata describes an external source of a pod, with the checked out commit when it is known
+func sourceMetadata(external, checkout map[string]string) map[string]string {
+	metadata := make(map[string]string)
+	switch {
+	case external["git"] != "":
+		metadata["source"] = "git"
+		metadata["git"] = external["git"]
+		for option, key := range map[string]string{"branch": "branch", "tag": "tag", "commit": "rev"} {
+			if value := external[option]; value != "" {
+				metadata[key] = value
+			}
+		}
+		if commit := checkout["commit"]; commit != "" {
+			metadata["rev"] = commit
+		}
+	case external["path"] != "":
+		metadata["source"] = "path"
+		metadata["path"] = external["path"]
+	case external["podspec"] != "":
+		metadata["source"] = "podspec"
+		metadata["podspec"] = external["podspec"]
+	}
+	return metadata
+}
+
+// Parse implements the Parser interface for Podfile.lock files
+func (p *CocoaPodsPodfileLockParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	var pods []lockedPod
+	direct := make(map[string]bool)
+	repos := make(map[string]string)
+	external := make(map[string]map[string]string)
+	checkout := make(map[string]map[string]string)
+	checksums := make(map[string]string)
+
+	var section, repo, sourcePod string
+	for i, raw := range strings.Split(string(content), "\n") {
+		line := strings.TrimRight(raw, " \t\r")
+		if line == "" {
+			continue
+		}
+
+		// Section headers start at the first column, e.g. "PODS:" or "COCOAPODS: 1.14.3"
+		if !strings.HasPrefix(line, " ") {
+			section, _, _ = strings.Cut(line, ":")
+			continue
+		}
+
+		switch section {
+		case podsSection:
+			if strings.HasPrefix(line, podDependencyIndent) {
+				if len(pods) > 0 {
+					name, _ := parsePodEntry(strings.TrimPrefix(line, podDependencyIndent))
+					pods[len(pods)-1].dependencies = append(pods[len(pods)-1].dependencies, name)
+				}
+			} else if strings.HasPrefix(line, podIndent) {
+				name, version := parsePodEntry(strings.TrimPrefix(line, podIndent))
+				pods = append(pods, lockedPod{name: name, version: version, location: entryLocation(line, i)})
+			}
+
+		case dependenciesSection:
+			name, _ := parsePodEntry(strings.TrimPrefix(line, podIndent))
+			direct[name] = true
+
+		case specReposSection:
+			// Repositories are keys, e.g. "  trunk:", their pods are listed below them
+			if strings.HasPrefix(line, podDependencyIndent) {
+				repos[strings.Trim(strings.TrimPrefix(line, podDependencyIndent), `"'`)] = repo
+			} else {
+				repo = strings.Trim(strings.TrimSuffix(strings.TrimSpace(line), ":"), `"'`)
+			}
+
+		case externalSourcesSection, checkoutOptionsSection:
+			sources := external
+			if section == checkoutOptionsSection {
+				sources = checkout
+			}
+			if strings.HasPrefix(line, sourceOptionIndent) {
+				// Source options, e.g. "    :git: https://github.com/SnapKit/SnapKit.git"
+				key, value, _ := strings.Cut(strings.TrimPrefix(line, sourceOptionIndent), ":")
+				if sources[sourcePod] != nil {
+					sources[sourcePod][key] = strings.Trim(strings.TrimSpace(value), `"'`)
+				}
+			} else if len(line)-len(strings.TrimLeft(line, " ")) == sectionEntryIndentWidth {
+				sourcePod = strings.Trim(strings.TrimSuffix(strings.TrimSpace(line), ":"), `"'`)
+				sources[sourcePod] = make(map[string]string)
+			}
+
+		case specChecksumsSection:
+			name, checksum, _ := strings.Cut(strings.TrimSpace(line), ":")
+			checksums[strings.Trim(name, `"'`)] = strings.TrimSpace(checksum)
+		}
+	}
+
+	packages := make([]models.Package, 0, len(pods))
+	for _, pod := range pods {
+		root := rootName(pod.name)
+
+		metadata := sourceMetadata(external[root], checkout[root])
+		if repo, ok := repos[root]; ok {
+			metadata["registry"] = repo
+		}
+		if len(metadata) == 0 {
+			metadata = nil
+		}
+
+		packages = append(packages, models.Package{
+			PackageManager: "cocoapods",
+			PackageName:    pod.name,
+			Version:        pod.version,
+			FilePath:       manifestFile,
+			Locations:      []models.Location{pod.location},
+			Transitive:     len(direct) > 0 && !direct[pod.name],
+			Hashes:         pkgutil.HexSRIHash("sha1", checksums[root]),
+			Dependencies:   pod.dependencies,
+			Metadata:       metadata,
+		})
+	}
+	return packages, nil
+}
@@ -0,0 +1,60 @@
+package cocoapods
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestCocoaPodsPodfileLockParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/Podfile.lock"
+
+	packages, err := (&CocoaPodsPodfileLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "cocoapods", PackageName: "Alamofire", Version: "5.8.1", FilePath: manifestFile, Locations: []models.Location{{Line: 1, StartIndex: 4, EndIndex: 21}}},
+		{PackageManager: "cocoapods", PackageName: "Firebase/Analytics", Version: "10.18.0", FilePath: manifestFile, Locations: []models.Location{{Line: 2, StartIndex: 4, EndIndex: 32}}},
+		{PackageManager: "cocoapods", PackageName: "Firebase/Core", Version: "10.18.0", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 4, EndIndex: 27}}},
+		{PackageManager: "cocoapods", PackageName: "FirebaseAnalytics", Version: "10.18.0", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 4, EndIndex: 31}}},
+		{PackageManager: "cocoapods", PackageName: "FLEX", Version: "5.22.10", FilePath: manifestFile, Locations: []models.Location{{Line: 7, StartIndex: 4, EndIndex: 18}}},
+		{PackageManager: "cocoapods", PackageName: "MyLib", Version: "0.1.0", FilePath: manifestFile, Locations: []models.Location{{Line: 8, StartIndex: 4, EndIndex: 17}}},
+		{PackageManager: "cocoapods", PackageName: "Quick", Version: "7.3.0", FilePath: manifestFile, Locations: []models.Location{{Line: 9, StartIndex: 4, EndIndex: 17}}},
+		{PackageManager: "cocoapods", PackageName: "SnapKit", Version: "5.6.0", FilePath: manifestFile, Locations: []models.Location{{Line: 10, StartIndex: 4, EndIndex: 19}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	// Pods missing from the DEPENDENCIES section are pulled in by other pods
+	transitive := map[string]bool{"Firebase/Core": true, "FirebaseAnalytics": true}
+	for _, pkg := range packages {
+		if pkg.Transitive != transitive[pkg.PackageName] {
+			t.Errorf("%s Transitive: got %v", pkg.PackageName, pkg.Transitive)
+		}
+	}
+
+	if want := []string{"Firebase/Core"}; !reflect.DeepEqual(packages[1].Dependencies, want) {
+		t.Errorf("Firebase/Analytics Dependencies: got %v, want %v", packages[1].Dependencies, want)
+	}
+	if want := []string{"sha1-PKQuJZBD7g3FwM3XbEvFaLjkKvc="}; !reflect.DeepEqual(packages[0].Hashes, want) {
+		t.Errorf("Alamofire Hashes: got %v, want %v", packages[0].Hashes, want)
+	}
+
+	wantMetadata := map[string]map[string]string{
+		"Alamofire": {"registry": "trunk"},
+		"MyLib":     {"source": "path", "path": "../MyLib"},
+		"SnapKit":   {"source": "git", "git": "https://github.com/SnapKit/SnapKit.git", "tag": "5.6.0", "rev": "2842e6e84e82eb9a8dac0100ca90d9444b0307f4"},
+	}
+	for _, pkg := range packages {
+		if want, ok := wantMetadata[pkg.PackageName]; ok && !reflect.DeepEqual(pkg.Metadata, want) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, want)
+		}
+	}
+}
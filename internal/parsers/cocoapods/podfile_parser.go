@@ -0,0 +1,168 @@
+package cocoapods
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/rubyutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// CocoaPodsPodfileParser implements parsing of CocoaPods manifests (Podfile)
+type CocoaPodsPodfileParser struct{}
+
+// CocoaPods file names
+const (
+	Podfile     = "Podfile"
+	PodfileLock = "Podfile.lock"
+)
+
+// targetMethods declare the targets of a Podfile, pods declared inside belong to the innermost one
+var targetMethods = map[string]bool{"target": true, "abstract_target": true}
+
+// rootName returns the pod a subspec belongs to, e.g. Firebase for Firebase/Core
+func rootName(name string) string {
+	root, _, _ := strings.Cut(name, "/")
+	return root
+}
+
+// podMetadata describes the options of a pod declaration: its git, path or podspec source and configurations
+func podMetadata(options map[string]string) map[string]string {
+	metadata := make(map[string]string)
+	literal := func(key string) string {
+		value, _ := rubyutil.ParseLiteral(options[key])
+		return value
+	}
+
+	switch {
+	case literal("git") != "":
+		metadata["source"] = "git"
+		metadata["git"] = literal("git")
+		for option, key := range map[string]string{"branch": "branch", "tag": "tag", "commit": "rev"} {
+			if value := literal(option); value != "" {
+				metadata[key] = value
+			}
+		}
+	case literal("path") != "":
+		metadata["source"] = "path"
+		metadata["path"] = literal("path")
+	case literal("podspec") != "":
+		metadata["source"] = "podspec"
+		metadata["podspec"] = literal("podspec")
+	}
+	if registry := literal("source"); registry != "" {
+		metadata["registry"] = registry
+	}
+
+	var configurations []string
+	for _, key := range []string{"configuration", "configurations"} {
+		configurations = append(configurations, rubyutil.ParseList(options[key])...)
+	}
+	if len(configurations) > 0 {
+		metadata["configurations"] = strings.Join(configurations, ",")
+	}
+
+	if len(metadata) == 0 {
+		return nil
+	}
+	return metadata
+}
+
+// loadLockedVersions reads the Podfile.lock next to a Podfile and maps pod names to their versions
+func loadLockedVersions(manifestFile string) map[string]string {
+	lockFile := filepath.Join(filepath.Dir(manifestFile), PodfileLock)
+	if _, err := os.Stat(lockFile); err != nil {
+		return nil
+	}
+	packages, err := (&CocoaPodsPodfileLockParser{}).Parse(lockFile)
+	if err != nil {
+		return nil
+	}
+
+	locked := make(map[string]string)
+	for _, pkg := range packages {
+		locked[pkg.PackageName] = pkg.Version
+		// A pod declared without a subspec uses the version of the subspecs that are locked
+		if _, ok := locked[rootName(pkg.PackageName)]; !ok {
+			locked[rootName(pkg.PackageName)] = pkg.Version
+		}
+	}
+	return locked
+}
+
+// Parse implements the Parser interface for Podfile files
+func (p *CocoaPodsPodfileParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	locked := loadLockedVersions(manifestFile)
+	var packages []models.Package
+	// targets holds the name of every open block, empty for blocks that are not targets
+	var targets []string
+
+	for _, stmt := range rubyutil.ReadStatements(strings.Split(string(content), "\n")) {
+		if rubyutil.IsEnd(stmt.Text) {
+			if len(targets) > 0 {
+				targets = targets[:len(targets)-1]
+			}
+			continue
+		}
+		if rubyutil.OpensKeywordBlock(stmt.Text) {
+			targets = append(targets, "")
+			continue
+		}
+
+		method, args, opensBlock := rubyutil.ParseCall(stmt.Text)
+		positional, options := rubyutil.SplitOptions(args)
+
+		if opensBlock {
+			target := ""
+			if targetMethods[method] && len(positional) > 0 {
+				target, _ = rubyutil.ParseLiteral(positional[0])
+			}
+			targets = append(targets, target)
+			continue
+		}
+
+		if method != "pod" || len(positional) == 0 {
+			continue
+		}
+		name, ok := rubyutil.ParseLiteral(positional[0])
+		if !ok {
+			continue
+		}
+
+		var requirements []string
+		for _, arg := range positional[1:] {
+			requirements = append(requirements, rubyutil.ParseList(arg)...)
+		}
+		version := rubyutil.ParseVersion(requirements)
+		if lockedVersion, ok := locked[name]; ok {
+			version = lockedVersion
+		}
+
+		var scopes []string
+		for i := len(targets) - 1; i >= 0; i-- {
+			if targets[i] != "" {
+				scopes = []string{targets[i]}
+				break
+			}
+		}
+
+		packages = append(packages, models.Package{
+			PackageManager: "cocoapods",
+			PackageName:    name,
+			Version:        version,
+			FilePath:       manifestFile,
+			Locations:      stmt.Locations,
+			Scopes:         scopes,
+			Metadata:       podMetadata(options),
+		})
+	}
+
+	return packages, nil
+}
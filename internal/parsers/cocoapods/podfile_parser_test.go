@@ -0,0 +1,91 @@
+package cocoapods
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestCocoaPodsPodfileParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/Podfile"
+
+	packages, err := (&CocoaPodsPodfileParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Versions are taken from the Podfile.lock next to the Podfile
+	expectedPackages := []models.Package{
+		{PackageManager: "cocoapods", PackageName: "Alamofire", Version: "5.8.1", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 2, EndIndex: 27}}},
+		{PackageManager: "cocoapods", PackageName: "Firebase/Analytics", Version: "10.18.0", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 2, EndIndex: 26}}},
+		{PackageManager: "cocoapods", PackageName: "MyLib", Version: "0.1.0", FilePath: manifestFile, Locations: []models.Location{{Line: 7, StartIndex: 2, EndIndex: 34}}},
+		{PackageManager: "cocoapods", PackageName: "SnapKit", Version: "5.6.0", FilePath: manifestFile, Locations: []models.Location{{Line: 8, StartIndex: 2, EndIndex: 82}}},
+		{PackageManager: "cocoapods", PackageName: "FLEX", Version: "5.22.10", FilePath: manifestFile, Locations: []models.Location{{Line: 9, StartIndex: 2, EndIndex: 53}}},
+		{PackageManager: "cocoapods", PackageName: "Quick", Version: "7.3.0", FilePath: manifestFile, Locations: []models.Location{
+			{Line: 13, StartIndex: 4, EndIndex: 16},
+			{Line: 14, StartIndex: 8, EndIndex: 15},
+		}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	wantScopes := []string{"MyApp", "MyApp", "MyApp", "MyApp", "MyApp", "MyAppTests"}
+	wantMetadata := []map[string]string{
+		nil,
+		nil,
+		{"source": "path", "path": "../MyLib"},
+		{"source": "git", "git": "https://github.com/SnapKit/SnapKit.git", "tag": "5.6.0"},
+		{"configurations": "Debug"},
+		nil,
+	}
+	for i, pkg := range packages {
+		if !reflect.DeepEqual(pkg.Scopes, []string{wantScopes[i]}) {
+			t.Errorf("%s Scopes: got %v, want [%s]", pkg.PackageName, pkg.Scopes, wantScopes[i])
+		}
+		if !reflect.DeepEqual(pkg.Metadata, wantMetadata[i]) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, wantMetadata[i])
+		}
+	}
+}
+
+func TestCocoaPodsPodfileParser_WithoutLock(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "Podfile")
+	content := `pod 'Kingfisher', '7.10.0'
+abstract_target 'Shared' do
+  pod 'RxSwift', '>= 6.0'
+  pod 'Realm', :git => 'https://github.com/realm/realm-swift.git', :commit => 'a1b2c3'
+end
+`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write Podfile: %v", err)
+	}
+
+	packages, err := (&CocoaPodsPodfileParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "cocoapods", PackageName: "Kingfisher", Version: "7.10.0", FilePath: manifestFile, Locations: []models.Location{{Line: 0, StartIndex: 0, EndIndex: 26}}},
+		{PackageManager: "cocoapods", PackageName: "RxSwift", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 2, StartIndex: 2, EndIndex: 25}}},
+		{PackageManager: "cocoapods", PackageName: "Realm", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 3, StartIndex: 2, EndIndex: 86}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+	if packages[0].Scopes != nil || !reflect.DeepEqual(packages[1].Scopes, []string{"Shared"}) {
+		t.Errorf("Scopes: got %v and %v", packages[0].Scopes, packages[1].Scopes)
+	}
+	if packages[2].Metadata["rev"] != "a1b2c3" {
+		t.Errorf("Realm rev: got %q, want a1b2c3", packages[2].Metadata["rev"])
+	}
+}
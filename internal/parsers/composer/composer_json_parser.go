@@ -0,0 +1,186 @@
+package composer
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/jsonutil"
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// ComposerJsonParser implements parsing of PHP Composer manifests (composer.json)
+type ComposerJsonParser struct{}
+
+// Composer file names
+const (
+	ComposerJson = "composer.json"
+	ComposerLock = "composer.lock"
+)
+
+// Link sections of composer.json; replace and provide declare packages this package stands in for
+var linkSections = []string{"require", "require-dev", "replace", "provide"}
+
+// installedSections are the link sections whose packages are installed and locked
+var installedSections = map[string]bool{"require": true, "require-dev": true}
+
+// platformPackagePattern matches the platform packages Composer provides itself, e.g. php, ext-json or lib-curl
+var platformPackagePattern = regexp.MustCompile(`^(php(-64bit|-ipv6|-zts|-debug)?|hhvm|composer(-plugin-api|-runtime-api)?|(ext|lib)-.+)$`)
+
+// linkMap maps package names to their version constraints. Composer writes empty link sections as empty arrays,
+// e.g. "require-dev": [], since PHP does not tell empty objects from empty arrays.
+type linkMap map[string]string
+
+// UnmarshalJSON implements json.Unmarshaler, accepting an empty array as an empty map
+func (m *linkMap) UnmarshalJSON(data []byte) error {
+	var list []json.RawMessage
+	if err := json.Unmarshal(data, &list); err == nil {
+		if len(list) > 0 {
+			return fmt.Errorf("link section must be an object, got an array of %d elements", len(list))
+		}
+		*m = linkMap{}
+		return nil
+	}
+	var links map[string]string
+	if err := json.Unmarshal(data, &links); err != nil {
+		return err
+	}
+	*m = links
+	return nil
+}
+
+// composerJSON holds the link sections of composer.json
+type composerJSON struct {
+	Require    linkMap `json:"require"`
+	RequireDev linkMap `json:"require-dev"`
+	Replace    linkMap `json:"replace"`
+	Provide    linkMap `json:"provide"`
+}
+
+// links returns the packages of a link section
+func (c composerJSON) links(section string) map[string]string {
+	switch section {
+	case "require":
+		return c.Require
+	case "require-dev":
+		return c.RequireDev
+	case "replace":
+		return c.Replace
+	case "provide":
+		return c.Provide
+	}
+	return nil
+}
+
+// IsPlatformPackage reports whether a package is provided by the platform rather than installed by Composer
+func IsPlatformPackage(name string) bool {
+	return platformPackagePattern.MatchString(strings.ToLower(name))
+}
+
+// isExactVersion reports whether a constraint pins a single version, e.g. "1.2.3", "v1.2.3" or "=1.2.3"
+func isExactVersion(constraint string) bool {
+	return constraint != "" &&
+		!strings.ContainsAny(constraint, "^~*<>!=|, @") &&
+		!strings.HasPrefix(constraint, "dev-") &&
+		!strings.HasSuffix(constraint, "-dev") &&
+		constraint != "self.version"
+}
+
+// getResolvedVersion resolves a version constraint of composer.json
+// - Returns the exact version directly if specified in composer.json
+// - Looks up in composer.lock if the constraint is a range or a branch
+// - Returns "latest" when the package is not locked
+func getResolvedVersion(name, constraint string, locked map[string]string) string {
+	constraint = strings.TrimSpace(constraint)
+	if exact, found := strings.CutPrefix(constraint, "=="); found {
+		constraint = exact
+	} else if exact, found := strings.CutPrefix(constraint, "="); found {
+		constraint = exact
+	}
+	if isExactVersion(constraint) {
+		return constraint
+	}
+	if version, ok := locked[strings.ToLower(name)]; ok {
+		return version
+	}
+	return "latest"
+}
+
+// loadLockedVersions reads the composer.lock next to a manifest and maps lower case package names to their versions
+func loadLockedVersions(manifestFile string) map[string]string {
+	content, err := os.ReadFile(filepath.Join(filepath.Dir(manifestFile), ComposerLock))
+	if err != nil {
+		return nil
+	}
+
+	var lock composerLock
+	if err := json.Unmarshal(content, &lock); err != nil {
+		// Just log and continue - we'll use specified versions if lock parsing fails
+		log.Printf("Warning: could not parse composer.lock: %v", err)
+		return nil
+	}
+
+	locked := make(map[string]string)
+	for _, pkg := range append(lock.Packages, lock.PackagesDev...) {
+		locked[strings.ToLower(pkg.Name)] = pkg.Version
+	}
+	return locked
+}
+
+// Parse implements the Parser interface for composer.json files
+func (p *ComposerJsonParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	var manifest composerJSON
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	locations, err := jsonutil.FindLocations(content)
+	if err != nil {
+		return nil, err
+	}
+
+	locked := loadLockedVersions(manifestFile)
+
+	var packages []models.Package
+	for _, section := range linkSections {
+		for name, constraint := range manifest.links(section) {
+			var metadata map[string]string
+			if IsPlatformPackage(name) {
+				metadata = map[string]string{"platform": "true"}
+			}
+
+			version := getResolvedVersion(name, constraint, nil)
+			if installedSections[section] && metadata == nil {
+				version = getResolvedVersion(name, constraint, locked)
+			}
+
+			var pkgLocations []models.Location
+			if location, ok := locations.Find(section, name); ok {
+				pkgLocations = []models.Location{location}
+			}
+
+			packages = append(packages, models.Package{
+				PackageManager: "composer",
+				PackageName:    name,
+				Version:        version,
+				FilePath:       manifestFile,
+				Locations:      pkgLocations,
+				Scopes:         []string{section},
+				Metadata:       metadata,
+			})
+		}
+	}
+
+	// Sort packages by line number
+	pkgutil.SortByFirstLine(packages)
+	return packages, nil
+}
@@ -0,0 +1,128 @@
+package composer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestComposerJsonParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/composer.json"
+
+	packages, err := (&ComposerJsonParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Ranges and branches are resolved from the composer.lock next to composer.json
+	expectedPackages := []models.Package{
+		{PackageManager: "composer", PackageName: "php", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 8, EndIndex: 23}}},
+		{PackageManager: "composer", PackageName: "ext-json", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 8, EndIndex: 24}}},
+		{PackageManager: "composer", PackageName: "monolog/monolog", Version: "3.5.0", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 8, EndIndex: 34}}},
+		{PackageManager: "composer", PackageName: "symfony/console", Version: "v6.4.1", FilePath: manifestFile, Locations: []models.Location{{Line: 7, StartIndex: 8, EndIndex: 36}}},
+		{PackageManager: "composer", PackageName: "guzzlehttp/guzzle", Version: "dev-main", FilePath: manifestFile, Locations: []models.Location{{Line: 8, StartIndex: 8, EndIndex: 39}}},
+		{PackageManager: "composer", PackageName: "phpunit/phpunit", Version: "10.5.3", FilePath: manifestFile, Locations: []models.Location{{Line: 11, StartIndex: 8, EndIndex: 34}}},
+		{PackageManager: "composer", PackageName: "symfony/polyfill-php80", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 14, StartIndex: 8, EndIndex: 37}}},
+		{PackageManager: "composer", PackageName: "psr/log-implementation", Version: "3.0.0", FilePath: manifestFile, Locations: []models.Location{{Line: 17, StartIndex: 8, EndIndex: 41}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	wantScopes := []string{"require", "require", "require", "require", "require", "require-dev", "replace", "provide"}
+	for i, pkg := range packages {
+		if len(pkg.Scopes) != 1 || pkg.Scopes[0] != wantScopes[i] {
+			t.Errorf("%s Scopes: got %v, want [%s]", pkg.PackageName, pkg.Scopes, wantScopes[i])
+		}
+		wantPlatform := i < 2
+		if (pkg.Metadata["platform"] == "true") != wantPlatform {
+			t.Errorf("%s platform: got %q, want %v", pkg.PackageName, pkg.Metadata["platform"], wantPlatform)
+		}
+	}
+}
+
+func TestComposerJsonParser_WithoutLock(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "composer.json")
+	content := `{
+  "require": {
+    "laravel/framework": "^11.0",
+    "doctrine/dbal": "3.8.0",
+    "lib-curl": ">=7.0"
+  }
+}`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write composer.json: %v", err)
+	}
+
+	packages, err := (&ComposerJsonParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "composer", PackageName: "laravel/framework", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 2, StartIndex: 4, EndIndex: 33}}},
+		{PackageManager: "composer", PackageName: "doctrine/dbal", Version: "3.8.0", FilePath: manifestFile, Locations: []models.Location{{Line: 3, StartIndex: 4, EndIndex: 29}}},
+		{PackageManager: "composer", PackageName: "lib-curl", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 4, EndIndex: 23}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+}
+
+func TestComposerJsonParser_EmptyArraySections(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "composer.json")
+	content := `{
+  "require": {
+    "monolog/monolog": "3.5.0"
+  },
+  "require-dev": [],
+  "provide": []
+}`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write composer.json: %v", err)
+	}
+
+	packages, err := (&ComposerJsonParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "composer", PackageName: "monolog/monolog", Version: "3.5.0", FilePath: manifestFile, Locations: []models.Location{{Line: 2, StartIndex: 4, EndIndex: 30}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+}
+
+func TestComposerJsonParser_NonEmptyArraySection(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "composer.json")
+	if err := os.WriteFile(manifestFile, []byte(`{"require": ["monolog/monolog"]}`), 0644); err != nil {
+		t.Fatalf("Failed to write composer.json: %v", err)
+	}
+
+	if _, err := (&ComposerJsonParser{}).Parse(manifestFile); err == nil {
+		t.Error("Expected error but got none")
+	}
+}
+
+func TestIsPlatformPackage(t *testing.T) {
+	tests := map[string]bool{
+		"php":                  true,
+		"php-64bit":            true,
+		"ext-mbstring":         true,
+		"lib-icu":              true,
+		"composer-runtime-api": true,
+		"hhvm":                 true,
+		"phpunit/phpunit":      false,
+		"php-http/client":      false,
+	}
+	for name, want := range tests {
+		if got := IsPlatformPackage(name); got != want {
+			t.Errorf("IsPlatformPackage(%q) = %v; want %v", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package composer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/jsonutil"
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// ComposerLockParser implements parsing of PHP Composer lock files (composer.lock)
+type ComposerLockParser struct{}
+
+// packageReference is the source or dist of a locked package
+type packageReference struct {
+	Type      string `json:"type"`
+	URL       string `json:"url"`
+	Reference string `json:"reference"`
+	Shasum    string `json:"shasum"`
+}
+
+// lockPackage represents an entry of the packages and packages-dev lists of composer.lock
+type lockPackage struct {
+	Name    string            `json:"name"`
+	Version string            `json:"version"`
+	Source  *packageReference `json:"source"`
+	Dist    *packageReference `json:"dist"`
+	Require linkMap           `json:"require"`
+}
+
+// composerLock represents the composer.lock structure
+type composerLock struct {
+	Packages    []lockPackage `json:"packages"`
+	PackagesDev []lockPackage `json:"packages-dev"`
+}
+
+// referenceMetadata describes where a locked package is installed from
+func referenceMetadata(pkg lockPackage) map[string]string {
+	metadata := make(map[string]string)
+	if pkg.Source != nil && pkg.Source.URL != "" {
+		metadata["source"] = pkg.Source.Type
+		metadata[pkg.Source.Type] = pkg.Source.URL
+		if pkg.Source.Reference != "" {
+			metadata["rev"] = pkg.Source.Reference
+		}
+	}
+	if pkg.Dist != nil && pkg.Dist.URL != "" {
+		// Path repositories only have a dist, e.g. {"type": "path", "url": "packages/shared"}
+		if pkg.Dist.Type == "path" {
+			metadata["source"] = "path"
+			metadata["path"] = pkg.Dist.URL
+		} else {
+			metadata["dist"] = pkg.Dist.URL
+		}
+		if _, ok := metadata["rev"]; !ok && pkg.Dist.Reference != "" {
+			metadata["rev"] = pkg.Dist.Reference
+		}
+	}
+	if len(metadata) == 0 {
+		return nil
+	}
+	return metadata
+}
+
+// loadDirectDependencies reads the composer.json next to a lock file and collects the lower case names it requires
+func loadDirectDependencies(lockFile string) map[string]bool {
+	content, err := os.ReadFile(filepath.Join(filepath.Dir(lockFile), ComposerJson))
+	if err != nil {
+		return nil
+	}
+	var manifest composerJSON
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil
+	}
+
+	direct := make(map[string]bool)
+	for section := range installedSections {
+		for name := range manifest.links(section) {
+			direct[strings.ToLower(name)] = true
+		}
+	}
+	return direct
+}
+
+// Parse implements the Parser interface for composer.lock files
+func (p *ComposerLockParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	var lock composerLock
+	if err := json.Unmarshal(content, &lock); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	locations, err := jsonutil.FindLocations(content)
+	if err != nil {
+		return nil, err
+	}
+
+	direct := loadDirectDependencies(manifestFile)
+
+	var packages []models.Package
+	for _, list := range []struct {
+		key      string
+		scope    string
+		packages []lockPackage
+	}{
+		{"packages", "require", lock.Packages},
+		{"packages-dev", "require-dev", lock.PackagesDev},
+	} {
+		for i, locked := range list.packages {
+			index := strconv.Itoa(i)
+			var pkgLocations []models.Location
+			for _, key := range []string{"name", "version"} {
+				if location, ok := locations.Find(list.key, index, key); ok {
+					pkgLocations = append(pkgLocations, location)
+				}
+			}
+
+			var hashes []string
+			if locked.Dist != nil {
+				hashes = pkgutil.HexSRIHash("sha1", locked.Dist.Shasum)
+			}
+
+			var dependencies []string
+			for name := range locked.Require {
+				dependencies = append(dependencies, name)
+			}
+			sort.Strings(dependencies)
+
+			packages = append(packages, models.Package{
+				PackageManager: "composer",
+				PackageName:    locked.Name,
+				Version:        locked.Version,
+				FilePath:       manifestFile,
+				Locations:      pkgLocations,
+				Scopes:         []string{list.scope},
+				Transitive:     direct != nil && !direct[strings.ToLower(locked.Name)],
+				Hashes:         hashes,
+				Dependencies:   dependencies,
+				Metadata:       referenceMetadata(locked),
+			})
+		}
+	}
+	return packages, nil
+}
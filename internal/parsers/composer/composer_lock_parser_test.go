@@ -0,0 +1,66 @@
+package composer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestComposerLockParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/composer.lock"
+
+	packages, err := (&ComposerLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "composer", PackageName: "monolog/monolog", Version: "3.5.0", FilePath: manifestFile, Locations: []models.Location{{Line: 7, StartIndex: 12, EndIndex: 38}, {Line: 8, StartIndex: 12, EndIndex: 31}}},
+		{PackageManager: "composer", PackageName: "psr/log", Version: "3.0.0", FilePath: manifestFile, Locations: []models.Location{{Line: 26, StartIndex: 12, EndIndex: 30}, {Line: 27, StartIndex: 12, EndIndex: 31}}},
+		{PackageManager: "composer", PackageName: "guzzlehttp/guzzle", Version: "dev-main", FilePath: manifestFile, Locations: []models.Location{{Line: 39, StartIndex: 12, EndIndex: 40}, {Line: 40, StartIndex: 12, EndIndex: 34}}},
+		{PackageManager: "composer", PackageName: "symfony/console", Version: "v6.4.1", FilePath: manifestFile, Locations: []models.Location{{Line: 48, StartIndex: 12, EndIndex: 38}, {Line: 49, StartIndex: 12, EndIndex: 32}}},
+		{PackageManager: "composer", PackageName: "phpunit/phpunit", Version: "10.5.3", FilePath: manifestFile, Locations: []models.Location{{Line: 59, StartIndex: 12, EndIndex: 38}, {Line: 60, StartIndex: 12, EndIndex: 32}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	// psr/log is only required by monolog/monolog, not by the sibling composer.json
+	for _, pkg := range packages {
+		if pkg.Transitive != (pkg.PackageName == "psr/log") {
+			t.Errorf("%s Transitive: got %v", pkg.PackageName, pkg.Transitive)
+		}
+	}
+	if packages[4].Scopes[0] != "require-dev" {
+		t.Errorf("phpunit/phpunit Scopes: got %v, want [require-dev]", packages[4].Scopes)
+	}
+
+	monolog := packages[0]
+	wantMetadata := map[string]string{
+		"source": "git",
+		"git":    "https://github.com/Seldaek/monolog.git",
+		"rev":    "c915e2634718dbc8a4a15c61b0e62e7a44e14448",
+		"dist":   "https://api.github.com/repos/Seldaek/monolog/zipball/c915e2634718dbc8a4a15c61b0e62e7a44e14448",
+	}
+	if !reflect.DeepEqual(monolog.Metadata, wantMetadata) {
+		t.Errorf("monolog/monolog Metadata: got %v, want %v", monolog.Metadata, wantMetadata)
+	}
+	if want := []string{"sha1-2jmj7l5rSw0yVb/vlWAYkK/YBwk="}; !reflect.DeepEqual(monolog.Hashes, want) {
+		t.Errorf("monolog/monolog Hashes: got %v, want %v", monolog.Hashes, want)
+	}
+	if want := []string{"php", "psr/log"}; !reflect.DeepEqual(monolog.Dependencies, want) {
+		t.Errorf("monolog/monolog Dependencies: got %v, want %v", monolog.Dependencies, want)
+	}
+	if packages[1].Hashes != nil {
+		t.Errorf("Empty shasum must not produce hashes, got %v", packages[1].Hashes)
+	}
+
+	wantPath := map[string]string{"source": "path", "path": "packages/console", "rev": "a1b2c3d4"}
+	if !reflect.DeepEqual(packages[3].Metadata, wantPath) {
+		t.Errorf("symfony/console Metadata: got %v, want %v", packages[3].Metadata, wantPath)
+	}
+}
@@ -0,0 +1,173 @@
+package conan
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/Checkmarx/manifest-parser/internal/jsonutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// ConanLockParser implements parsing of Conan lock files (conan.lock), the Conan 2 lists of references and the Conan 1 graph
+type ConanLockParser struct{}
+
+// lockSections are the lists of locked references of Conan 2 lock files
+var lockSections = []string{"requires", "build_requires", "python_requires"}
+
+// lockNode is a node of the graph of Conan 1 lock files; node "0" is the consumer of the lock
+type lockNode struct {
+	Ref           string   `json:"ref"`
+	Requires      []string `json:"requires"`
+	BuildRequires []string `json:"build_requires"`
+}
+
+// conanLock represents the conan.lock structure
+type conanLock struct {
+	Requires       []string `json:"requires"`
+	BuildRequires  []string `json:"build_requires"`
+	PythonRequires []string `json:"python_requires"`
+	GraphLock      struct {
+		Nodes map[string]lockNode `json:"nodes"`
+	} `json:"graph_lock"`
+}
+
+// section returns the references of a list of Conan 2 lock files
+func (c conanLock) section(name string) []string {
+	switch name {
+	case "requires":
+		return c.Requires
+	case "build_requires":
+		return c.BuildRequires
+	case "python_requires":
+		return c.PythonRequires
+	}
+	return nil
+}
+
+// loadDeclaredRecipes reads the conanfile.txt or conanfile.py next to a conan.lock and collects the recipes it requires
+func loadDeclaredRecipes(lockFile string) map[string]bool {
+	dir := filepath.Dir(lockFile)
+	packages, err := parseConanfileTxt(filepath.Join(dir, ConanfileTxt), nil)
+	if err != nil {
+		if packages, err = parseConanfilePy(filepath.Join(dir, ConanfilePy), nil); err != nil {
+			return nil
+		}
+	}
+
+	declared := make(map[string]bool)
+	for _, pkg := range packages {
+		declared[pkg.PackageName] = true
+	}
+	return declared
+}
+
+// newLockedPackage converts a locked reference into a package
+func newLockedPackage(ref reference, manifestFile string, location models.Location, found bool) models.Package {
+	metadata := ref.metadata()
+	if len(metadata) == 0 {
+		metadata = nil
+	}
+	var locations []models.Location
+	if found {
+		locations = []models.Location{location}
+	}
+	return models.Package{
+		PackageManager: "conan",
+		PackageName:    ref.name,
+		Version:        ref.version,
+		FilePath:       manifestFile,
+		Locations:      locations,
+		Metadata:       metadata,
+	}
+}
+
+// parseGraph reads the nodes of a Conan 1 lock file, the requirements of the consumer node are direct
+func parseGraph(lock conanLock, locations jsonutil.Locations, manifestFile string) []models.Package {
+	nodes := lock.GraphLock.Nodes
+	consumer := nodes["0"]
+	direct := make(map[string]string)
+	for _, id := range consumer.Requires {
+		direct[id] = "requires"
+	}
+	for _, id := range consumer.BuildRequires {
+		direct[id] = "build_requires"
+	}
+
+	ids := make([]string, 0, len(nodes))
+	for id := range nodes {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, _ := strconv.Atoi(ids[i])
+		b, _ := strconv.Atoi(ids[j])
+		return a < b
+	})
+
+	var packages []models.Package
+	for _, id := range ids {
+		ref, ok := parseReference(nodes[id].Ref)
+		if id == "0" || !ok {
+			continue
+		}
+		location, found := locations.Find("graph_lock", "nodes", id, "ref")
+		pkg := newLockedPackage(ref, manifestFile, location, found)
+		if scope, ok := direct[id]; ok {
+			pkg.Scopes = []string{scope}
+		} else {
+			pkg.Transitive = true
+		}
+
+		for _, child := range append(append([]string{}, nodes[id].Requires...), nodes[id].BuildRequires...) {
+			if childRef, ok := parseReference(nodes[child].Ref); ok {
+				pkg.Dependencies = append(pkg.Dependencies, childRef.name)
+			}
+		}
+		sort.Strings(pkg.Dependencies)
+		packages = append(packages, pkg)
+	}
+	return packages
+}
+
+// Parse implements the Parser interface for conan.lock files
+func (p *ConanLockParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	var lock conanLock
+	if err := json.Unmarshal(content, &lock); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	locations, err := jsonutil.FindLocations(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lock.GraphLock.Nodes) > 0 {
+		return parseGraph(lock, locations, manifestFile), nil
+	}
+
+	// Conan 2 lock files do not keep the graph, recipes missing from the conanfile next to them are transitive
+	declared := loadDeclaredRecipes(manifestFile)
+
+	var packages []models.Package
+	for _, section := range lockSections {
+		for i, text := range lock.section(section) {
+			ref, ok := parseReference(text)
+			if !ok {
+				continue
+			}
+			location, found := locations.Find(section, strconv.Itoa(i))
+			pkg := newLockedPackage(ref, manifestFile, location, found)
+			pkg.Scopes = []string{section}
+			pkg.Transitive = declared != nil && !declared[ref.name]
+			packages = append(packages, pkg)
+		}
+	}
+	return packages, nil
+}
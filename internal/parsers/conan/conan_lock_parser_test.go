@@ -0,0 +1,93 @@
+package conan
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestConanLockParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/conan.lock"
+
+	packages, err := (&ConanLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "conan", PackageName: "zlib", Version: "1.2.13", FilePath: manifestFile, Locations: []models.Location{{Line: 3, StartIndex: 8, EndIndex: 68}}},
+		{PackageManager: "conan", PackageName: "openssl", Version: "3.2.0", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 8, EndIndex: 71}}},
+		{PackageManager: "conan", PackageName: "fmt", Version: "10.1.1", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 8, EndIndex: 68}}},
+		{PackageManager: "conan", PackageName: "boost", Version: "1.83.0", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 8, EndIndex: 81}}},
+		{PackageManager: "conan", PackageName: "bzip2", Version: "1.0.8", FilePath: manifestFile, Locations: []models.Location{{Line: 7, StartIndex: 8, EndIndex: 69}}},
+		{PackageManager: "conan", PackageName: "cmake", Version: "3.27.7", FilePath: manifestFile, Locations: []models.Location{{Line: 10, StartIndex: 8, EndIndex: 69}}},
+		{PackageManager: "conan", PackageName: "gtest", Version: "1.14.0", FilePath: manifestFile, Locations: []models.Location{{Line: 11, StartIndex: 8, EndIndex: 68}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	// Recipes missing from the conanfile.txt next to conan.lock are dependencies of dependencies
+	for _, pkg := range packages {
+		if pkg.Transitive != (pkg.PackageName == "bzip2") {
+			t.Errorf("%s Transitive: got %v", pkg.PackageName, pkg.Transitive)
+		}
+	}
+	if want := []string{"build_requires"}; !reflect.DeepEqual(packages[5].Scopes, want) {
+		t.Errorf("cmake Scopes: got %v, want %v", packages[5].Scopes, want)
+	}
+	if want := map[string]string{"user": "acme", "channel": "stable", "rrev": "1ed9a4a8e6a2b1b5f0a3b8c9d0e1f2a3"}; !reflect.DeepEqual(packages[3].Metadata, want) {
+		t.Errorf("boost Metadata: got %v, want %v", packages[3].Metadata, want)
+	}
+}
+
+func TestConanLockParser_Conan1Graph(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "conan.lock")
+	content := `{
+ "graph_lock": {
+  "nodes": {
+   "0": {"options": "", "requires": ["1"], "build_requires": ["3"], "path": "conanfile.txt", "context": "host"},
+   "1": {"ref": "openssl/1.1.1w#4a1d8b9e", "requires": ["2"], "context": "host"},
+   "2": {"ref": "zlib/1.3", "context": "host"},
+   "3": {"ref": "cmake/3.27.7", "context": "build"}
+  },
+  "revisions_enabled": true
+ },
+ "version": "0.4"
+}`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write conan.lock: %v", err)
+	}
+
+	packages, err := (&ConanLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "conan", PackageName: "openssl", Version: "1.1.1w", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 9, EndIndex: 42}}},
+		{PackageManager: "conan", PackageName: "zlib", Version: "1.3", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 9, EndIndex: 27}}},
+		{PackageManager: "conan", PackageName: "cmake", Version: "3.27.7", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 9, EndIndex: 31}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	if !packages[1].Transitive || packages[0].Transitive || packages[2].Transitive {
+		t.Errorf("Only zlib must be transitive")
+	}
+	if want := []string{"zlib"}; !reflect.DeepEqual(packages[0].Dependencies, want) {
+		t.Errorf("openssl Dependencies: got %v, want %v", packages[0].Dependencies, want)
+	}
+	if want := []string{"build_requires"}; !reflect.DeepEqual(packages[2].Scopes, want) {
+		t.Errorf("cmake Scopes: got %v, want %v", packages[2].Scopes, want)
+	}
+}
@@ -0,0 +1,168 @@
+package conan
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// ConanfilePyParser implements parsing of the declarative requirements of Conan recipes (conanfile.py).
+// References built at runtime, e.g. f"zlib/{version}", are not known before the recipe runs and are skipped.
+type ConanfilePyParser struct{}
+
+// requirementAttributePattern matches the requirement attributes of a recipe class, e.g. requires = "zlib/1.2.13", "fmt/10.1.1"
+var requirementAttributePattern = regexp.MustCompile(`(?m)^[ \t]*(requires|tool_requires|build_requires|test_requires)[ \t]*=[ \t]*`)
+
+// requirementCallPattern matches requirements of requirements() and build_requirements(), e.g. self.requires("boost/1.83.0")
+var requirementCallPattern = regexp.MustCompile(`\bself\.(requires|tool_requires|build_requires|test_requires)\s*\(\s*`)
+
+// overridePattern matches the keyword arguments that make a requirement win over the versions other recipes require
+var overridePattern = regexp.MustCompile(`\b(override|force)\s*=\s*True\b`)
+
+// blankComments replaces the comments of Python code with spaces, keeping the offsets of the code intact
+func blankComments(content []byte) []byte {
+	code := make([]byte, len(content))
+	copy(code, content)
+
+	var quote byte
+	for i := 0; i < len(code); i++ {
+		switch c := code[i]; {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote || c == '\n' {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#':
+			for ; i < len(code) && code[i] != '\n'; i++ {
+				code[i] = ' '
+			}
+		}
+	}
+	return code
+}
+
+// stringLiteral is a string literal with the offsets of its contents
+type stringLiteral struct {
+	value string
+	start int
+	end   int
+}
+
+// readStringLiteral reads a plain string literal starting at an offset, f-strings and concatenations are not literals
+func readStringLiteral(code []byte, start int) (stringLiteral, bool) {
+	if start >= len(code) || (code[start] != '"' && code[start] != '\'') {
+		return stringLiteral{}, false
+	}
+	quote := code[start]
+	end := bytes.IndexAny(code[start+1:], string(quote)+"\n")
+	if end < 0 || code[start+1+end] != quote {
+		return stringLiteral{}, false
+	}
+	end += start + 1
+	return stringLiteral{value: string(code[start+1 : end]), start: start + 1, end: end}, true
+}
+
+// attributeLiterals reads the string literals assigned to an attribute: a string, a tuple or a list, possibly spanning lines
+func attributeLiterals(code []byte, start int) []stringLiteral {
+	end := bytes.IndexByte(code[start:], '\n')
+	if end < 0 {
+		end = len(code)
+	} else {
+		end += start
+	}
+	if start < len(code) && (code[start] == '(' || code[start] == '[') {
+		closing := map[byte]byte{'(': ')', '[': ']'}[code[start]]
+		if closingAt := bytes.IndexByte(code[start:], closing); closingAt >= 0 {
+			end = start + closingAt
+		}
+	}
+
+	var literals []stringLiteral
+	for i := start; i < end; i++ {
+		if literal, ok := readStringLiteral(code, i); ok {
+			literals = append(literals, literal)
+			i = literal.end
+		}
+	}
+	return literals
+}
+
+// offsetLocation converts an offset range of the content into a location
+func offsetLocation(content []byte, start, end int) models.Location {
+	line := bytes.Count(content[:start], []byte("\n"))
+	column := start - (bytes.LastIndexByte(content[:start], '\n') + 1)
+	return models.Location{Line: line, StartIndex: column, EndIndex: column + end - start}
+}
+
+// requirement is a reference required by a recipe
+type requirement struct {
+	literal    stringLiteral
+	scope      string
+	overridden bool
+}
+
+// Parse implements the Parser interface for conanfile.py files
+func (p *ConanfilePyParser) Parse(manifestFile string) ([]models.Package, error) {
+	return parseConanfilePy(manifestFile, loadLockedVersions(manifestFile))
+}
+
+// parseConanfilePy reads the requirements of a conanfile.py, resolving version ranges from the locked versions
+func parseConanfilePy(manifestFile string, locked map[string]string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+	code := blankComments(content)
+
+	var requirements []requirement
+	for _, match := range requirementAttributePattern.FindAllSubmatchIndex(code, -1) {
+		scope := string(code[match[2]:match[3]])
+		for _, literal := range attributeLiterals(code, match[1]) {
+			requirements = append(requirements, requirement{literal: literal, scope: scope})
+		}
+	}
+	for _, match := range requirementCallPattern.FindAllSubmatchIndex(code, -1) {
+		literal, ok := readStringLiteral(code, match[1])
+		if !ok {
+			continue
+		}
+		// Keyword arguments follow the reference up to the end of the call
+		arguments := code[literal.end:]
+		if end := bytes.IndexByte(arguments, ')'); end >= 0 {
+			arguments = arguments[:end]
+		}
+		requirements = append(requirements, requirement{
+			literal:    literal,
+			scope:      string(code[match[2]:match[3]]),
+			overridden: overridePattern.Match(arguments),
+		})
+	}
+	sort.SliceStable(requirements, func(i, j int) bool {
+		return requirements[i].literal.start < requirements[j].literal.start
+	})
+
+	var packages []models.Package
+	for _, req := range requirements {
+		ref, ok := parseReference(req.literal.value)
+		if !ok || strings.ContainsAny(ref.version, "{}") {
+			continue
+		}
+		pkg := newPackage(ref, manifestFile, offsetLocation(content, req.literal.start, req.literal.end), req.scope, locked)
+		if req.overridden {
+			if pkg.Metadata == nil {
+				pkg.Metadata = make(map[string]string)
+			}
+			pkg.Metadata["overridden"] = "true"
+		}
+		packages = append(packages, pkg)
+	}
+	return packages, nil
+}
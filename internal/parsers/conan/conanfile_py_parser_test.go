@@ -0,0 +1,44 @@
+package conan
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestConanfilePyParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/conanfile.py"
+
+	packages, err := (&ConanfilePyParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Commented requirements and references formatted at runtime are skipped
+	expectedPackages := []models.Package{
+		{PackageManager: "conan", PackageName: "zlib", Version: "1.2.13", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 16, EndIndex: 27}}},
+		{PackageManager: "conan", PackageName: "openssl", Version: "3.2.0", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 31, EndIndex: 49}}},
+		{PackageManager: "conan", PackageName: "cmake", Version: "3.27.7", FilePath: manifestFile, Locations: []models.Location{{Line: 7, StartIndex: 22, EndIndex: 34}}},
+		{PackageManager: "conan", PackageName: "ninja", Version: "1.11.1", FilePath: manifestFile, Locations: []models.Location{{Line: 8, StartIndex: 22, EndIndex: 34}}},
+		{PackageManager: "conan", PackageName: "boost", Version: "1.83.0", FilePath: manifestFile, Locations: []models.Location{{Line: 12, StartIndex: 23, EndIndex: 47}}},
+		{PackageManager: "conan", PackageName: "fmt", Version: "10.1.1", FilePath: manifestFile, Locations: []models.Location{{Line: 13, StartIndex: 23, EndIndex: 33}}},
+		{PackageManager: "conan", PackageName: "gtest", Version: "1.14.0", FilePath: manifestFile, Locations: []models.Location{{Line: 17, StartIndex: 28, EndIndex: 40}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	wantScopes := map[string]string{"openssl": "requires", "ninja": "tool_requires", "boost": "requires", "gtest": "test_requires"}
+	for _, pkg := range packages {
+		if want, ok := wantScopes[pkg.PackageName]; ok && !reflect.DeepEqual(pkg.Scopes, []string{want}) {
+			t.Errorf("%s Scopes: got %v, want [%s]", pkg.PackageName, pkg.Scopes, want)
+		}
+	}
+	if want := map[string]string{"overridden": "true"}; !reflect.DeepEqual(packages[5].Metadata, want) {
+		t.Errorf("fmt Metadata: got %v, want %v", packages[5].Metadata, want)
+	}
+}
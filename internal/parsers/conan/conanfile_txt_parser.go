@@ -0,0 +1,111 @@
+package conan
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// ConanfileTxtParser implements parsing of Conan manifests (conanfile.txt)
+type ConanfileTxtParser struct{}
+
+// requirementSections are the sections of conanfile.txt listing references; build_requires is the Conan 1 name of tool_requires
+var requirementSections = map[string]bool{
+	"requires":       true,
+	"tool_requires":  true,
+	"build_requires": true,
+	"test_requires":  true,
+}
+
+// loadLockedVersions reads the conan.lock next to a manifest and maps recipe names to their locked versions
+func loadLockedVersions(manifestFile string) map[string]string {
+	lockFile := filepath.Join(filepath.Dir(manifestFile), ConanLock)
+	if _, err := os.Stat(lockFile); err != nil {
+		return nil
+	}
+	packages, err := (&ConanLockParser{}).Parse(lockFile)
+	if err != nil {
+		return nil
+	}
+
+	locked := make(map[string]string)
+	for _, pkg := range packages {
+		locked[pkg.PackageName] = pkg.Version
+	}
+	return locked
+}
+
+// newPackage converts a required reference into a package, resolving version ranges from conan.lock
+func newPackage(ref reference, manifestFile string, location models.Location, scope string, locked map[string]string) models.Package {
+	version := ref.version
+	if ref.isRange() {
+		version = "latest"
+		if lockedVersion, ok := locked[ref.name]; ok {
+			version = lockedVersion
+		}
+	}
+
+	metadata := ref.metadata()
+	if len(metadata) == 0 {
+		metadata = nil
+	}
+
+	return models.Package{
+		PackageManager: "conan",
+		PackageName:    ref.name,
+		Version:        version,
+		FilePath:       manifestFile,
+		Locations:      []models.Location{location},
+		Scopes:         []string{scope},
+		Metadata:       metadata,
+	}
+}
+
+// Parse implements the Parser interface for conanfile.txt files
+func (p *ConanfileTxtParser) Parse(manifestFile string) ([]models.Package, error) {
+	return parseConanfileTxt(manifestFile, loadLockedVersions(manifestFile))
+}
+
+// parseConanfileTxt reads the requirements of a conanfile.txt, resolving version ranges from the locked versions
+func parseConanfileTxt(manifestFile string, locked map[string]string) ([]models.Package, error) {
+	file, err := os.Open(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+	defer file.Close()
+
+	var packages []models.Package
+	section := ""
+	scanner := bufio.NewScanner(file)
+	for lineNum := 0; scanner.Scan(); lineNum++ {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		// # only starts comments at the start of a line, references use it for revisions
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "[") && strings.HasSuffix(trimmed, "]") && !strings.Contains(trimmed, "/") {
+			section = strings.TrimSpace(trimmed[1 : len(trimmed)-1])
+			continue
+		}
+		if !requirementSections[section] {
+			continue
+		}
+
+		ref, ok := parseReference(trimmed)
+		if !ok {
+			continue
+		}
+		start := strings.Index(line, trimmed)
+		location := models.Location{Line: lineNum, StartIndex: start, EndIndex: start + len(trimmed)}
+		packages = append(packages, newPackage(ref, manifestFile, location, section, locked))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+	return packages, nil
+}
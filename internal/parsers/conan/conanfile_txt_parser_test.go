@@ -0,0 +1,51 @@
+package conan
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestConanfileTxtParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/conanfile.txt"
+
+	packages, err := (&ConanfileTxtParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Version ranges are resolved from the conan.lock next to conanfile.txt
+	expectedPackages := []models.Package{
+		{PackageManager: "conan", PackageName: "zlib", Version: "1.2.13", FilePath: manifestFile, Locations: []models.Location{{Line: 1, StartIndex: 0, EndIndex: 11}}},
+		{PackageManager: "conan", PackageName: "openssl", Version: "3.2.0", FilePath: manifestFile, Locations: []models.Location{{Line: 2, StartIndex: 0, EndIndex: 18}}},
+		{PackageManager: "conan", PackageName: "boost", Version: "1.83.0", FilePath: manifestFile, Locations: []models.Location{{Line: 3, StartIndex: 0, EndIndex: 24}}},
+		{PackageManager: "conan", PackageName: "fmt", Version: "10.1.1", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 0, EndIndex: 43}}},
+		{PackageManager: "conan", PackageName: "cmake", Version: "3.27.7", FilePath: manifestFile, Locations: []models.Location{{Line: 7, StartIndex: 0, EndIndex: 12}}},
+		{PackageManager: "conan", PackageName: "gtest", Version: "1.14.0", FilePath: manifestFile, Locations: []models.Location{{Line: 11, StartIndex: 0, EndIndex: 12}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	wantScopes := map[string]string{"zlib": "requires", "cmake": "tool_requires", "gtest": "test_requires"}
+	for _, pkg := range packages {
+		if want, ok := wantScopes[pkg.PackageName]; ok && !reflect.DeepEqual(pkg.Scopes, []string{want}) {
+			t.Errorf("%s Scopes: got %v, want [%s]", pkg.PackageName, pkg.Scopes, want)
+		}
+	}
+
+	wantMetadata := map[string]map[string]string{
+		"zlib":  nil,
+		"boost": {"user": "acme", "channel": "stable"},
+		"fmt":   {"rrev": "e7f3e2b0b47e6ecd4a5a1de6c3bd4c8f"},
+	}
+	for _, pkg := range packages {
+		if want, ok := wantMetadata[pkg.PackageName]; ok && !reflect.DeepEqual(pkg.Metadata, want) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, want)
+		}
+	}
+}
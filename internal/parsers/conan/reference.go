@@ -0,0 +1,72 @@
+package conan
+
+import (
+	"strings"
+)
+
+// Conan file names
+const (
+	ConanfileTxt = "conanfile.txt"
+	ConanfilePy  = "conanfile.py"
+	ConanLock    = "conan.lock"
+)
+
+// reference is a Conan recipe reference, name/version[@user[/channel]][#revision]
+type reference struct {
+	name     string
+	version  string
+	user     string
+	channel  string
+	revision string
+}
+
+// parseReference splits a recipe reference, e.g. boost/1.83.0@acme/stable#e7f3... or openssl/[>=3.0 <4].
+// Lock files append the time of the revision, e.g. zlib/1.2.13#e377...%1697628577.123, which is dropped.
+func parseReference(text string) (reference, bool) {
+	text = strings.TrimSpace(text)
+	text, _, _ = strings.Cut(text, "%")
+
+	var ref reference
+	text, ref.revision, _ = strings.Cut(text, "#")
+
+	// Version ranges may contain @ and /, e.g. [>=1.0 <2, include_prerelease], so the version is cut first
+	name, rest, found := strings.Cut(text, "/")
+	if !found || name == "" {
+		return reference{}, false
+	}
+	ref.name = strings.TrimSpace(name)
+	if strings.HasPrefix(rest, "[") {
+		if end := strings.Index(rest, "]"); end >= 0 {
+			ref.version, rest = rest[:end+1], rest[end+1:]
+		}
+	} else {
+		ref.version, rest, _ = strings.Cut(rest, "@")
+		rest = "@" + rest
+	}
+	if userChannel, found := strings.CutPrefix(rest, "@"); found {
+		ref.user, ref.channel, _ = strings.Cut(userChannel, "/")
+	}
+	ref.version = strings.TrimSpace(ref.version)
+	return ref, ref.version != ""
+}
+
+// isRange reports whether the version of a reference is a version range, e.g. [>=1.0 <2]
+func (r reference) isRange() bool {
+	return strings.HasPrefix(r.version, "[")
+}
+
+// metadata returns the user, channel and recipe revision of a reference, the qualifiers of its purl
+func (r reference) metadata() map[string]string {
+	metadata := make(map[string]string)
+	// The _ user and channel of Conan 2 stand for none
+	if r.user != "" && r.user != "_" {
+		metadata["user"] = r.user
+	}
+	if r.channel != "" && r.channel != "_" {
+		metadata["channel"] = r.channel
+	}
+	if r.revision != "" {
+		metadata["rrev"] = r.revision
+	}
+	return metadata
+}
@@ -0,0 +1,33 @@
+package conan
+
+import (
+	"testing"
+)
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		text string
+		want reference
+		ok   bool
+	}{
+		{"zlib/1.2.13", reference{name: "zlib", version: "1.2.13"}, true},
+		{"boost/1.83.0@acme/stable#e7f3", reference{name: "boost", version: "1.83.0", user: "acme", channel: "stable", revision: "e7f3"}, true},
+		{"openssl/[>=3.0 <4]", reference{name: "openssl", version: "[>=3.0 <4]"}, true},
+		{"pkg/[>1 <2]@user/channel", reference{name: "pkg", version: "[>1 <2]", user: "user", channel: "channel"}, true},
+		{"zlib/1.2.13#e377%1697628577.0", reference{name: "zlib", version: "1.2.13", revision: "e377"}, true},
+		{"CMakeDeps", reference{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseReference(tt.text)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("parseReference(%q) = %+v, %v; want %+v, %v", tt.text, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestReferenceMetadata(t *testing.T) {
+	ref, _ := parseReference("fmt/10.1.1@_/_")
+	if metadata := ref.metadata(); len(metadata) != 0 {
+		t.Errorf("The _ user and channel must be dropped, got %v", metadata)
+	}
+}
@@ -0,0 +1,164 @@
+package conda
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/internal/yamlutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// CondaLockParser implements parsing of conda-lock lock files (conda-lock.yml)
+type CondaLockParser struct{}
+
+// packageManagers maps the managers of conda-lock.yml to the package managers of the packages
+var packageManagers = map[string]string{
+	"conda": "conda",
+	"pip":   "pypi",
+}
+
+// archiveExtensions are the extensions of conda package archives
+var archiveExtensions = []string{".conda", ".tar.bz2"}
+
+// archiveMetadata reads the channel and build string from the url of a conda package archive,
+// e.g. https://conda.anaconda.org/conda-forge/linux-64/numpy-1.26.4-py311h64a7726_0.conda
+func archiveMetadata(metadata map[string]string, archiveURL, name, version string) {
+	parsed, err := url.Parse(archiveURL)
+	if err != nil || parsed.Path == "" {
+		return
+	}
+	dir, file := path.Split(parsed.Path)
+	channelPath := path.Dir(strings.TrimSuffix(dir, "/"))
+
+	// Channels hosted on anaconda.org are named by their path, other channels by their url
+	if parsed.Host == "conda.anaconda.org" {
+		metadata["channel"] = strings.TrimPrefix(channelPath, "/")
+	} else if channelPath != "/" && channelPath != "." {
+		parsed.Path, parsed.RawQuery, parsed.Fragment = channelPath, "", ""
+		metadata["channel"] = parsed.String()
+	}
+
+	for _, extension := range archiveExtensions {
+		if base, ok := strings.CutSuffix(file, extension); ok {
+			if build, ok := strings.CutPrefix(base, name+"-"+version+"-"); ok && build != "" {
+				metadata["build"] = build
+			}
+			break
+		}
+	}
+}
+
+// loadDeclaredPackages reads the environment files a lock file was created from and returns the keys of their packages
+func loadDeclaredPackages(lockFile string, sources []string) map[string]bool {
+	var declared map[string]bool
+	for _, source := range sources {
+		if ext := filepath.Ext(source); ext != ".yml" && ext != ".yaml" {
+			continue
+		}
+		if !filepath.IsAbs(source) {
+			source = filepath.Join(filepath.Dir(lockFile), source)
+		}
+		packages, err := parseEnvironment(source, nil)
+		if err != nil {
+			continue
+		}
+		if declared == nil {
+			declared = make(map[string]bool)
+		}
+		for _, pkg := range packages {
+			declared[lockKey(pkg.PackageManager, pkg.PackageName)] = true
+		}
+	}
+	return declared
+}
+
+// Parse implements the Parser interface for conda-lock.yml files
+func (p *CondaLockParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	doc, err := yamlutil.Parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without the environment files the lock file was created from, no package is known to be transitive
+	var sources []string
+	_, lockMetadata := yamlutil.Lookup(doc.Root, "metadata")
+	_, sourceList := yamlutil.Lookup(lockMetadata, "sources")
+	if sourceList != nil {
+		for _, source := range sourceList.Content {
+			sources = append(sources, source.Value)
+		}
+	}
+	declared := loadDeclaredPackages(manifestFile, sources)
+
+	var packages []models.Package
+	_, lockedPackages := yamlutil.Lookup(doc.Root, "package")
+	if lockedPackages == nil {
+		return nil, nil
+	}
+	for _, locked := range lockedPackages.Content {
+		nameKey, nameValue := yamlutil.Lookup(locked, "name")
+		manager, ok := packageManagers[yamlutil.Value(locked, "manager")]
+		if nameKey == nil || nameValue.Value == "" || !ok {
+			continue
+		}
+		name, version := nameValue.Value, yamlutil.Value(locked, "version")
+
+		locations := []models.Location{doc.EntryLocation(nameKey, nameValue)}
+		if versionKey, versionValue := yamlutil.Lookup(locked, "version"); versionKey != nil {
+			locations = append(locations, doc.EntryLocation(versionKey, versionValue))
+		}
+
+		// Packages are locked once per platform, e.g. linux-64 and osx-arm64
+		metadata := make(map[string]string)
+		if platform := yamlutil.Value(locked, "platform"); platform != "" {
+			metadata["platform"] = platform
+		}
+		if manager == "conda" {
+			archiveMetadata(metadata, yamlutil.Value(locked, "url"), name, version)
+		}
+		if yamlutil.Value(locked, "optional") == "true" {
+			metadata["optional"] = "true"
+		}
+		if len(metadata) == 0 {
+			metadata = nil
+		}
+
+		var scopes []string
+		if category := yamlutil.Value(locked, "category"); category != "" {
+			scopes = []string{category}
+		}
+
+		var dependencies []string
+		_, requires := yamlutil.Lookup(locked, "dependencies")
+		for _, pair := range yamlutil.Pairs(requires) {
+			dependencies = append(dependencies, pair.Key.Value)
+		}
+		sort.Strings(dependencies)
+
+		_, hash := yamlutil.Lookup(locked, "hash")
+		packages = append(packages, models.Package{
+			PackageManager: manager,
+			PackageName:    name,
+			Version:        version,
+			FilePath:       manifestFile,
+			Locations:      locations,
+			Scopes:         scopes,
+			Transitive:     declared != nil && !declared[lockKey(manager, name)],
+			Hashes:         pkgutil.HexSRIHash("sha256", yamlutil.Value(hash, "sha256")),
+			Dependencies:   dependencies,
+			Metadata:       metadata,
+		})
+	}
+	return packages, nil
+}
@@ -0,0 +1,66 @@
+package conda
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestCondaLockParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/conda-lock.yml"
+
+	packages, err := (&CondaLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "conda", PackageName: "numpy", Version: "1.26.4", FilePath: manifestFile, Locations: []models.Location{{Line: 12, StartIndex: 2, EndIndex: 13}, {Line: 13, StartIndex: 2, EndIndex: 17}}},
+		{PackageManager: "conda", PackageName: "pandas", Version: "2.1.4", FilePath: manifestFile, Locations: []models.Location{{Line: 25, StartIndex: 2, EndIndex: 14}, {Line: 26, StartIndex: 2, EndIndex: 16}}},
+		{PackageManager: "conda", PackageName: "python-dateutil", Version: "2.8.2", FilePath: manifestFile, Locations: []models.Location{{Line: 38, StartIndex: 2, EndIndex: 23}, {Line: 39, StartIndex: 2, EndIndex: 16}}},
+		{PackageManager: "pypi", PackageName: "rich", Version: "13.7.0", FilePath: manifestFile, Locations: []models.Location{{Line: 50, StartIndex: 2, EndIndex: 12}, {Line: 51, StartIndex: 2, EndIndex: 17}}},
+		{PackageManager: "conda", PackageName: "pytest", Version: "7.4.3", FilePath: manifestFile, Locations: []models.Location{{Line: 62, StartIndex: 2, EndIndex: 14}, {Line: 63, StartIndex: 2, EndIndex: 16}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	// Packages missing from environment.yml, the source of the lock file, are dependencies of dependencies
+	for _, pkg := range packages {
+		want := pkg.PackageName == "python-dateutil" || pkg.PackageName == "pytest"
+		if pkg.Transitive != want {
+			t.Errorf("%s Transitive: got %v, want %v", pkg.PackageName, pkg.Transitive, want)
+		}
+	}
+
+	if want := map[string]string{"platform": "linux-64", "channel": "conda-forge", "build": "py311h64a7726_0"}; !reflect.DeepEqual(packages[0].Metadata, want) {
+		t.Errorf("numpy Metadata: got %v, want %v", packages[0].Metadata, want)
+	}
+	if want := []string{"sha256-P0Nl4Rso4kTJW6hXmUKwgCdhunuzHAJvUNGp6pxygUk="}; !reflect.DeepEqual(packages[0].Hashes, want) {
+		t.Errorf("numpy Hashes: got %v, want %v", packages[0].Hashes, want)
+	}
+	if want := []string{"numpy", "python-dateutil"}; !reflect.DeepEqual(packages[1].Dependencies, want) {
+		t.Errorf("pandas Dependencies: got %v, want %v", packages[1].Dependencies, want)
+	}
+	if want := map[string]string{"platform": "linux-64"}; !reflect.DeepEqual(packages[3].Metadata, want) {
+		t.Errorf("rich Metadata: got %v, want %v", packages[3].Metadata, want)
+	}
+	if want := []string{"dev"}; !reflect.DeepEqual(packages[4].Scopes, want) {
+		t.Errorf("pytest Scopes: got %v, want %v", packages[4].Scopes, want)
+	}
+	if packages[4].Metadata["optional"] != "true" {
+		t.Errorf("pytest must be optional, got %v", packages[4].Metadata)
+	}
+}
+
+func TestArchiveMetadata(t *testing.T) {
+	metadata := make(map[string]string)
+	archiveMetadata(metadata, "https://repo.example.com/conda/main/linux-64/zlib-1.2.13-h5eee18b_0.tar.bz2", "zlib", "1.2.13")
+	if want := map[string]string{"channel": "https://repo.example.com/conda/main", "build": "h5eee18b_0"}; !reflect.DeepEqual(metadata, want) {
+		t.Errorf("archiveMetadata: got %v, want %v", metadata, want)
+	}
+}
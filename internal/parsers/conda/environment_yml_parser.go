@@ -0,0 +1,173 @@
+package conda
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Checkmarx/manifest-parser/internal/parsers/pypi"
+	"github.com/Checkmarx/manifest-parser/internal/yamlutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// CondaEnvironmentParser implements parsing of conda environment files (environment.yml)
+type CondaEnvironmentParser struct{}
+
+const (
+	// EnvironmentYml is the file name of conda environment files
+	EnvironmentYml = "environment.yml"
+	// CondaLockYml is the file name of conda-lock lock files
+	CondaLockYml = "conda-lock.yml"
+)
+
+// pipSection is the entry of the dependencies that lists the requirements installed with pip
+const pipSection = "pip"
+
+// lockKey identifies a package of a package manager regardless of the case of its name
+func lockKey(manager, name string) string {
+	return manager + "/" + strings.ToLower(name)
+}
+
+// loadLockedVersions reads the conda-lock.yml next to an environment file and maps packages to their locked versions
+func loadLockedVersions(manifestFile string) map[string]string {
+	lockFile := filepath.Join(filepath.Dir(manifestFile), CondaLockYml)
+	if _, err := os.Stat(lockFile); err != nil {
+		return nil
+	}
+	packages, err := (&CondaLockParser{}).Parse(lockFile)
+	if err != nil {
+		return nil
+	}
+
+	// Packages are locked once per platform, the first platform is used
+	locked := make(map[string]string)
+	for _, pkg := range packages {
+		key := lockKey(pkg.PackageManager, pkg.PackageName)
+		if _, ok := locked[key]; !ok {
+			locked[key] = pkg.Version
+		}
+	}
+	return locked
+}
+
+// condaPackage creates the package of a conda match spec, e.g. conda-forge::numpy=1.26.*=py311*
+func condaPackage(manifestFile string, doc *yamlutil.Document, node *yaml.Node, locked map[string]string) (models.Package, bool) {
+	spec, ok := parseMatchSpec(node.Value)
+	if !ok {
+		return models.Package{}, false
+	}
+
+	// Exact versions pin the package, everything else is resolved by conda-lock.yml
+	version := spec.exactVersion()
+	if lockedVersion, ok := locked[lockKey("conda", spec.name)]; ok && version == "" {
+		version = lockedVersion
+	}
+	if version == "" {
+		version = "latest"
+	}
+
+	metadata := make(map[string]string)
+	if spec.channel != "" {
+		metadata["channel"] = spec.channel
+	}
+	if spec.subdir != "" {
+		metadata["subdir"] = spec.subdir
+	}
+	if spec.build != "" {
+		metadata["build"] = spec.build
+	}
+	if len(metadata) == 0 {
+		metadata = nil
+	}
+
+	return models.Package{
+		PackageManager: "conda",
+		PackageName:    spec.name,
+		Version:        version,
+		FilePath:       manifestFile,
+		Locations:      []models.Location{doc.NodeLocation(node)},
+		Metadata:       metadata,
+	}, true
+}
+
+// pipPackage creates the package of a pip requirement, which is parsed the way requirements.txt is
+func pipPackage(manifestFile string, node *yaml.Node, locked map[string]string) (models.Package, bool) {
+	requirement, ok := pypi.ParseRequirement(node.Value)
+	if !ok {
+		return models.Package{}, false
+	}
+
+	version := requirement.Version
+	if lockedVersion, ok := locked[lockKey("pypi", requirement.Name)]; ok && version == "latest" {
+		version = lockedVersion
+	}
+
+	// The columns of the requirement are relative to the value, which starts after the quote of quoted values
+	column := node.Column - 1
+	if node.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle) != 0 {
+		column++
+	}
+
+	return models.Package{
+		PackageManager: "pypi",
+		PackageName:    requirement.Name,
+		Version:        version,
+		FilePath:       manifestFile,
+		Locations: []models.Location{{
+			Line:       node.Line - 1,
+			StartIndex: column + requirement.StartIndex,
+			EndIndex:   column + requirement.EndIndex,
+		}},
+		Scopes: []string{pipSection},
+	}, true
+}
+
+// parseEnvironment reads the dependencies of an environment file, resolving versions with the locked versions
+func parseEnvironment(manifestFile string, locked map[string]string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	doc, err := yamlutil.Parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var packages []models.Package
+	_, dependencies := yamlutil.Lookup(doc.Root, "dependencies")
+	if dependencies == nil || dependencies.Kind != yaml.SequenceNode {
+		return nil, nil
+	}
+	for _, item := range dependencies.Content {
+		switch item.Kind {
+		case yaml.ScalarNode:
+			if pkg, ok := condaPackage(manifestFile, doc, item, locked); ok {
+				packages = append(packages, pkg)
+			}
+		case yaml.MappingNode:
+			// - pip: [requests==2.31.0, ...]
+			_, requirements := yamlutil.Lookup(item, pipSection)
+			if requirements == nil || requirements.Kind != yaml.SequenceNode {
+				continue
+			}
+			for _, requirement := range requirements.Content {
+				if requirement.Kind != yaml.ScalarNode {
+					continue
+				}
+				if pkg, ok := pipPackage(manifestFile, requirement, locked); ok {
+					packages = append(packages, pkg)
+				}
+			}
+		}
+	}
+	return packages, nil
+}
+
+// Parse implements the Parser interface for environment.yml files
+func (p *CondaEnvironmentParser) Parse(manifestFile string) ([]models.Package, error) {
+	return parseEnvironment(manifestFile, loadLockedVersions(manifestFile))
+}
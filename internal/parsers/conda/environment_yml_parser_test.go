@@ -0,0 +1,81 @@
+package conda
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestCondaEnvironmentParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/environment.yml"
+
+	packages, err := (&CondaEnvironmentParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Fuzzy versions, wildcards and constraints are resolved by the conda-lock.yml next to the environment file,
+	// python=3.11 matches 3.11.* and is not locked there
+	expectedPackages := []models.Package{
+		{PackageManager: "conda", PackageName: "python", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 4, EndIndex: 15}}},
+		{PackageManager: "conda", PackageName: "numpy", Version: "1.26.4", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 4, EndIndex: 23}}},
+		{PackageManager: "conda", PackageName: "pandas", Version: "2.1.4", FilePath: manifestFile, Locations: []models.Location{{Line: 7, StartIndex: 4, EndIndex: 28}}},
+		{PackageManager: "conda", PackageName: "scipy", Version: "1.11.4", FilePath: manifestFile, Locations: []models.Location{{Line: 8, StartIndex: 4, EndIndex: 39}}},
+		{PackageManager: "conda", PackageName: "matplotlib-base", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 9, StartIndex: 4, EndIndex: 34}}},
+		{PackageManager: "conda", PackageName: "pytorch", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 10, StartIndex: 4, EndIndex: 50}}},
+		{PackageManager: "conda", PackageName: "pip", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 11, StartIndex: 4, EndIndex: 7}}},
+		{PackageManager: "pypi", PackageName: "requests", Version: "2.31.0", FilePath: manifestFile, Locations: []models.Location{{Line: 13, StartIndex: 8, EndIndex: 24}}},
+		{PackageManager: "pypi", PackageName: "httpx", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 14, StartIndex: 9, EndIndex: 20}}},
+		{PackageManager: "pypi", PackageName: "rich", Version: "13.7.0", FilePath: manifestFile, Locations: []models.Location{{Line: 15, StartIndex: 8, EndIndex: 12}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	wantMetadata := map[string]map[string]string{
+		"numpy":           {"build": "py311*"},
+		"pandas":          {"channel": "conda-forge"},
+		"scipy":           {"channel": "conda-forge", "subdir": "linux-64"},
+		"matplotlib-base": {"build": "py311*"},
+		"pytorch":         {"channel": "pytorch", "build": "*cuda*"},
+	}
+	for _, pkg := range packages {
+		if want := wantMetadata[pkg.PackageName]; !reflect.DeepEqual(pkg.Metadata, want) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, want)
+		}
+		if wantScopes := []string{"pip"}; pkg.PackageManager == "pypi" && !reflect.DeepEqual(pkg.Scopes, wantScopes) {
+			t.Errorf("%s Scopes: got %v, want %v", pkg.PackageName, pkg.Scopes, wantScopes)
+		}
+	}
+}
+
+func TestCondaEnvironmentParser_NoLockFile(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "environment.yml")
+	content := `dependencies:
+  - numpy=1.26.*
+  - conda-forge::scipy==1.11.4
+  - pip:
+    - requests>=2.31
+`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write environment.yml: %v", err)
+	}
+
+	packages, err := (&CondaEnvironmentParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "conda", PackageName: "numpy", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 1, StartIndex: 4, EndIndex: 16}}},
+		{PackageManager: "conda", PackageName: "scipy", Version: "1.11.4", FilePath: manifestFile, Locations: []models.Location{{Line: 2, StartIndex: 4, EndIndex: 30}}},
+		{PackageManager: "pypi", PackageName: "requests", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 6, EndIndex: 20}}},
+	}
+	testdata.ValidatePackages(t, packages, expectedPackages)
+}
@@ -0,0 +1,102 @@
+package conda
+
+import (
+	"regexp"
+	"strings"
+)
+
+// matchSpec is a conda package specification, e.g. conda-forge::numpy=1.26.*=py311* or numpy[version='>=1.26',build=py311*]
+type matchSpec struct {
+	name    string
+	version string
+	build   string
+	channel string
+	subdir  string
+	// exact is set for specs that pin the version, ==1.26.4 or a version with a build string such as =1.26.4=py311_0
+	exact bool
+}
+
+// bracketOptionPattern matches the key=value options of the bracket syntax, e.g. version='>=1.26,<2'
+var bracketOptionPattern = regexp.MustCompile(`(\w+)\s*=\s*(?:'([^']*)'|"([^"]*)"|([^,\]]*))`)
+
+// subdirPattern matches the platform subdirectories of channels, e.g. linux-64, osx-arm64 or noarch
+var subdirPattern = regexp.MustCompile(`^(noarch|(linux|osx|win|emscripten|wasi|zos|freebsd)-\w+)$`)
+
+// parseMatchSpec splits a match spec into its parts. Versions may be written as =1.26 (fuzzy, 1.26.*), ==1.26.4,
+// with a build string as =1.26.*=py311* or separated by spaces as 1.26.* py311*, or as a constraint such as >=1.26,<2.
+func parseMatchSpec(text string) (matchSpec, bool) {
+	spec := strings.TrimSpace(text)
+	var parsed matchSpec
+
+	if start := strings.Index(spec, "["); start >= 0 && strings.HasSuffix(spec, "]") {
+		for _, option := range bracketOptionPattern.FindAllStringSubmatch(spec[start+1:len(spec)-1], -1) {
+			value := option[2] + option[3] + option[4]
+			switch option[1] {
+			case "version":
+				parsed.version = strings.TrimSpace(value)
+				if exact, found := strings.CutPrefix(parsed.version, "=="); found {
+					parsed.version, parsed.exact = strings.TrimSpace(exact), true
+				}
+			case "build":
+				parsed.build = strings.TrimSpace(value)
+			case "channel":
+				parsed.channel = strings.TrimSpace(value)
+			case "subdir":
+				parsed.subdir = strings.TrimSpace(value)
+			}
+		}
+		spec = strings.TrimSpace(spec[:start])
+	}
+
+	if i := strings.LastIndex(spec, "::"); i >= 0 {
+		parsed.channel = spec[:i]
+		if j := strings.LastIndex(parsed.channel, "/"); j >= 0 && subdirPattern.MatchString(parsed.channel[j+1:]) {
+			parsed.channel, parsed.subdir = parsed.channel[:j], parsed.channel[j+1:]
+		}
+		spec = spec[i+2:]
+	}
+
+	end := strings.IndexAny(spec, " =<>!~")
+	if end < 0 {
+		end = len(spec)
+	}
+	parsed.name = spec[:end]
+	if parsed.name == "" {
+		return matchSpec{}, false
+	}
+
+	rest := strings.TrimSpace(spec[end:])
+	switch {
+	case strings.HasPrefix(rest, "=="):
+		parsed.version = strings.TrimSpace(rest[2:])
+		parsed.exact = true
+	case strings.HasPrefix(rest, "="):
+		// =1.26 matches 1.26.*, only the full =version=build form pins the version
+		parsed.version, parsed.build, _ = strings.Cut(rest[1:], "=")
+		parsed.exact = parsed.build != ""
+	case rest != "" && !strings.ContainsAny(rest[:1], "<>!~"):
+		// name version [build]
+		fields := strings.Fields(rest)
+		parsed.version = fields[0]
+		if len(fields) > 1 {
+			parsed.build = fields[1]
+			parsed.exact = true
+		}
+	case rest != "":
+		parsed.version = rest
+	}
+	// Wildcards and constraints match several versions, whatever form they are written in
+	if parsed.version == "" || strings.ContainsAny(parsed.version, "*<>!~,| ") {
+		parsed.exact = false
+	}
+	return parsed, true
+}
+
+// exactVersion returns the version a spec pins, or "" for fuzzy versions, wildcards and constraints such as =1.26,
+// 1.26.* or >=1.26
+func (m matchSpec) exactVersion() string {
+	if !m.exact {
+		return ""
+	}
+	return m.version
+}
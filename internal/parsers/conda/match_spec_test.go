@@ -0,0 +1,40 @@
+package conda
+
+import (
+	"testing"
+)
+
+func TestParseMatchSpec(t *testing.T) {
+	tests := []struct {
+		text  string
+		want  matchSpec
+		exact string
+	}{
+		{"python", matchSpec{name: "python"}, ""},
+		{"python=3.11", matchSpec{name: "python", version: "3.11"}, ""},
+		{"python=3.11.9=h955ad1f_0", matchSpec{name: "python", version: "3.11.9", build: "h955ad1f_0", exact: true}, "3.11.9"},
+		{"numpy=1.26.*=py311*", matchSpec{name: "numpy", version: "1.26.*", build: "py311*"}, ""},
+		{"numpy==1.26.4", matchSpec{name: "numpy", version: "1.26.4", exact: true}, "1.26.4"},
+		{"numpy 1.26.4 py311h64a7726_0", matchSpec{name: "numpy", version: "1.26.4", build: "py311h64a7726_0", exact: true}, "1.26.4"},
+		{"numpy 1.26.4", matchSpec{name: "numpy", version: "1.26.4"}, ""},
+		{"pandas>=2.1,<3", matchSpec{name: "pandas", version: ">=2.1,<3"}, ""},
+		{"conda-forge::pandas", matchSpec{name: "pandas", channel: "conda-forge"}, ""},
+		{"conda-forge/noarch::six=1.16.0", matchSpec{name: "six", version: "1.16.0", channel: "conda-forge", subdir: "noarch"}, ""},
+		{"conda-forge/noarch::six==1.16.0", matchSpec{name: "six", version: "1.16.0", channel: "conda-forge", subdir: "noarch", exact: true}, "1.16.0"},
+		{"pytorch::pytorch[version='>=2.1',build=*cuda*]", matchSpec{name: "pytorch", version: ">=2.1", build: "*cuda*", channel: "pytorch"}, ""},
+		{"numpy[version='==1.26.4']", matchSpec{name: "numpy", version: "1.26.4", exact: true}, "1.26.4"},
+	}
+	for _, tt := range tests {
+		got, ok := parseMatchSpec(tt.text)
+		if !ok || got != tt.want {
+			t.Errorf("parseMatchSpec(%q) = %+v, %v; want %+v", tt.text, got, ok, tt.want)
+		}
+		if exact := got.exactVersion(); exact != tt.exact {
+			t.Errorf("exactVersion(%q) = %q; want %q", tt.text, exact, tt.exact)
+		}
+	}
+
+	if _, ok := parseMatchSpec("conda-forge::"); ok {
+		t.Errorf("A spec without a name must be rejected")
+	}
+}
@@ -0,0 +1,121 @@
+package deno
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/jsonutil"
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// DenoJsonParser implements parsing of Deno configuration files (deno.json and deno.jsonc)
+type DenoJsonParser struct{}
+
+// denoJSON represents the import map of the deno.json structure
+type denoJSON struct {
+	Imports map[string]string            `json:"imports"`
+	Scopes  map[string]map[string]string `json:"scopes"`
+}
+
+// loadLockedVersions reads the deno.lock next to a configuration file and maps specifiers to their locked versions.
+// Specifiers are also mapped by their registry and name, for those recorded without their version requirement.
+func loadLockedVersions(manifestFile string) map[string]string {
+	lockFile := filepath.Join(filepath.Dir(manifestFile), DenoLock)
+	if _, err := os.Stat(lockFile); err != nil {
+		return nil
+	}
+	_, lock, err := readDenoLock(lockFile)
+	if err != nil {
+		return nil
+	}
+	sections, _ := lock.sections()
+
+	locked := make(map[string]string)
+	for text, resolution := range sections.Specifiers {
+		spec, ok := parseSpecifier(text)
+		if !ok {
+			continue
+		}
+		_, version := splitPackage(strings.SplitN(resolve(spec, resolution), ":", 2)[1])
+		locked[text] = version
+		if byName := spec.registry + ":" + spec.name; sections.Specifiers[byName] == "" {
+			locked[byName] = version
+		}
+	}
+	return locked
+}
+
+// Parse implements the Parser interface for deno.json and deno.jsonc files
+func (p *DenoJsonParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	// deno.jsonc, and deno.json as well, may contain comments and trailing commas
+	content = jsonutil.Standardize(content)
+	var manifest denoJSON
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	locations, err := jsonutil.FindLocations(content)
+	if err != nil {
+		return nil, err
+	}
+
+	locked := loadLockedVersions(manifestFile)
+
+	var packages []models.Package
+	addImports := func(imports map[string]string, path []string, scopes []string) {
+		for key, target := range imports {
+			// Imports of URLs and local paths are not packages, e.g. "@/": "./src/"
+			spec, ok := parseSpecifier(target)
+			if !ok {
+				continue
+			}
+
+			// Exact versions pin the package, everything else is resolved by deno.lock
+			version := spec.exactVersion()
+			if lockedVersion, ok := locked[spec.key()]; ok && version == "" {
+				version = lockedVersion
+			}
+			if version == "" {
+				version = "latest"
+			}
+
+			var metadata map[string]string
+			if alias := strings.TrimSuffix(key, "/"); alias != spec.name {
+				metadata = map[string]string{"alias": alias}
+			}
+
+			var importLocations []models.Location
+			if location, ok := locations.Find(append(append([]string{}, path...), key)...); ok {
+				importLocations = []models.Location{location}
+			}
+
+			packages = append(packages, models.Package{
+				PackageManager: spec.registry,
+				PackageName:    spec.name,
+				Version:        version,
+				FilePath:       manifestFile,
+				Locations:      importLocations,
+				Scopes:         scopes,
+				Metadata:       metadata,
+			})
+		}
+	}
+
+	addImports(manifest.Imports, []string{"imports"}, nil)
+	// Scoped imports apply to the modules below a prefix, which scopes them
+	for prefix, imports := range manifest.Scopes {
+		addImports(imports, []string{"scopes", prefix}, []string{prefix})
+	}
+
+	// Imports are read from objects, the packages are put back in the order of the configuration file
+	pkgutil.SortByFirstLine(packages)
+	return packages, nil
+}
@@ -0,0 +1,70 @@
+package deno
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestDenoJsonParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/deno.json"
+
+	packages, err := (&DenoJsonParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Ranges are resolved by the deno.lock next to deno.json, URL and path imports are not packages
+	expectedPackages := []models.Package{
+		{PackageManager: "jsr", PackageName: "@std/assert", Version: "1.0.2", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 4, EndIndex: 44}}},
+		{PackageManager: "jsr", PackageName: "@std/http", Version: "1.0.9", FilePath: manifestFile, Locations: []models.Location{{Line: 7, StartIndex: 4, EndIndex: 42}}},
+		{PackageManager: "npm", PackageName: "chalk", Version: "5.3.0", FilePath: manifestFile, Locations: []models.Location{{Line: 8, StartIndex: 4, EndIndex: 31}}},
+		{PackageManager: "npm", PackageName: "preact", Version: "10.19.3", FilePath: manifestFile, Locations: []models.Location{{Line: 9, StartIndex: 4, EndIndex: 36}}},
+		{PackageManager: "npm", PackageName: "preact-render-to-string", Version: "6.3.1", FilePath: manifestFile, Locations: []models.Location{{Line: 10, StartIndex: 4, EndIndex: 50}}},
+		{PackageManager: "npm", PackageName: "zod", Version: "3.22.4", FilePath: manifestFile, Locations: []models.Location{{Line: 16, StartIndex: 6, EndIndex: 28}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	if want := map[string]string{"alias": "render"}; !reflect.DeepEqual(packages[4].Metadata, want) {
+		t.Errorf("preact-render-to-string Metadata: got %v, want %v", packages[4].Metadata, want)
+	}
+	if packages[0].Metadata != nil {
+		t.Errorf("@std/assert Metadata: got %v, want nil", packages[0].Metadata)
+	}
+	if want := []string{"https://deno.land/x/"}; !reflect.DeepEqual(packages[5].Scopes, want) {
+		t.Errorf("zod Scopes: got %v, want %v", packages[5].Scopes, want)
+	}
+}
+
+func TestDenoJsonParser_JsoncWithoutLockFile(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "deno.jsonc")
+	content := `{
+  /* Dependencies */
+  "imports": {
+    "@std/path": "jsr:@std/path@^1.0.0", // range
+    "hono": "npm:hono@4.0.5",
+  },
+}`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write deno.jsonc: %v", err)
+	}
+
+	packages, err := (&DenoJsonParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "jsr", PackageName: "@std/path", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 3, StartIndex: 4, EndIndex: 40}}},
+		{PackageManager: "npm", PackageName: "hono", Version: "4.0.5", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 4, EndIndex: 28}}},
+	}
+	testdata.ValidatePackages(t, packages, expectedPackages)
+}
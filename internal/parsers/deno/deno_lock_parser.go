@@ -0,0 +1,182 @@
+package deno
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/jsonutil"
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// DenoLockParser implements parsing of Deno lock files (deno.lock), version 3 and later
+type DenoLockParser struct{}
+
+// lockedPackage is a package of the jsr or npm section of deno.lock
+type lockedPackage struct {
+	Integrity string `json:"integrity"`
+	// Dependencies are an object of names in version 3 npm sections and a list of specifiers otherwise
+	Dependencies json.RawMessage `json:"dependencies"`
+}
+
+// lockedPackages are the sections of deno.lock that resolve specifiers to packages
+type lockedPackages struct {
+	Specifiers map[string]string        `json:"specifiers"`
+	Jsr        map[string]lockedPackage `json:"jsr"`
+	Npm        map[string]lockedPackage `json:"npm"`
+}
+
+// workspaceDependencies are the specifiers deno.json and package.json of a workspace member depend on
+type workspaceDependencies struct {
+	Dependencies []string `json:"dependencies"`
+	PackageJSON  struct {
+		Dependencies []string `json:"dependencies"`
+	} `json:"packageJson"`
+}
+
+// denoLock represents the deno.lock structure. Version 3 nests the package sections in packages.
+type denoLock struct {
+	Version string `json:"version"`
+	lockedPackages
+	Packages  *lockedPackages `json:"packages"`
+	Workspace struct {
+		workspaceDependencies
+		Members map[string]workspaceDependencies `json:"members"`
+	} `json:"workspace"`
+}
+
+// sections returns the package sections and the path of their members in the document
+func (l *denoLock) sections() (lockedPackages, []string) {
+	if l.Packages != nil {
+		return *l.Packages, []string{"packages"}
+	}
+	return l.lockedPackages, nil
+}
+
+// declaredSpecifiers returns the specifiers the workspace depends on directly
+func (l *denoLock) declaredSpecifiers() []string {
+	var declared []string
+	add := func(deps workspaceDependencies) {
+		declared = append(declared, deps.Dependencies...)
+		declared = append(declared, deps.PackageJSON.Dependencies...)
+	}
+	add(l.Workspace.workspaceDependencies)
+	for _, member := range l.Workspace.Members {
+		add(member)
+	}
+	return declared
+}
+
+// resolve returns the locked package a specifier resolves to, e.g. jsr:@std/assert@1.0.2.
+// Version 3 records the package, later versions only the version.
+func resolve(spec specifier, resolution string) string {
+	if scheme, _, ok := strings.Cut(resolution, ":"); ok && registries[scheme] {
+		return resolution
+	}
+	return spec.registry + ":" + spec.name + "@" + resolution
+}
+
+// readDenoLock reads and decodes a deno.lock file
+func readDenoLock(lockFile string) ([]byte, *denoLock, error) {
+	content, err := os.ReadFile(lockFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+	var lock denoLock
+	if err := json.Unmarshal(content, &lock); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	return content, &lock, nil
+}
+
+// dependencyNames returns the sorted names of the dependencies of a locked package
+func dependencyNames(raw json.RawMessage) []string {
+	var names []string
+	var byName map[string]string
+	var specifiers []string
+	switch {
+	case json.Unmarshal(raw, &byName) == nil:
+		for name := range byName {
+			names = append(names, name)
+		}
+	case json.Unmarshal(raw, &specifiers) == nil:
+		// jsr:@std/internal@^1.0.1 or, in npm sections, ansi-styles and ansi-styles@6.2.1
+		for _, text := range specifiers {
+			if spec, ok := parseSpecifier(text); ok {
+				names = append(names, spec.name)
+			} else if name, _ := splitPackage(text); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sriHash converts the hex encoded SHA-256 of a JSR package into its subresource integrity form, npm integrities already are
+func sriHash(integrity string) []string {
+	if integrity == "" {
+		return nil
+	}
+	if strings.Contains(integrity, "-") {
+		return []string{integrity}
+	}
+	return pkgutil.HexSRIHash("sha256", integrity)
+}
+
+// Parse implements the Parser interface for deno.lock files
+func (p *DenoLockParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, lock, err := readDenoLock(manifestFile)
+	if err != nil {
+		return nil, err
+	}
+	locations, err := jsonutil.FindLocations(content)
+	if err != nil {
+		return nil, err
+	}
+	sections, path := lock.sections()
+
+	// Without workspace dependencies, no package is known to be transitive
+	var direct map[string]bool
+	for _, text := range lock.declaredSpecifiers() {
+		spec, ok := parseSpecifier(text)
+		resolution, resolved := sections.Specifiers[text]
+		if !ok || !resolved {
+			continue
+		}
+		if direct == nil {
+			direct = make(map[string]bool)
+		}
+		direct[resolve(spec, resolution)] = true
+	}
+
+	var packages []models.Package
+	addSection := func(registry string, section map[string]lockedPackage) {
+		for key, locked := range section {
+			name, version := splitPackage(key)
+			var pkgLocations []models.Location
+			if location, ok := locations.Find(append(append([]string{}, path...), registry, key)...); ok {
+				pkgLocations = []models.Location{location}
+			}
+			packages = append(packages, models.Package{
+				PackageManager: registry,
+				PackageName:    name,
+				Version:        version,
+				FilePath:       manifestFile,
+				Locations:      pkgLocations,
+				Transitive:     direct != nil && !direct[registry+":"+key],
+				Hashes:         sriHash(locked.Integrity),
+				Dependencies:   dependencyNames(locked.Dependencies),
+			})
+		}
+	}
+	addSection("jsr", sections.Jsr)
+	addSection("npm", sections.Npm)
+
+	// The sections are read from objects, the packages are put back in the order of the lock file
+	pkgutil.SortByFirstLine(packages)
+	return packages, nil
+}
@@ -0,0 +1,97 @@
+package deno
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestDenoLockParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/deno.lock"
+
+	packages, err := (&DenoLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "jsr", PackageName: "@std/assert", Version: "1.0.2", FilePath: manifestFile, Locations: []models.Location{{Line: 12, StartIndex: 4, EndIndex: 23}}},
+		{PackageManager: "jsr", PackageName: "@std/http", Version: "1.0.9", FilePath: manifestFile, Locations: []models.Location{{Line: 18, StartIndex: 4, EndIndex: 21}}},
+		{PackageManager: "jsr", PackageName: "@std/internal", Version: "1.0.1", FilePath: manifestFile, Locations: []models.Location{{Line: 21, StartIndex: 4, EndIndex: 25}}},
+		{PackageManager: "npm", PackageName: "chalk", Version: "5.3.0", FilePath: manifestFile, Locations: []models.Location{{Line: 26, StartIndex: 4, EndIndex: 17}}},
+		{PackageManager: "npm", PackageName: "preact-render-to-string", Version: "6.3.1", FilePath: manifestFile, Locations: []models.Location{{Line: 29, StartIndex: 4, EndIndex: 50}}},
+		{PackageManager: "npm", PackageName: "preact", Version: "10.19.3", FilePath: manifestFile, Locations: []models.Location{{Line: 36, StartIndex: 4, EndIndex: 20}}},
+		{PackageManager: "npm", PackageName: "pretty-format", Version: "3.8.0", FilePath: manifestFile, Locations: []models.Location{{Line: 39, StartIndex: 4, EndIndex: 25}}},
+		{PackageManager: "npm", PackageName: "zod", Version: "3.22.4", FilePath: manifestFile, Locations: []models.Location{{Line: 42, StartIndex: 4, EndIndex: 16}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	// Packages the workspace does not depend on are dependencies of dependencies
+	for _, pkg := range packages {
+		want := pkg.PackageName == "@std/internal" || pkg.PackageName == "pretty-format"
+		if pkg.Transitive != want {
+			t.Errorf("%s Transitive: got %v, want %v", pkg.PackageName, pkg.Transitive, want)
+		}
+	}
+
+	if want := []string{"sha256-zKzsMylYEm3qzrXGP/jU6vn17Q61s6gAn0nJuPxqfyI="}; !reflect.DeepEqual(packages[0].Hashes, want) {
+		t.Errorf("@std/assert Hashes: got %v, want %v", packages[0].Hashes, want)
+	}
+	if want := []string{"@std/internal"}; !reflect.DeepEqual(packages[0].Dependencies, want) {
+		t.Errorf("@std/assert Dependencies: got %v, want %v", packages[0].Dependencies, want)
+	}
+	if want := []string{"preact", "pretty-format"}; !reflect.DeepEqual(packages[4].Dependencies, want) {
+		t.Errorf("preact-render-to-string Dependencies: got %v, want %v", packages[4].Dependencies, want)
+	}
+}
+
+func TestDenoLockParser_Version3(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "deno.lock")
+	content := `{
+  "version": "3",
+  "packages": {
+    "specifiers": {
+      "npm:chalk@^5": "npm:chalk@5.3.0"
+    },
+    "npm": {
+      "chalk@5.3.0": {"integrity": "sha512-abc=", "dependencies": {}},
+      "supports-color@9.4.0": {"integrity": "sha512-def=", "dependencies": {"has-flag": "has-flag@5.0.1"}}
+    }
+  },
+  "remote": {},
+  "workspace": {"dependencies": ["npm:chalk@^5"]}
+}`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write deno.lock: %v", err)
+	}
+
+	packages, err := (&DenoLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "npm", PackageName: "chalk", Version: "5.3.0", FilePath: manifestFile, Locations: []models.Location{{Line: 7, StartIndex: 6, EndIndex: 70}}},
+		{PackageManager: "npm", PackageName: "supports-color", Version: "9.4.0", FilePath: manifestFile, Locations: []models.Location{{Line: 8, StartIndex: 6, EndIndex: 106}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	if packages[0].Transitive || !packages[1].Transitive {
+		t.Errorf("Only supports-color must be transitive")
+	}
+	if want := []string{"has-flag"}; !reflect.DeepEqual(packages[1].Dependencies, want) {
+		t.Errorf("supports-color Dependencies: got %v, want %v", packages[1].Dependencies, want)
+	}
+}
@@ -0,0 +1,92 @@
+package deno
+
+import (
+	"strings"
+)
+
+const (
+	// DenoJson is the file name of Deno configuration files
+	DenoJson = "deno.json"
+	// DenoJsonc is the file name of Deno configuration files with comments
+	DenoJsonc = "deno.jsonc"
+	// DenoLock is the file name of Deno lock files
+	DenoLock = "deno.lock"
+)
+
+// registries are the schemes of specifiers, which name the package managers of the packages
+var registries = map[string]bool{
+	"npm": true,
+	"jsr": true,
+}
+
+// specifier is a package specifier of an npm or JSR package, e.g. jsr:@std/assert@^1.0.0 or npm:preact@10.19.0/hooks
+type specifier struct {
+	registry string
+	name     string
+	version  string
+}
+
+// parseSpecifier splits a specifier into its registry, package name and version requirement, dropping a subpath
+func parseSpecifier(text string) (specifier, bool) {
+	scheme, rest, ok := strings.Cut(text, ":")
+	if !ok || !registries[scheme] {
+		return specifier{}, false
+	}
+	rest = strings.TrimPrefix(rest, "/")
+
+	// The name of scoped packages contains a slash, e.g. @std/assert
+	nameStart := 0
+	if strings.HasPrefix(rest, "@") {
+		slash := strings.Index(rest, "/")
+		if slash < 0 {
+			return specifier{}, false
+		}
+		nameStart = slash + 1
+	}
+	nameEnd := len(rest)
+	if end := strings.IndexAny(rest[nameStart:], "@/"); end >= 0 {
+		nameEnd = nameStart + end
+	}
+	if nameEnd == nameStart {
+		return specifier{}, false
+	}
+
+	parsed := specifier{registry: scheme, name: rest[:nameEnd]}
+	if version, ok := strings.CutPrefix(rest[nameEnd:], "@"); ok {
+		parsed.version, _, _ = strings.Cut(version, "/")
+	}
+	return parsed, true
+}
+
+// key returns the specifier the way deno.lock records it, e.g. npm:preact@^10.19.0
+func (s specifier) key() string {
+	if s.version == "" {
+		return s.registry + ":" + s.name
+	}
+	return s.registry + ":" + s.name + "@" + s.version
+}
+
+// exactVersion returns the version a specifier pins, or "" for ranges such as ^1.0.0 and missing versions
+func (s specifier) exactVersion() string {
+	if s.version == "" || strings.ContainsAny(s.version, "^~<>=*| ") {
+		return ""
+	}
+	for _, part := range strings.Split(s.version, ".") {
+		if part == "x" || part == "X" {
+			return ""
+		}
+	}
+	return s.version
+}
+
+// splitPackage splits the name and version of a locked package, e.g. @std/assert@1.0.2 or preact-render-to-string@6.3.1_preact@10.19.3.
+// The peer dependencies an npm package was resolved with follow its version after an underscore and are dropped.
+func splitPackage(text string) (name, version string) {
+	at := strings.Index(text[min(1, len(text)):], "@")
+	if at < 0 {
+		return text, ""
+	}
+	name, version = text[:at+1], text[at+2:]
+	version, _, _ = strings.Cut(version, "_")
+	return name, version
+}
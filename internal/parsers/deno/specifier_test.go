@@ -0,0 +1,47 @@
+package deno
+
+import (
+	"testing"
+)
+
+func TestParseSpecifier(t *testing.T) {
+	tests := []struct {
+		text  string
+		want  specifier
+		ok    bool
+		exact string
+	}{
+		{"jsr:@std/assert@^1.0.0", specifier{registry: "jsr", name: "@std/assert", version: "^1.0.0"}, true, ""},
+		{"jsr:/@std/http@1.0.9/", specifier{registry: "jsr", name: "@std/http", version: "1.0.9"}, true, "1.0.9"},
+		{"npm:preact@10.19.3/hooks", specifier{registry: "npm", name: "preact", version: "10.19.3"}, true, "10.19.3"},
+		{"npm:chalk", specifier{registry: "npm", name: "chalk"}, true, ""},
+		{"npm:lodash@4.x", specifier{registry: "npm", name: "lodash", version: "4.x"}, true, ""},
+		{"npm:esbuild@0.20.0-experimental", specifier{registry: "npm", name: "esbuild", version: "0.20.0-experimental"}, true, "0.20.0-experimental"},
+		{"https://deno.land/x/oak@v12.6.1/", specifier{}, false, ""},
+		{"jsr:@std", specifier{}, false, ""},
+	}
+	for _, tt := range tests {
+		got, ok := parseSpecifier(tt.text)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("parseSpecifier(%q) = %+v, %v; want %+v, %v", tt.text, got, ok, tt.want, tt.ok)
+		}
+		if exact := got.exactVersion(); exact != tt.exact {
+			t.Errorf("exactVersion(%q) = %q; want %q", tt.text, exact, tt.exact)
+		}
+	}
+}
+
+func TestSplitPackage(t *testing.T) {
+	tests := []struct {
+		text, name, version string
+	}{
+		{"@std/assert@1.0.2", "@std/assert", "1.0.2"},
+		{"preact-render-to-string@6.3.1_preact@10.19.3", "preact-render-to-string", "6.3.1"},
+		{"ansi-styles", "ansi-styles", ""},
+	}
+	for _, tt := range tests {
+		if name, version := splitPackage(tt.text); name != tt.name || version != tt.version {
+			t.Errorf("splitPackage(%q) = %q, %q; want %q, %q", tt.text, name, version, tt.name, tt.version)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package docker
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Checkmarx/manifest-parser/internal/yamlutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// ComposeParser implements parsing of the images of Docker Compose files (compose.yaml and docker-compose.yml)
+type ComposeParser struct{}
+
+// envFile is the file Compose reads the variables of the project from
+const envFile = ".env"
+
+// loadEnvFile reads the variables of the .env file next to a Compose file, e.g. TAG=1.4.2
+func loadEnvFile(manifestFile string) map[string]string {
+	file, err := os.Open(filepath.Join(filepath.Dir(manifestFile), envFile))
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	variables := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, ok := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		if !ok {
+			continue
+		}
+		variables[strings.TrimSpace(name)] = unquote(strings.TrimSpace(value))
+	}
+	return variables
+}
+
+// Parse implements the Parser interface for Docker Compose files
+func (p *ComposeParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	doc, err := yamlutil.Parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	variables := loadEnvFile(manifestFile)
+	lookup := func(name string) (string, bool) {
+		value, ok := variables[name]
+		return value, ok
+	}
+
+	var packages []models.Package
+	_, services := yamlutil.Lookup(doc.Root, "services")
+	for _, service := range yamlutil.Pairs(services) {
+		// Services built from a Dockerfile without an image name are covered by the Dockerfile
+		imageKey, imageValue := yamlutil.Lookup(service.Value, "image")
+		if imageKey == nil || imageValue.Kind != yaml.ScalarNode {
+			continue
+		}
+		ref, ok := parseImageReference(expandVariables(imageValue.Value, lookup))
+		if !ok {
+			continue
+		}
+
+		metadata := ref.metadata()
+		metadata["service"] = service.Key.Value
+		if platform := yamlutil.Value(service.Value, "platform"); platform != "" {
+			metadata["platform"] = platform
+		}
+
+		packages = append(packages, models.Package{
+			PackageManager: packageManager,
+			PackageName:    ref.name,
+			Version:        ref.version(),
+			FilePath:       manifestFile,
+			Locations:      []models.Location{doc.EntryLocation(imageKey, imageValue)},
+			Hashes:         ref.hashes(),
+			Metadata:       metadata,
+		})
+	}
+	return packages, nil
+}
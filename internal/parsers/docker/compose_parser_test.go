@@ -0,0 +1,70 @@
+package docker
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestComposeParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/docker-compose.yml"
+
+	packages, err := (&ComposeParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Services built without an image name have no package
+	expectedPackages := []models.Package{
+		{PackageManager: "docker", PackageName: "postgres", Version: "16.2", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 4, EndIndex: 24}}},
+		{PackageManager: "docker", PackageName: "redis", Version: "sha256:2c1ef2c0c9c64e6e1f6bf1b4c8f0e4a8a3b5c1d2e3f4a5b6c7d8e9f0a1b2c3d4", FilePath: manifestFile, Locations: []models.Location{{Line: 9, StartIndex: 4, EndIndex: 90}}},
+		{PackageManager: "docker", PackageName: "nginx", Version: "1.25-alpine", FilePath: manifestFile, Locations: []models.Location{{Line: 11, StartIndex: 4, EndIndex: 58}}},
+		{PackageManager: "docker", PackageName: "localhost:5000/acme/worker", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 13, StartIndex: 4, EndIndex: 37}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	wantMetadata := []map[string]string{
+		{"service": "db", "platform": "linux/amd64"},
+		{"service": "cache", "digest": "sha256:2c1ef2c0c9c64e6e1f6bf1b4c8f0e4a8a3b5c1d2e3f4a5b6c7d8e9f0a1b2c3d4"},
+		{"service": "proxy"},
+		{"service": "worker", "registry": "localhost:5000"},
+	}
+	for i, pkg := range packages {
+		if !reflect.DeepEqual(pkg.Metadata, wantMetadata[i]) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, wantMetadata[i])
+		}
+	}
+}
+
+func TestComposeParser_EnvFile(t *testing.T) {
+	dir := t.TempDir()
+	manifestFile := filepath.Join(dir, "compose.yaml")
+	content := `services:
+  api:
+    image: ${REGISTRY}/api:${TAG:-dev}
+`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write compose.yaml: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".env"), []byte("# images\nREGISTRY=registry.acme.io\nexport TAG=\"3.1.0\"\n"), 0644); err != nil {
+		t.Fatalf("Failed to write .env: %v", err)
+	}
+
+	packages, err := (&ComposeParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "docker", PackageName: "registry.acme.io/api", Version: "3.1.0", FilePath: manifestFile, Locations: []models.Location{{Line: 2, StartIndex: 4, EndIndex: 38}}},
+	}
+	testdata.ValidatePackages(t, packages, expectedPackages)
+}
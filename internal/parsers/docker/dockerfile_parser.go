@@ -0,0 +1,223 @@
+package docker
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// DockerfileParser implements parsing of the base images of Dockerfiles and Containerfiles
+type DockerfileParser struct{}
+
+// escapeDirectivePattern matches the parser directive that changes the escape character, e.g. # escape=`
+var escapeDirectivePattern = regexp.MustCompile("^#\\s*escape\\s*=\\s*([\\\\`])\\s*$")
+
+// scratch is the empty image that stages may start from
+const scratch = "scratch"
+
+// token is a word of an instruction with its position
+type token struct {
+	text  string
+	line  int
+	start int
+	end   int
+}
+
+// escapeCharacter returns the escape character set by the parser directives at the top of a Dockerfile
+func escapeCharacter(lines []string) byte {
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "#") {
+			break
+		}
+		if match := escapeDirectivePattern.FindStringSubmatch(trimmed); match != nil {
+			return match[1][0]
+		}
+	}
+	return '\\'
+}
+
+// readInstructions splits a Dockerfile into the words of its instructions, joining lines continued with the escape character.
+// Comments are skipped, as are the bodies of heredocs, e.g. RUN <<EOF ... EOF.
+func readInstructions(lines []string) [][]token {
+	escape := escapeCharacter(lines)
+
+	var instructions [][]token
+	var current []token
+	var heredocs []string
+	for i, line := range lines {
+		line = strings.TrimRight(line, " \t\r")
+		if len(heredocs) > 0 {
+			if strings.TrimSpace(line) == heredocs[0] {
+				heredocs = heredocs[1:]
+			}
+			continue
+		}
+		if trimmed := strings.TrimSpace(line); trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		continued := line[len(line)-1] == escape
+		if continued {
+			line = line[:len(line)-1]
+		}
+		for _, field := range fieldPositions(line) {
+			current = append(current, token{text: line[field[0]:field[1]], line: i, start: field[0], end: field[1]})
+		}
+		if continued {
+			continue
+		}
+
+		for _, word := range current {
+			if delimiter, ok := strings.CutPrefix(word.text, "<<"); ok {
+				delimiter = strings.Trim(strings.TrimPrefix(delimiter, "-"), `"'`)
+				if delimiter != "" {
+					heredocs = append(heredocs, delimiter)
+				}
+			}
+		}
+		if len(current) > 0 {
+			instructions = append(instructions, current)
+		}
+		current = nil
+	}
+	if len(current) > 0 {
+		instructions = append(instructions, current)
+	}
+	return instructions
+}
+
+// fieldPositions returns the start and end columns of the words of a line
+func fieldPositions(line string) [][2]int {
+	var fields [][2]int
+	start := -1
+	for i := 0; i <= len(line); i++ {
+		if i == len(line) || line[i] == ' ' || line[i] == '\t' {
+			if start >= 0 {
+				fields = append(fields, [2]int{start, i})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	return fields
+}
+
+// instructionLocation locates an instruction by its image: from the start of the instruction, or of the image
+// when the instruction is continued on its line, to the last word on the line of the image
+func instructionLocation(instruction []token, image token) models.Location {
+	location := models.Location{Line: image.line, StartIndex: image.start, EndIndex: image.end}
+	if instruction[0].line == image.line {
+		location.StartIndex = instruction[0].start
+	}
+	for _, word := range instruction {
+		if word.line == image.line {
+			location.EndIndex = word.end
+		}
+	}
+	return location
+}
+
+// unquote removes the quotes around the value of an ARG, e.g. ARG VERSION="3.12"
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+// Parse implements the Parser interface for Dockerfiles and Containerfiles
+func (p *DockerfileParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	// Only the arguments declared before the first FROM can be used in FROM instructions
+	args := make(map[string]string)
+	lookup := func(name string) (string, bool) {
+		value, ok := args[name]
+		return value, ok
+	}
+	stages := make(map[string]bool)
+	seenFrom := false
+
+	var packages []models.Package
+	for _, instruction := range readInstructions(strings.Split(string(content), "\n")) {
+		switch strings.ToUpper(instruction[0].text) {
+		case "ARG":
+			if seenFrom {
+				continue
+			}
+			for _, word := range instruction[1:] {
+				name, value, hasValue := strings.Cut(word.text, "=")
+				if hasValue {
+					args[name] = expandVariables(unquote(value), lookup)
+				} else if _, ok := args[name]; !ok {
+					args[name] = ""
+				}
+			}
+
+		case "FROM":
+			// FROM [--platform=<platform>] <image> [AS <name>]
+			seenFrom = true
+			var platform, stage string
+			var image *token
+			for i, word := range instruction[1:] {
+				switch {
+				case strings.HasPrefix(word.text, "--"):
+					if value, ok := strings.CutPrefix(word.text, "--platform="); ok {
+						platform = value
+					}
+				case image == nil:
+					image = &instruction[1+i]
+				case strings.EqualFold(word.text, "AS") && i+2 < len(instruction):
+					stage = instruction[i+2].text
+				}
+			}
+			if image == nil {
+				continue
+			}
+
+			// Stages built earlier in the same file are not images, e.g. FROM build AS test
+			name := expandVariables(image.text, lookup)
+			isStage := stages[strings.ToLower(name)] || strings.EqualFold(name, scratch)
+			if stage != "" {
+				stages[strings.ToLower(stage)] = true
+			}
+			if isStage {
+				continue
+			}
+			ref, ok := parseImageReference(name)
+			if !ok {
+				continue
+			}
+
+			metadata := ref.metadata()
+			if platform != "" {
+				metadata["platform"] = platform
+			}
+			if stage != "" {
+				metadata["stage"] = stage
+			}
+			if len(metadata) == 0 {
+				metadata = nil
+			}
+
+			packages = append(packages, models.Package{
+				PackageManager: packageManager,
+				PackageName:    ref.name,
+				Version:        ref.version(),
+				FilePath:       manifestFile,
+				Locations:      []models.Location{instructionLocation(instruction, *image)},
+				Hashes:         ref.hashes(),
+				Metadata:       metadata,
+			})
+		}
+	}
+	return packages, nil
+}
@@ -0,0 +1,70 @@
+package docker
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestDockerfileParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/Dockerfile"
+
+	packages, err := (&DockerfileParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Stages, scratch and the body of the heredoc are not images
+	expectedPackages := []models.Package{
+		{PackageManager: "docker", PackageName: "node", Version: "20.11-alpine", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 0, EndIndex: 67}}},
+		{PackageManager: "docker", PackageName: "ghcr.io/acme/runtime-base", Version: "1.4.2", FilePath: manifestFile, Locations: []models.Location{{Line: 18, StartIndex: 0, EndIndex: 107}}},
+		{PackageManager: "docker", PackageName: "gcr.io/distroless/nodejs20-debian12", Version: "nonroot", FilePath: manifestFile, Locations: []models.Location{{Line: 22, StartIndex: 0, EndIndex: 67}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	wantMetadata := []map[string]string{
+		{"platform": "$BUILDPLATFORM", "stage": "build"},
+		{"registry": "ghcr.io", "digest": "sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", "stage": "runtime"},
+		{"registry": "gcr.io"},
+	}
+	for i, pkg := range packages {
+		if !reflect.DeepEqual(pkg.Metadata, wantMetadata[i]) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, wantMetadata[i])
+		}
+	}
+	if want := []string{"sha256-n4bQgYhMfWWaL+qgxVrQFaO/TxsrC4Is0V1sFbDwCgg="}; !reflect.DeepEqual(packages[1].Hashes, want) {
+		t.Errorf("runtime-base Hashes: got %v, want %v", packages[1].Hashes, want)
+	}
+}
+
+func TestDockerfileParser_EscapeDirective(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "Containerfile")
+	content := "# escape=`\n" +
+		"ARG BASE=\"mcr.microsoft.com/windows/servercore\"\n" +
+		"FROM `\n" +
+		"    ${BASE}:ltsc2022\n" +
+		"RUN dir c:\\\n" +
+		"from python:3.12-slim as app\n"
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write Containerfile: %v", err)
+	}
+
+	packages, err := (&DockerfileParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "docker", PackageName: "mcr.microsoft.com/windows/servercore", Version: "ltsc2022", FilePath: manifestFile, Locations: []models.Location{{Line: 3, StartIndex: 4, EndIndex: 20}}},
+		{PackageManager: "docker", PackageName: "python", Version: "3.12-slim", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 0, EndIndex: 28}}},
+	}
+	testdata.ValidatePackages(t, packages, expectedPackages)
+}
@@ -0,0 +1,169 @@
+package docker
+
+import (
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// packageManager is the package manager of container images
+const packageManager = "docker"
+
+// imageReference is a reference to a container image, e.g. ghcr.io/acme/api:1.4.2@sha256:9f86d0...
+type imageReference struct {
+	name     string
+	registry string
+	tag      string
+	digest   string
+}
+
+// parseImageReference splits an image reference into its repository, tag and digest.
+// The registry is the first component of the repository when it is a host, e.g. ghcr.io or localhost:5000.
+func parseImageReference(text string) (imageReference, bool) {
+	var ref imageReference
+	name, digest, _ := strings.Cut(text, "@")
+	ref.digest = digest
+	if colon := strings.LastIndex(name, ":"); colon > strings.LastIndex(name, "/") {
+		name, ref.tag = name[:colon], name[colon+1:]
+	}
+	// Variables that expanded to nothing leave empty components, e.g. ${REGISTRY}/api
+	if name == "" || strings.ContainsAny(name, " $") || strings.HasPrefix(name, "/") || strings.Contains(name, "//") {
+		return imageReference{}, false
+	}
+	ref.name = name
+
+	if host, _, ok := strings.Cut(name, "/"); ok && (strings.ContainsAny(host, ".:") || host == "localhost") {
+		ref.registry = host
+	}
+	return ref, true
+}
+
+// version returns the tag of an image, the digest of images pinned only by their digest, or the latest tag pulled by default
+func (r imageReference) version() string {
+	switch {
+	case r.tag != "":
+		return r.tag
+	case r.digest != "":
+		return r.digest
+	}
+	return "latest"
+}
+
+// metadata describes the registry and the digest of an image
+func (r imageReference) metadata() map[string]string {
+	metadata := make(map[string]string)
+	if r.registry != "" {
+		metadata["registry"] = r.registry
+	}
+	if r.digest != "" {
+		metadata["digest"] = r.digest
+	}
+	return metadata
+}
+
+// hashes converts a digest, e.g. sha256:9f86d0..., into its subresource integrity form
+func (r imageReference) hashes() []string {
+	algorithm, encoded, ok := strings.Cut(r.digest, ":")
+	if !ok {
+		return nil
+	}
+	return pkgutil.HexSRIHash(algorithm, encoded)
+}
+
+// NewImagePackage creates the package of an image reference, e.g. ghcr.io/acme/api:1.4.2, for manifests that refer to images.
+// It reports false for references that are not valid.
+func NewImagePackage(reference, manifestFile string, location models.Location) (models.Package, bool) {
+	ref, ok := parseImageReference(reference)
+	if !ok {
+		return models.Package{}, false
+	}
+	metadata := ref.metadata()
+	if len(metadata) == 0 {
+		metadata = nil
+	}
+	return models.Package{
+		PackageManager: packageManager,
+		PackageName:    ref.name,
+		Version:        ref.version(),
+		FilePath:       manifestFile,
+		Locations:      []models.Location{location},
+		Hashes:         ref.hashes(),
+		Metadata:       metadata,
+	}, true
+}
+
+// expandVariables substitutes $NAME, ${NAME}, ${NAME:-default} and ${NAME-default} in a value, $$ is a literal $.
+// Variables that are not defined expand to their default, or to nothing.
+func expandVariables(text string, lookup func(name string) (string, bool)) string {
+	var out strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] != '$' || i+1 == len(text) {
+			out.WriteByte(text[i])
+			continue
+		}
+		if text[i+1] == '$' {
+			out.WriteByte('$')
+			i++
+			continue
+		}
+
+		var name, fallback string
+		var hasFallback, setOnly bool
+		if text[i+1] == '{' {
+			end := strings.IndexByte(text[i:], '}')
+			if end < 0 {
+				out.WriteString(text[i:])
+				break
+			}
+			expression := text[i+2 : i+end]
+			i += end
+			name = expression
+			for _, operator := range []string{":-", "-", ":+", "+"} {
+				if before, after, ok := strings.Cut(expression, operator); ok && isVariableName(before) {
+					name, fallback, hasFallback = before, after, true
+					setOnly = strings.HasSuffix(operator, "+")
+					break
+				}
+			}
+		} else {
+			end := i + 1
+			for end < len(text) && isVariableChar(text[end], end == i+1) {
+				end++
+			}
+			if end == i+1 {
+				out.WriteByte('$')
+				continue
+			}
+			name = text[i+1 : end]
+			i = end - 1
+		}
+
+		value, ok := lookup(name)
+		switch {
+		case setOnly:
+			// ${NAME:+value} expands to value only when NAME is set
+			if ok && value != "" {
+				out.WriteString(fallback)
+			}
+		case ok && value != "":
+			out.WriteString(value)
+		case hasFallback:
+			out.WriteString(fallback)
+		}
+	}
+	return out.String()
+}
+
+func isVariableName(name string) bool {
+	for i := 0; i < len(name); i++ {
+		if !isVariableChar(name[i], i == 0) {
+			return false
+		}
+	}
+	return name != ""
+}
+
+func isVariableChar(c byte, first bool) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || !first && c >= '0' && c <= '9'
+}
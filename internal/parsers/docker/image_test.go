@@ -0,0 +1,79 @@
+package docker
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestParseImageReference(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    imageReference
+		ok      bool
+		version string
+	}{
+		{"nginx", imageReference{name: "nginx"}, true, "latest"},
+		{"nginx:1.25-alpine", imageReference{name: "nginx", tag: "1.25-alpine"}, true, "1.25-alpine"},
+		{"localhost:5000/acme/api", imageReference{name: "localhost:5000/acme/api", registry: "localhost:5000"}, true, "latest"},
+		{"ghcr.io/acme/api:2.0@sha256:abc", imageReference{name: "ghcr.io/acme/api", registry: "ghcr.io", tag: "2.0", digest: "sha256:abc"}, true, "2.0"},
+		{"redis@sha256:abc", imageReference{name: "redis", digest: "sha256:abc"}, true, "sha256:abc"},
+		{"library/node:20", imageReference{name: "library/node", tag: "20"}, true, "20"},
+		{"/api:1.0", imageReference{}, false, "latest"},
+	}
+	for _, tt := range tests {
+		got, ok := parseImageReference(tt.text)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("parseImageReference(%q) = %+v, %v; want %+v, %v", tt.text, got, ok, tt.want, tt.ok)
+		}
+		if version := got.version(); version != tt.version {
+			t.Errorf("version(%q) = %q; want %q", tt.text, version, tt.version)
+		}
+	}
+}
+
+func TestExpandVariables(t *testing.T) {
+	variables := map[string]string{"TAG": "1.4.2", "EMPTY": ""}
+	lookup := func(name string) (string, bool) {
+		value, ok := variables[name]
+		return value, ok
+	}
+
+	tests := []struct {
+		text, want string
+	}{
+		{"api:$TAG", "api:1.4.2"},
+		{"api:${TAG}-slim", "api:1.4.2-slim"},
+		{"api:${MISSING:-latest}", "api:latest"},
+		{"api:${EMPTY-dev}", "api:dev"},
+		{"api${TAG:+-tagged}", "api-tagged"},
+		{"api${MISSING:+-tagged}", "api"},
+		{"api:$MISSING", "api:"},
+		{"price-$$5", "price-$5"},
+	}
+	for _, tt := range tests {
+		if got := expandVariables(tt.text, lookup); got != tt.want {
+			t.Errorf("expandVariables(%q) = %q; want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestNewImagePackage(t *testing.T) {
+	location := models.Location{Line: 3, StartIndex: 6, EndIndex: 30}
+	pkg, ok := NewImagePackage("ghcr.io/acme/api:2.0", "workflow.yml", location)
+	want := models.Package{
+		PackageManager: "docker",
+		PackageName:    "ghcr.io/acme/api",
+		Version:        "2.0",
+		FilePath:       "workflow.yml",
+		Locations:      []models.Location{location},
+		Metadata:       map[string]string{"registry": "ghcr.io"},
+	}
+	if !ok || !reflect.DeepEqual(pkg, want) {
+		t.Errorf("NewImagePackage: got %+v, %v; want %+v", pkg, ok, want)
+	}
+	if _, ok := NewImagePackage("/api", "workflow.yml", location); ok {
+		t.Errorf("NewImagePackage must reject invalid references")
+	}
+}
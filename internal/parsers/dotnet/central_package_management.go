@@ -0,0 +1,119 @@
+package dotnet
+
+import (
+	"log"
+	"path/filepath"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// centralPackages holds the package versions managed by a Directory.Packages.props file
+type centralPackages struct {
+	FilePath string
+	// versions maps lower-cased package ids to their <PackageVersion> declaration
+	versions map[string]models.Package
+	// globals lists the <GlobalPackageReference> items that apply to every project
+	globals []models.Package
+	// overrideEnabled reports whether projects may use VersionOverride
+	overrideEnabled bool
+}
+
+// loadCentralPackages locates the nearest Directory.Packages.props above the project and loads its versions.
+// Returns nil when central package management is not enabled for the project.
+func loadCentralPackages(projectFile string, props msbuildProperties) *centralPackages {
+	if enabled, _ := props.lookup("ManagePackageVersionsCentrally"); !strings.EqualFold(enabled, "true") {
+		return nil
+	}
+
+	absPath, err := filepath.Abs(projectFile)
+	if err != nil {
+		absPath = projectFile
+	}
+	propsFile := findFileAbove(filepath.Dir(absPath), DirectoryPackagesProps)
+	if propsFile == "" {
+		log.Printf("Central package management is enabled for %s but no %s was found", projectFile, DirectoryPackagesProps)
+		return nil
+	}
+
+	// Evaluate conditions in the props file for the framework and configuration of the project
+	parser := &DotnetDirectoryPackagesPropsParser{}
+	if tfm, ok := props.lookup("TargetFramework"); ok {
+		parser.TargetFramework = tfm
+	}
+	if configuration, ok := props.lookup("Configuration"); ok {
+		parser.Configuration = configuration
+	}
+	packages, err := parser.Parse(propsFile)
+	if err != nil {
+		log.Printf("Failed to load central package versions from %s: %v", propsFile, err)
+		return nil
+	}
+
+	overrideEnabled, _ := props.lookup("CentralPackageVersionOverrideEnabled")
+	central := &centralPackages{
+		FilePath:        propsFile,
+		versions:        make(map[string]models.Package),
+		overrideEnabled: !strings.EqualFold(overrideEnabled, "false"),
+	}
+
+	for _, pkg := range packages {
+		// Locations of the central declarations live in the props file, not in the project
+		for i := range pkg.Locations {
+			pkg.Locations[i].FilePath = propsFile
+		}
+
+		if pkg.Metadata["global"] == "true" {
+			central.globals = append(central.globals, pkg)
+			continue
+		}
+		central.versions[strings.ToLower(pkg.PackageName)] = pkg
+	}
+
+	return central
+}
+
+// resolveReference determines the version of a PackageReference under central package management.
+// It returns the version, the locations of the central version declaration and metadata describing the source.
+func (c *centralPackages) resolveReference(name, version, versionOverride string) (string, []models.Location, map[string]string) {
+	if versionOverride != "" {
+		if c.overrideEnabled {
+			return versionOverride, nil, map[string]string{"versionOverride": "true"}
+		}
+		log.Printf("VersionOverride for %s is ignored because CentralPackageVersionOverrideEnabled is false", name)
+	}
+
+	// Projects should not specify versions under central package management, keep the explicit one if they do
+	if version != "" && versionOverride == "" {
+		return version, nil, nil
+	}
+
+	central, ok := c.versions[strings.ToLower(name)]
+	if !ok {
+		log.Printf("No central version found for %s in %s", name, c.FilePath)
+		return version, nil, nil
+	}
+	return central.Version, central.Locations, map[string]string{"centralVersionFile": c.FilePath}
+}
+
+// globalReferences returns the global package references that are not already referenced by the project
+func (c *centralPackages) globalReferences(packages []models.Package, manifestFile string) []models.Package {
+	var globals []models.Package
+	for _, global := range c.globals {
+		referenced := false
+		for _, pkg := range packages {
+			if strings.EqualFold(pkg.PackageName, global.PackageName) {
+				referenced = true
+				break
+			}
+		}
+		if referenced {
+			continue
+		}
+
+		global.FilePath = manifestFile
+		global.Metadata = map[string]string{"global": "true", "centralVersionFile": c.FilePath}
+		globals = append(globals, global)
+	}
+	return globals
+}
@@ -0,0 +1,150 @@
+package dotnet
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+const centralPackagesProps = `<Project>
+  <PropertyGroup>
+    <ManagePackageVersionsCentrally>true</ManagePackageVersionsCentrally>
+  </PropertyGroup>
+  <ItemGroup>
+    <PackageVersion Include="Serilog" Version="3.1.1" />
+    <PackageVersion Include="Newtonsoft.Json" Version="13.0.3" />
+    <PackageVersion Include="Polly" Version="[8.0.0,9.0.0)" />
+  </ItemGroup>
+  <ItemGroup>
+    <GlobalPackageReference Include="Nerdbank.GitVersioning" Version="3.6.133" />
+  </ItemGroup>
+</Project>`
+
+func TestDotnetCsprojParser_CentralPackageManagement(t *testing.T) {
+	root := t.TempDir()
+	propsFile := filepath.Join(root, DirectoryPackagesProps)
+	writeFile(t, propsFile, centralPackagesProps)
+	project := filepath.Join(root, "src", "App", "App.csproj")
+	writeFile(t, project, `<Project Sdk="Microsoft.NET.Sdk">
+  <ItemGroup>
+    <PackageReference Include="Serilog" />
+    <PackageReference Include="newtonsoft.json" VersionOverride="13.0.1" />
+    <PackageReference Include="Polly" />
+    <PackageReference Include="Dapper" />
+  </ItemGroup>
+</Project>`)
+
+	packages, err := (&DotnetCsprojParser{}).Parse(project)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	testdata.ValidatePackages(t, packages, []models.Package{
+		{
+			PackageManager: "nuget",
+			PackageName:    "Serilog",
+			Version:        "3.1.1",
+			FilePath:       project,
+			Locations: []models.Location{
+				{Line: 2, StartIndex: 4, EndIndex: 42},
+				{Line: 5, StartIndex: 4, EndIndex: 56, FilePath: propsFile},
+			},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "newtonsoft.json",
+			Version:        "13.0.1",
+			FilePath:       project,
+			Locations:      []models.Location{{Line: 3, StartIndex: 4, EndIndex: 75}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Polly",
+			Version:        "latest",
+			FilePath:       project,
+			Locations: []models.Location{
+				{Line: 4, StartIndex: 4, EndIndex: 40},
+				{Line: 7, StartIndex: 4, EndIndex: 62, FilePath: propsFile},
+			},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Dapper",
+			Version:        "latest",
+			FilePath:       project,
+			Locations:      []models.Location{{Line: 5, StartIndex: 4, EndIndex: 41}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Nerdbank.GitVersioning",
+			Version:        "3.6.133",
+			FilePath:       project,
+			Locations:      []models.Location{{Line: 10, StartIndex: 4, EndIndex: 81, FilePath: propsFile}},
+		},
+	})
+
+	if got := packages[0].Metadata["centralVersionFile"]; got != propsFile {
+		t.Errorf("centralVersionFile: got %q, want %q", got, propsFile)
+	}
+	if got := packages[1].Metadata["versionOverride"]; got != "true" {
+		t.Errorf("versionOverride: got %q, want %q", got, "true")
+	}
+}
+
+func TestDotnetCsprojParser_CentralPackageManagementDisabled(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, DirectoryPackagesProps), centralPackagesProps)
+	project := filepath.Join(root, "App.csproj")
+	writeFile(t, project, `<Project Sdk="Microsoft.NET.Sdk">
+  <PropertyGroup>
+    <ManagePackageVersionsCentrally>false</ManagePackageVersionsCentrally>
+  </PropertyGroup>
+  <ItemGroup>
+    <PackageReference Include="Serilog" />
+  </ItemGroup>
+</Project>`)
+
+	packages, err := (&DotnetCsprojParser{}).Parse(project)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	testdata.ValidatePackages(t, packages, []models.Package{
+		{
+			PackageManager: "nuget",
+			PackageName:    "Serilog",
+			Version:        "latest",
+			FilePath:       project,
+			Locations:      []models.Location{{Line: 5, StartIndex: 4, EndIndex: 42}},
+		},
+	})
+}
+
+func TestDotnetCsprojParser_CentralVersionOverrideDisabled(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, DirectoryPackagesProps), centralPackagesProps)
+	writeFile(t, filepath.Join(root, DirectoryBuildProps), `<Project>
+  <PropertyGroup>
+    <CentralPackageVersionOverrideEnabled>false</CentralPackageVersionOverrideEnabled>
+  </PropertyGroup>
+</Project>`)
+	project := filepath.Join(root, "App.csproj")
+	writeFile(t, project, `<Project Sdk="Microsoft.NET.Sdk">
+  <ItemGroup>
+    <PackageReference Include="Serilog" VersionOverride="2.0.0" />
+  </ItemGroup>
+</Project>`)
+
+	packages, err := (&DotnetCsprojParser{}).Parse(project)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(packages) != 2 {
+		t.Fatalf("Expected 2 packages, got %d", len(packages))
+	}
+	if packages[0].Version != "3.1.1" {
+		t.Errorf("Version: got %q, want %q", packages[0].Version, "3.1.1")
+	}
+}
@@ -4,21 +4,34 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
+	"log"
+	"maps"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 
 	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
 )
 
-// DotnetCsprojParser implements parsing of .NET project files (.csproj)
-type DotnetCsprojParser struct{}
+// DotnetCsprojParser implements parsing of MSBuild project files (.csproj, .fsproj, .vbproj, .vcxproj, Directory.Build.props)
+type DotnetCsprojParser struct {
+	// TargetFramework selects the framework to evaluate MSBuild conditions for, e.g. "net48".
+	// When empty, packages of every framework are reported with their condition attached.
+	TargetFramework string
+	// Configuration selects the build configuration to evaluate MSBuild conditions for, e.g. "Release"
+	Configuration string
+}
 
 // PackageReference represents a package reference in the .csproj file
 type PackageReference struct {
 	Include       string `xml:"Include,attr"`
+	Update        string `xml:"Update,attr"`
+	Condition     string `xml:"Condition,attr"`
 	VersionAttr   string `xml:"Version,attr"`
 	VersionNested string `xml:"Version"`
+	// VersionOverride replaces the centrally managed version of the package
+	VersionOverride string `xml:"VersionOverride,attr"`
 }
 
 // PackageReferenceTag is the XML tag for package references in .csproj files
@@ -108,6 +121,28 @@ func computeLocations(lines []string, startLine int) []models.Location {
 	return locations
 }
 
+// findNthLine returns the line of the n-th (zero based) match of re, or 0 if there is none
+func findNthLine(lines []string, re *regexp.Regexp, n int) int {
+	for i, line := range lines {
+		if re.MatchString(line) {
+			if n == 0 {
+				return i
+			}
+			n--
+		}
+	}
+	return 0
+}
+
+// withMetadata sets a metadata entry, allocating the map when needed
+func withMetadata(metadata map[string]string, key, value string) map[string]string {
+	if metadata == nil {
+		metadata = make(map[string]string)
+	}
+	metadata[key] = value
+	return metadata
+}
+
 // Parse implements the Parser interface for .csproj files
 func (p *DotnetCsprojParser) Parse(manifestFile string) ([]models.Package, error) {
 	// Read the file content
@@ -120,9 +155,30 @@ func (p *DotnetCsprojParser) Parse(manifestFile string) ([]models.Package, error
 	strContent := string(content)
 	lines := strings.Split(strContent, "\n")
 
+	// Collect MSBuild properties from the project and its imports for $(Prop) substitution
+	props := loadProjectProperties(manifestFile, globalProperties(p.TargetFramework, p.Configuration))
+	evaluator := conditionEvaluator{props: props, baseDir: filepath.Dir(manifestFile)}
+	frameworks := props.targetFrameworks()
+	if p.TargetFramework != "" && len(frameworks) > 0 && !containsFold(frameworks, p.TargetFramework) {
+		log.Printf("Target framework %s is not one of the frameworks of %s: %s",
+			p.TargetFramework, manifestFile, strings.Join(frameworks, ";"))
+	}
+
+	// Link to Directory.Packages.props when central package management is enabled
+	central := loadCentralPackages(manifestFile, props)
+
+	// Floating versions and ranges are resolved through the lock file next to the project
+	lockedFrameworks := frameworks
+	if p.TargetFramework != "" {
+		lockedFrameworks = []string{p.TargetFramework}
+	}
+	locked := loadLockedVersions(manifestFile, props, lockedFrameworks)
+
 	// Create XML decoder
 	decoder := xml.NewDecoder(strings.NewReader(strContent))
 	var packages []models.Package
+	stack := &conditionStack{}
+	occurrences := make(map[string]int)
 
 	// Parse XML content
 	for {
@@ -137,54 +193,214 @@ func (p *DotnetCsprojParser) Parse(manifestFile string) ([]models.Package, error
 		// Process each element
 		switch elem := token.(type) {
 		case xml.StartElement:
-			if elem.Name.Local == PackageReferenceTag {
-				var pkgRef PackageReference
-				if err := decoder.DecodeElement(&pkgRef, &elem); err != nil {
-					return nil, fmt.Errorf("failed to decode PackageReference: %w", err)
+			switch elem.Name.Local {
+			case PackageReferenceTag:
+				// Package references are resolved below
+			case PackageDownloadTag, GlobalPackageReferenceTag:
+				var item PackageReference
+				if err := decoder.DecodeElement(&item, &elem); err != nil {
+					return nil, fmt.Errorf("failed to decode %s: %w", elem.Name.Local, err)
 				}
-
-				// Skip empty package names
-				if pkgRef.Include == "" {
-					continue
+				packages = append(packages, p.parseItem(elem.Name.Local, item, stack, evaluator, props, lines, occurrences, manifestFile)...)
+				continue
+			case SdkTag:
+				var sdk SdkReference
+				if err := decoder.DecodeElement(&sdk, &elem); err != nil {
+					return nil, fmt.Errorf("failed to decode Sdk: %w", err)
 				}
+				packages = append(packages, parseSdkElement(sdk, props, lines, occurrences, manifestFile)...)
+				continue
+			case ProjectTag:
+				if stack.parent() == nil {
+					packages = append(packages, projectSdkPackages(attributeValue(elem, SdkTag), lines, manifestFile)...)
+				}
+				stack.push(elem)
+				continue
+			default:
+				stack.push(elem)
+				continue
+			}
 
-				// Find line number
-				lineNum := 0
-				packagePattern := fmt.Sprintf(`PackageReference.*Include="%s"`, pkgRef.Include)
-				re := regexp.MustCompile(packagePattern)
+			var pkgRef PackageReference
+			if err := decoder.DecodeElement(&pkgRef, &elem); err != nil {
+				return nil, fmt.Errorf("failed to decode PackageReference: %w", err)
+			}
+
+			// Skip references excluded by their own or an enclosing condition
+			condition := stack.combined(pkgRef.Condition)
+			if evaluator.evaluate(condition) == conditionFalse {
+				occurrences[pkgRef.Include]++
+				continue
+			}
+
+			// Determine the version
+			version := pkgRef.VersionAttr
+			if version == "" {
+				version = pkgRef.VersionNested
+			}
+			version = props.resolve(version, manifestFile)
 
-				for i, line := range lines {
-					if re.MatchString(line) {
-						lineNum = i
-						break
+			// Update items change the version of a package that was already included
+			if pkgRef.Include == "" && pkgRef.Update != "" {
+				name := props.resolve(pkgRef.Update, manifestFile)
+				for i := range packages {
+					if strings.EqualFold(packages[i].PackageName, name) && version != "" {
+						packages[i].Version = parseVersion(version)
 					}
 				}
+				continue
+			}
 
-				// Skip if line not found
-				if lineNum == 0 {
-					continue
-				}
+			// Skip empty package names
+			if pkgRef.Include == "" {
+				continue
+			}
 
-				// Compute locations for both single-line and multi-line formats
-				locations := computeLocations(lines, lineNum)
+			// Find line number, the same package may be referenced under several conditions
+			packagePattern := fmt.Sprintf(`PackageReference.*Include="%s"`, regexp.QuoteMeta(pkgRef.Include))
+			re := regexp.MustCompile(packagePattern)
+			lineNum := findNthLine(lines, re, occurrences[pkgRef.Include])
+			occurrences[pkgRef.Include]++
 
-				// Determine the version
-				version := pkgRef.VersionAttr
-				if version == "" {
-					version = pkgRef.VersionNested
-				}
+			// Skip if line not found
+			if lineNum == 0 {
+				continue
+			}
+
+			// Compute locations for both single-line and multi-line formats
+			locations := computeLocations(lines, lineNum)
+			name := props.resolve(pkgRef.Include, manifestFile)
+
+			// Take the version from Directory.Packages.props under central package management
+			var metadata map[string]string
+			if central != nil {
+				var centralLocations []models.Location
+				versionOverride := props.resolve(pkgRef.VersionOverride, manifestFile)
+				version, centralLocations, metadata = central.resolveReference(name, version, versionOverride)
+				locations = append(locations, centralLocations...)
+			}
+
+			resolvedVersion := parseVersion(version)
+			if lockedVersion, ok := locked[strings.ToLower(name)]; ok && resolvedVersion == "latest" {
+				resolvedVersion = lockedVersion
+			}
 
-				// Create package entry
-				packages = append(packages, models.Package{
-					PackageManager: "nuget",
-					PackageName:    pkgRef.Include,
-					Version:        parseVersion(version),
-					FilePath:       manifestFile,
-					Locations:      locations,
-				})
+			if condition != "" {
+				metadata = withMetadata(metadata, "condition", condition)
 			}
+
+			// Create package entry
+			packages = append(packages, models.Package{
+				PackageManager: "nuget",
+				PackageName:    name,
+				Version:        resolvedVersion,
+				FilePath:       manifestFile,
+				Locations:      locations,
+				Metadata:       metadata,
+			})
+		case xml.EndElement:
+			stack.pop()
+		}
+	}
+
+	// Global package references from Directory.Packages.props apply to every project
+	if central != nil {
+		packages = append(packages, central.globalReferences(packages, manifestFile)...)
+	}
+
+	// Expose the frameworks of the project on every package
+	for i := range packages {
+		if len(frameworks) > 0 {
+			packages[i].Metadata = withMetadata(packages[i].Metadata, "targetFrameworks", strings.Join(frameworks, ";"))
+		}
+		if p.TargetFramework != "" {
+			packages[i].Metadata = withMetadata(packages[i].Metadata, "targetFramework", p.TargetFramework)
 		}
 	}
 
 	return packages, nil
 }
+
+// parseItem handles PackageDownload and GlobalPackageReference items, which carry their own versions
+func (p *DotnetCsprojParser) parseItem(tag string, item PackageReference, stack *conditionStack, evaluator conditionEvaluator,
+	props msbuildProperties, lines []string, occurrences map[string]int, manifestFile string) []models.Package {
+	key := tag + "|" + item.Include
+	condition := stack.combined(item.Condition)
+	if evaluator.evaluate(condition) == conditionFalse {
+		occurrences[key]++
+		return nil
+	}
+	if item.Include == "" {
+		return nil
+	}
+
+	lineNum := findItemLine(lines, tag, "Include", item.Include, occurrences[key])
+	occurrences[key]++
+	if lineNum == 0 {
+		return nil
+	}
+
+	version := item.VersionAttr
+	if version == "" {
+		version = item.VersionNested
+	}
+	version = props.resolve(version, manifestFile)
+
+	// Global package references are added to every project, downloads may list several exact versions
+	var versions []string
+	var metadata map[string]string
+	if tag == PackageDownloadTag {
+		versions = parseDownloadVersions(version)
+		metadata = map[string]string{"itemType": PackageDownloadTag}
+	} else {
+		versions = []string{parseVersion(version)}
+		metadata = map[string]string{"global": "true"}
+	}
+	if condition != "" {
+		metadata["condition"] = condition
+	}
+
+	var packages []models.Package
+	for _, v := range versions {
+		packages = append(packages, models.Package{
+			PackageManager: "nuget",
+			PackageName:    props.resolve(item.Include, manifestFile),
+			Version:        v,
+			FilePath:       manifestFile,
+			Locations:      computeElementLocations(lines, lineNum, tag),
+			Metadata:       maps.Clone(metadata),
+		})
+	}
+	return packages
+}
+
+// parseSdkElement reports an <Sdk> element with a version as a package
+func parseSdkElement(sdk SdkReference, props msbuildProperties, lines []string, occurrences map[string]int, manifestFile string) []models.Package {
+	key := SdkTag + "|" + sdk.Name
+	lineNum := findItemLine(lines, SdkTag, "Name", sdk.Name, occurrences[key])
+	occurrences[key]++
+
+	version := props.resolve(sdk.Version, manifestFile)
+	if sdk.Name == "" || version == "" || lineNum == 0 {
+		return nil
+	}
+
+	return []models.Package{{
+		PackageManager: "nuget",
+		PackageName:    props.resolve(sdk.Name, manifestFile),
+		Version:        parseVersion(version),
+		FilePath:       manifestFile,
+		Locations:      computeElementLocations(lines, lineNum, SdkTag),
+		Metadata:       map[string]string{"itemType": SdkTag},
+	}}
+}
+
+// containsFold reports whether values contains value, ignoring case
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -13,17 +14,27 @@ import (
 
 // DotnetDirectoryPackagesPropsParser implements parsing of Directory.Packages.props files
 // These files are used for central package management in .NET projects
-type DotnetDirectoryPackagesPropsParser struct{}
+type DotnetDirectoryPackagesPropsParser struct {
+	// TargetFramework selects the framework to evaluate MSBuild conditions for, e.g. "net48"
+	TargetFramework string
+	// Configuration selects the build configuration to evaluate MSBuild conditions for, e.g. "Release"
+	Configuration string
+}
 
 // PackageVersion represents a <PackageVersion> element in Directory.Packages.props
 type PackageVersion struct {
 	Include       string `xml:"Include,attr"`
+	Update        string `xml:"Update,attr"`
+	Condition     string `xml:"Condition,attr"`
 	VersionAttr   string `xml:"Version,attr"`
 	VersionNested string `xml:"Version"`
 }
 
 const PackageVersionTag = "PackageVersion"
 
+// GlobalPackageReferenceTag is the XML tag for packages referenced by every project in the directory tree
+const GlobalPackageReferenceTag = "GlobalPackageReference"
+
 // parseVersionProps handles version resolution for Directory.Packages.props
 // Returns:
 // - Exact version if specified
@@ -50,6 +61,11 @@ func parseVersionProps(version string) string {
 
 // computePackageVersionLocations calculates all locations for a PackageVersion element
 func computePackageVersionLocations(lines []string, startLine int) []models.Location {
+	return computeElementLocations(lines, startLine, PackageVersionTag)
+}
+
+// computeElementLocations calculates all locations for a package element with the given tag
+func computeElementLocations(lines []string, startLine int, tag string) []models.Location {
 	// Handle empty lines or invalid start line
 	if len(lines) == 0 || startLine < 0 || startLine >= len(lines) {
 		return nil
@@ -57,9 +73,10 @@ func computePackageVersionLocations(lines []string, startLine int) []models.Loca
 
 	var locations []models.Location
 	currentLine := lines[startLine]
+	closingTag := "</" + tag + ">"
 
-	// Find the position of the PackageVersion tag start in the line
-	startIdx := strings.Index(currentLine, "<PackageVersion")
+	// Find the position of the tag start in the line
+	startIdx := strings.Index(currentLine, "<"+tag)
 	if startIdx < 0 {
 		return nil
 	}
@@ -87,9 +104,9 @@ func computePackageVersionLocations(lines []string, startLine int) []models.Loca
 	// Add all lines until the closing tag
 	for i := startLine + 1; i < len(lines) && i < startLine+10; i++ { // Limit search to 10 lines
 		line := lines[i]
-		if strings.Contains(line, "</PackageVersion>") {
-			startIdxInLineEnd := strings.Index(line, "</PackageVersion>")
-			endIdx := strings.Index(line, "</PackageVersion>") + len("</PackageVersion>")
+		if strings.Contains(line, closingTag) {
+			startIdxInLineEnd := strings.Index(line, closingTag)
+			endIdx := strings.Index(line, closingTag) + len(closingTag)
 			locations = append(locations, models.Location{
 				Line:       i,
 				StartIndex: startIdxInLineEnd,
@@ -128,9 +145,15 @@ func (p *DotnetDirectoryPackagesPropsParser) Parse(manifestFile string) ([]model
 	strContent := string(content)
 	lines := strings.Split(strContent, "\n")
 
+	// Collect MSBuild properties from the file and its imports for $(Prop) substitution
+	props := loadProjectProperties(manifestFile, globalProperties(p.TargetFramework, p.Configuration))
+	evaluator := conditionEvaluator{props: props, baseDir: filepath.Dir(manifestFile)}
+
 	// Create XML decoder
 	decoder := xml.NewDecoder(strings.NewReader(strContent))
 	var packages []models.Package
+	stack := &conditionStack{}
+	occurrences := make(map[string]int)
 
 	// Parse XML content
 	for {
@@ -145,52 +168,82 @@ func (p *DotnetDirectoryPackagesPropsParser) Parse(manifestFile string) ([]model
 		// Process each element
 		switch elem := token.(type) {
 		case xml.StartElement:
-			if elem.Name.Local == PackageVersionTag {
-				var pkgVer PackageVersion
-				if err := decoder.DecodeElement(&pkgVer, &elem); err != nil {
-					return nil, fmt.Errorf("failed to decode PackageVersion: %w", err)
-				}
+			if elem.Name.Local != PackageVersionTag && elem.Name.Local != GlobalPackageReferenceTag {
+				stack.push(elem)
+				continue
+			}
 
-				// Skip empty package names
-				if pkgVer.Include == "" {
-					continue
-				}
+			tag := elem.Name.Local
+			var pkgVer PackageVersion
+			if err := decoder.DecodeElement(&pkgVer, &elem); err != nil {
+				return nil, fmt.Errorf("failed to decode PackageVersion: %w", err)
+			}
 
-				// Find line number
-				lineNum := 0
-				packagePattern := fmt.Sprintf(`PackageVersion.*Include="%s"`, pkgVer.Include)
-				re := regexp.MustCompile(packagePattern)
+			// Skip versions excluded by their own or an enclosing condition
+			key := tag + "|" + pkgVer.Include
+			condition := stack.combined(pkgVer.Condition)
+			if evaluator.evaluate(condition) == conditionFalse {
+				occurrences[key]++
+				continue
+			}
 
-				for i, line := range lines {
-					if re.MatchString(line) {
-						lineNum = i
-						break
+			// Determine the version
+			version := pkgVer.VersionAttr
+			if version == "" {
+				version = pkgVer.VersionNested
+			}
+			version = props.resolve(version, manifestFile)
+
+			// Update items change the version of a package that was already declared
+			if pkgVer.Include == "" && pkgVer.Update != "" {
+				name := props.resolve(pkgVer.Update, manifestFile)
+				for i := range packages {
+					if strings.EqualFold(packages[i].PackageName, name) && version != "" {
+						packages[i].Version = parseVersionProps(version)
 					}
 				}
+				continue
+			}
 
-				// Skip if line not found
-				if lineNum == 0 {
-					continue
-				}
+			// Skip empty package names
+			if pkgVer.Include == "" {
+				continue
+			}
 
-				// Compute locations for both single-line and multi-line formats
-				locations := computePackageVersionLocations(lines, lineNum)
+			// Find line number, the same package may be declared under several conditions
+			packagePattern := fmt.Sprintf(`%s.*Include="%s"`, tag, regexp.QuoteMeta(pkgVer.Include))
+			re := regexp.MustCompile(packagePattern)
+			lineNum := findNthLine(lines, re, occurrences[key])
+			occurrences[key]++
 
-				// Determine the version
-				version := pkgVer.VersionAttr
-				if version == "" {
-					version = pkgVer.VersionNested
-				}
+			// Skip if line not found
+			if lineNum == 0 {
+				continue
+			}
+
+			// Compute locations for both single-line and multi-line formats
+			locations := computeElementLocations(lines, lineNum, tag)
 
-				// Create package entry
-				packages = append(packages, models.Package{
-					PackageManager: "nuget",
-					PackageName:    pkgVer.Include,
-					Version:        parseVersionProps(version),
-					FilePath:       manifestFile,
-					Locations:      locations,
-				})
+			// Global package references apply to every project below this file
+			var metadata map[string]string
+			if tag == GlobalPackageReferenceTag {
+				metadata = map[string]string{"global": "true"}
+			}
+			if condition != "" {
+				metadata = withMetadata(metadata, "condition", condition)
 			}
+
+			// Create package entry
+			packages = append(packages, models.Package{
+				PackageManager: "nuget",
+				PackageName:    props.resolve(pkgVer.Include, manifestFile),
+				Version:        parseVersionProps(version),
+				FilePath:       manifestFile,
+				Locations:      locations,
+				Metadata:       metadata,
+			})
+		case xml.EndElement:
+			stack.pop()
 		}
 	}
 
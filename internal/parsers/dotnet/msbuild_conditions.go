@@ -0,0 +1,534 @@
+package dotnet
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// conditionResult is the outcome of evaluating an MSBuild condition.
+// Conditions that depend on values only known at build time evaluate to conditionUnknown.
+type conditionResult int
+
+const (
+	conditionFalse conditionResult = iota
+	conditionTrue
+	conditionUnknown
+)
+
+// buildTimeProperties are properties that are set per build (usually as global properties).
+// When a project does not define them and the caller did not select a value, conditions using them are unknown.
+var buildTimeProperties = []string{
+	"TargetFramework",
+	"TargetFrameworkIdentifier",
+	"TargetFrameworkVersion",
+	"Configuration",
+	"Platform",
+	"RuntimeIdentifier",
+}
+
+// conditionEvaluator evaluates MSBuild condition expressions against a set of properties
+type conditionEvaluator struct {
+	props msbuildProperties
+	// baseDir resolves relative paths used in Exists() conditions
+	baseDir string
+}
+
+// conditionValue is an operand of a condition; known is false when it depends on an unknown property
+type conditionValue struct {
+	text  string
+	known bool
+}
+
+// conditionToken is a lexical token of a condition expression
+type conditionToken struct {
+	kind  string // "string", "property", "word", "op"
+	value string
+}
+
+// evaluate evaluates a condition expression; an empty condition is true
+func (e conditionEvaluator) evaluate(condition string) conditionResult {
+	if strings.TrimSpace(condition) == "" {
+		return conditionTrue
+	}
+
+	tokens, ok := tokenizeCondition(condition)
+	if !ok {
+		return conditionUnknown
+	}
+
+	parser := &conditionParser{tokens: tokens, evaluator: e}
+	result := parser.parseOr()
+	if parser.pos != len(parser.tokens) {
+		return conditionUnknown
+	}
+	return result
+}
+
+// isUnknown reports whether a property is a build time property without a value
+func (e conditionEvaluator) isUnknown(name string) bool {
+	if _, defined := e.props.lookup(name); defined {
+		return false
+	}
+	for _, property := range buildTimeProperties {
+		if strings.EqualFold(property, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// expandValue expands property references inside an operand.
+// Undefined properties expand to an empty string like in MSBuild, unknown build time properties make the value unknown.
+func (e conditionEvaluator) expandValue(raw string) conditionValue {
+	known := true
+	expanded := propertyReferencePattern.ReplaceAllStringFunc(raw, func(ref string) string {
+		name := propertyReferencePattern.FindStringSubmatch(ref)[1]
+		if e.isUnknown(name) {
+			known = false
+			return ref
+		}
+		value, _ := e.props.lookup(name)
+		return value
+	})
+
+	// Property functions, item lists and item metadata cannot be evaluated statically
+	if strings.Contains(expanded, "$(") || strings.Contains(expanded, "@(") || strings.Contains(expanded, "%(") {
+		known = false
+	}
+	return conditionValue{text: expanded, known: known}
+}
+
+// tokenizeCondition splits a condition expression into tokens
+func tokenizeCondition(condition string) ([]conditionToken, bool) {
+	var tokens []conditionToken
+	for i := 0; i < len(condition); {
+		c := condition[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+		case c == '\'' || c == '"' || c == '`':
+			end := strings.IndexByte(condition[i+1:], c)
+			if end < 0 {
+				return nil, false
+			}
+			tokens = append(tokens, conditionToken{"string", condition[i+1 : i+1+end]})
+			i += end + 2
+		case (c == '$' || c == '@' || c == '%') && i+1 < len(condition) && condition[i+1] == '(':
+			// Property, item or metadata reference, possibly with nested parentheses
+			depth := 0
+			end := i + 1
+			for ; end < len(condition); end++ {
+				if condition[end] == '(' {
+					depth++
+				} else if condition[end] == ')' {
+					depth--
+					if depth == 0 {
+						break
+					}
+				}
+			}
+			if end == len(condition) {
+				return nil, false
+			}
+			tokens = append(tokens, conditionToken{"property", condition[i : end+1]})
+			i = end + 1
+		case strings.HasPrefix(condition[i:], "==") || strings.HasPrefix(condition[i:], "!=") ||
+			strings.HasPrefix(condition[i:], "<=") || strings.HasPrefix(condition[i:], ">="):
+			tokens = append(tokens, conditionToken{"op", condition[i : i+2]})
+			i += 2
+		case strings.ContainsRune("!<>(),", rune(c)):
+			tokens = append(tokens, conditionToken{"op", string(c)})
+			i++
+		default:
+			end := i
+			for end < len(condition) && (isWordChar(condition[end])) {
+				end++
+			}
+			if end == i {
+				return nil, false
+			}
+			tokens = append(tokens, conditionToken{"word", condition[i:end]})
+			i = end
+		}
+	}
+	return tokens, true
+}
+
+// isWordChar reports whether c can be part of an unquoted word such as "and", "Exists" or "4.5"
+func isWordChar(c byte) bool {
+	return c == '_' || c == '.' || c == '-' ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// conditionParser is a recursive descent parser that evaluates while parsing
+type conditionParser struct {
+	tokens    []conditionToken
+	pos       int
+	evaluator conditionEvaluator
+}
+
+// peek returns the current token, or an empty token at the end of the input
+func (p *conditionParser) peek() conditionToken {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return conditionToken{}
+}
+
+// isKeyword reports whether the current token is the given case-insensitive keyword
+func (p *conditionParser) isKeyword(keyword string) bool {
+	token := p.peek()
+	return token.kind == "word" && strings.EqualFold(token.value, keyword)
+}
+
+func (p *conditionParser) parseOr() conditionResult {
+	result := p.parseAnd()
+	for p.isKeyword("or") {
+		p.pos++
+		right := p.parseAnd()
+		switch {
+		case result == conditionTrue || right == conditionTrue:
+			result = conditionTrue
+		case result == conditionUnknown || right == conditionUnknown:
+			result = conditionUnknown
+		default:
+			result = conditionFalse
+		}
+	}
+	return result
+}
+
+func (p *conditionParser) parseAnd() conditionResult {
+	result := p.parseUnary()
+	for p.isKeyword("and") {
+		p.pos++
+		right := p.parseUnary()
+		switch {
+		case result == conditionFalse || right == conditionFalse:
+			result = conditionFalse
+		case result == conditionUnknown || right == conditionUnknown:
+			result = conditionUnknown
+		default:
+			result = conditionTrue
+		}
+	}
+	return result
+}
+
+func (p *conditionParser) parseUnary() conditionResult {
+	if token := p.peek(); token.kind == "op" && token.value == "!" {
+		p.pos++
+		switch p.parseUnary() {
+		case conditionTrue:
+			return conditionFalse
+		case conditionFalse:
+			return conditionTrue
+		default:
+			return conditionUnknown
+		}
+	}
+	return p.parsePrimary()
+}
+
+func (p *conditionParser) parsePrimary() conditionResult {
+	token := p.peek()
+
+	// Parenthesized expression
+	if token.kind == "op" && token.value == "(" {
+		p.pos++
+		result := p.parseOr()
+		if closing := p.peek(); closing.kind != "op" || closing.value != ")" {
+			p.pos = len(p.tokens) + 1 // force a parse failure
+			return conditionUnknown
+		}
+		p.pos++
+		return result
+	}
+
+	// Function call such as Exists('...')
+	if token.kind == "word" && p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].value == "(" {
+		return p.parseFunction()
+	}
+
+	left, ok := p.parseOperand()
+	if !ok {
+		p.pos = len(p.tokens) + 1
+		return conditionUnknown
+	}
+
+	operator := p.peek()
+	if operator.kind != "op" {
+		return booleanValue(left)
+	}
+	switch operator.value {
+	case "==", "!=", "<", ">", "<=", ">=":
+		p.pos++
+	default:
+		return booleanValue(left)
+	}
+
+	right, ok := p.parseOperand()
+	if !ok {
+		p.pos = len(p.tokens) + 1
+		return conditionUnknown
+	}
+	return compareValues(left, right, operator.value)
+}
+
+// parseOperand parses a string, property reference or bare word
+func (p *conditionParser) parseOperand() (conditionValue, bool) {
+	token := p.peek()
+	switch token.kind {
+	case "string", "property":
+		p.pos++
+		return p.evaluator.expandValue(token.value), true
+	case "word":
+		p.pos++
+		return conditionValue{text: token.value, known: true}, true
+	}
+	return conditionValue{}, false
+}
+
+// parseFunction evaluates the condition functions supported by MSBuild
+func (p *conditionParser) parseFunction() conditionResult {
+	name := p.peek().value
+	p.pos += 2 // function name and "("
+
+	var args []conditionValue
+	for {
+		token := p.peek()
+		if token.kind == "op" && token.value == ")" {
+			p.pos++
+			break
+		}
+		if token.kind == "op" && token.value == "," {
+			p.pos++
+			continue
+		}
+		arg, ok := p.parseOperand()
+		if !ok {
+			p.pos = len(p.tokens) + 1
+			return conditionUnknown
+		}
+		args = append(args, arg)
+	}
+
+	if len(args) != 1 || !args[0].known {
+		return conditionUnknown
+	}
+
+	switch strings.ToLower(name) {
+	case "exists":
+		path := filepath.FromSlash(strings.ReplaceAll(strings.TrimSpace(args[0].text), `\`, "/"))
+		if path == "" {
+			return conditionFalse
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(p.evaluator.baseDir, path)
+		}
+		if _, err := os.Stat(path); err == nil {
+			return conditionTrue
+		}
+		return conditionFalse
+	case "hastrailingslash":
+		if strings.HasSuffix(args[0].text, "/") || strings.HasSuffix(args[0].text, `\`) {
+			return conditionTrue
+		}
+		return conditionFalse
+	}
+	return conditionUnknown
+}
+
+// booleanValue interprets a single operand as a boolean
+func booleanValue(value conditionValue) conditionResult {
+	if !value.known {
+		return conditionUnknown
+	}
+	switch strings.ToLower(strings.TrimSpace(value.text)) {
+	case "true", "on", "yes":
+		return conditionTrue
+	case "false", "off", "no":
+		return conditionFalse
+	}
+	return conditionUnknown
+}
+
+// compareValues compares two operands; equality is case-insensitive and ordering is numeric
+func compareValues(left, right conditionValue, operator string) conditionResult {
+	if !left.known || !right.known {
+		return conditionUnknown
+	}
+
+	toResult := func(b bool) conditionResult {
+		if b {
+			return conditionTrue
+		}
+		return conditionFalse
+	}
+
+	switch operator {
+	case "==":
+		return toResult(strings.EqualFold(left.text, right.text))
+	case "!=":
+		return toResult(!strings.EqualFold(left.text, right.text))
+	}
+
+	cmp, ok := compareNumbers(left.text, right.text)
+	if !ok {
+		return conditionUnknown
+	}
+	switch operator {
+	case "<":
+		return toResult(cmp < 0)
+	case ">":
+		return toResult(cmp > 0)
+	case "<=":
+		return toResult(cmp <= 0)
+	default:
+		return toResult(cmp >= 0)
+	}
+}
+
+// compareNumbers compares two numbers or dotted versions, e.g. "4.5" and "4.7.2"
+func compareNumbers(left, right string) (int, bool) {
+	leftParts := strings.Split(strings.TrimPrefix(strings.TrimSpace(left), "v"), ".")
+	rightParts := strings.Split(strings.TrimPrefix(strings.TrimSpace(right), "v"), ".")
+	for i := 0; i < len(leftParts) || i < len(rightParts); i++ {
+		var l, r int
+		var err error
+		if i < len(leftParts) {
+			if l, err = strconv.Atoi(leftParts[i]); err != nil {
+				return 0, false
+			}
+		}
+		if i < len(rightParts) {
+			if r, err = strconv.Atoi(rightParts[i]); err != nil {
+				return 0, false
+			}
+		}
+		if l != r {
+			if l < r {
+				return -1, true
+			}
+			return 1, true
+		}
+	}
+	return 0, true
+}
+
+// targetFrameworkProperties derives the properties the .NET SDK computes from a target framework moniker,
+// e.g. net48 -> .NETFramework v4.8 and net8.0 -> .NETCoreApp v8.0
+func targetFrameworkProperties(targetFramework string) map[string]string {
+	tfm := strings.ToLower(strings.TrimSpace(targetFramework))
+	// Platform specific frameworks such as net8.0-windows share the identifier of the base framework
+	tfm, _, _ = strings.Cut(tfm, "-")
+
+	var identifier, version string
+	switch {
+	case strings.HasPrefix(tfm, "netstandard"):
+		identifier, version = ".NETStandard", strings.TrimPrefix(tfm, "netstandard")
+	case strings.HasPrefix(tfm, "netcoreapp"):
+		identifier, version = ".NETCoreApp", strings.TrimPrefix(tfm, "netcoreapp")
+	case strings.HasPrefix(tfm, "net") && strings.Contains(tfm, "."):
+		identifier, version = ".NETCoreApp", strings.TrimPrefix(tfm, "net")
+	case strings.HasPrefix(tfm, "net"):
+		// net48 -> 4.8, net472 -> 4.7.2
+		digits := strings.TrimPrefix(tfm, "net")
+		identifier, version = ".NETFramework", strings.Join(strings.Split(digits, ""), ".")
+	default:
+		return nil
+	}
+
+	if version == "" {
+		return map[string]string{"TargetFrameworkIdentifier": identifier}
+	}
+	return map[string]string{
+		"TargetFrameworkIdentifier": identifier,
+		"TargetFrameworkVersion":    "v" + version,
+	}
+}
+
+// combineConditions joins the conditions of nested elements into a single expression
+func combineConditions(conditions []string) string {
+	var parts []string
+	for _, condition := range conditions {
+		if condition = strings.TrimSpace(condition); condition != "" {
+			parts = append(parts, condition)
+		}
+	}
+	if len(parts) <= 1 {
+		return strings.Join(parts, "")
+	}
+	return "(" + strings.Join(parts, ") and (") + ")"
+}
+
+// conditionFrame holds the condition of an enclosing element
+type conditionFrame struct {
+	name      string
+	condition string
+	// whens collects the conditions of the <When> children of a <Choose> element
+	whens []string
+}
+
+// conditionStack tracks the conditions of the enclosing elements while walking an MSBuild file
+type conditionStack struct {
+	frames []conditionFrame
+}
+
+// push enters an element. <When> and <Otherwise> only apply when no previous <When> of their <Choose> matched.
+func (s *conditionStack) push(elem xml.StartElement) {
+	condition := attributeValue(elem, "Condition")
+
+	if elem.Name.Local == "When" || elem.Name.Local == "Otherwise" {
+		if parent := s.parent(); parent != nil {
+			var previous []string
+			for _, when := range parent.whens {
+				previous = append(previous, "!("+when+")")
+			}
+			if elem.Name.Local == "When" {
+				parent.whens = append(parent.whens, condition)
+				previous = append(previous, condition)
+			}
+			condition = combineConditions(previous)
+		}
+	}
+
+	s.frames = append(s.frames, conditionFrame{name: elem.Name.Local, condition: condition})
+}
+
+// pop leaves the innermost element
+func (s *conditionStack) pop() {
+	if len(s.frames) > 0 {
+		s.frames = s.frames[:len(s.frames)-1]
+	}
+}
+
+// parent returns the innermost element, or nil at the document root
+func (s *conditionStack) parent() *conditionFrame {
+	if len(s.frames) == 0 {
+		return nil
+	}
+	return &s.frames[len(s.frames)-1]
+}
+
+// combined returns the condition of an element nested in the current elements
+func (s *conditionStack) combined(condition string) string {
+	conditions := make([]string, 0, len(s.frames)+1)
+	for _, frame := range s.frames {
+		conditions = append(conditions, frame.condition)
+	}
+	return combineConditions(append(conditions, condition))
+}
+
+// attributeValue returns the value of an attribute of an element, or an empty string
+func attributeValue(elem xml.StartElement, name string) string {
+	for _, attr := range elem.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
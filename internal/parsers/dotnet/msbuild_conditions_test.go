@@ -0,0 +1,152 @@
+package dotnet
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestConditionEvaluator_Evaluate(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "build.props"), "<Project />")
+
+	evaluator := conditionEvaluator{
+		props:   msbuildProperties{"TargetFramework": "net48", "UseSerilog": "true", "LangVersion": "10.0"},
+		baseDir: dir,
+	}
+
+	tests := []struct {
+		condition string
+		want      conditionResult
+	}{
+		{"", conditionTrue},
+		{"'$(TargetFramework)' == 'net48'", conditionTrue},
+		{"'$(TargetFramework)' == 'NET48'", conditionTrue},
+		{"'$(TargetFramework)' != 'net48'", conditionFalse},
+		{"'$(TargetFramework)' == 'net48' and '$(UseSerilog)' == 'true'", conditionTrue},
+		{"'$(TargetFramework)' == 'net8.0' or $(UseSerilog)", conditionTrue},
+		{"!$(UseSerilog)", conditionFalse},
+		{"'$(Undefined)' == ''", conditionTrue},
+		{"'$(Configuration)' == 'Release'", conditionUnknown},
+		{"'$(Configuration)' == 'Release' and '$(TargetFramework)' == 'net8.0'", conditionFalse},
+		{"'$(Configuration)' == 'Release' or '$(TargetFramework)' == 'net48'", conditionTrue},
+		{"$(LangVersion) >= 9.0", conditionTrue},
+		{"'$(TargetFrameworkIdentifier)' == '.NETFramework'", conditionUnknown},
+		{"Exists('build.props')", conditionTrue},
+		{"!Exists('missing.props')", conditionTrue},
+		{"HasTrailingSlash('obj/')", conditionTrue},
+		{"'$(TargetFramework)' == ", conditionUnknown},
+	}
+
+	for _, tt := range tests {
+		if got := evaluator.evaluate(tt.condition); got != tt.want {
+			t.Errorf("evaluate(%q): got %v, want %v", tt.condition, got, tt.want)
+		}
+	}
+}
+
+func TestDotnetCsprojParser_TargetFrameworkConditions(t *testing.T) {
+	root := t.TempDir()
+	project := filepath.Join(root, "App.csproj")
+	writeFile(t, project, `<Project Sdk="Microsoft.NET.Sdk">
+  <PropertyGroup>
+    <TargetFrameworks>net48;net8.0</TargetFrameworks>
+  </PropertyGroup>
+  <ItemGroup>
+    <PackageReference Include="Serilog" Version="3.1.1" />
+  </ItemGroup>
+  <ItemGroup Condition="'$(TargetFramework)' == 'net48'">
+    <PackageReference Include="System.Text.Json" Version="6.0.0" />
+  </ItemGroup>
+  <ItemGroup Condition="'$(TargetFramework)' == 'net8.0'">
+    <PackageReference Include="System.Text.Json" Version="8.0.0" />
+  </ItemGroup>
+  <Choose>
+    <When Condition="'$(Configuration)' == 'Debug'">
+      <ItemGroup>
+        <PackageReference Include="Microsoft.Extensions.Logging.Debug" Version="8.0.0" />
+      </ItemGroup>
+    </When>
+    <Otherwise>
+      <ItemGroup>
+        <PackageReference Include="Microsoft.Extensions.Logging.Console" Version="8.0.0" />
+      </ItemGroup>
+    </Otherwise>
+  </Choose>
+</Project>`)
+
+	// Without a selected framework every package is reported with its condition
+	packages, err := (&DotnetCsprojParser{}).Parse(project)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(packages) != 5 {
+		t.Fatalf("Expected 5 packages, got %d: %+v", len(packages), packages)
+	}
+	wantLines := []int{5, 8, 11, 16, 21}
+	for i, pkg := range packages {
+		if pkg.Locations[0].Line != wantLines[i] {
+			t.Errorf("%s line: got %d, want %d", pkg.PackageName, pkg.Locations[0].Line, wantLines[i])
+		}
+		if got := pkg.Metadata["targetFrameworks"]; got != "net48;net8.0" {
+			t.Errorf("%s targetFrameworks: got %q, want %q", pkg.PackageName, got, "net48;net8.0")
+		}
+	}
+	if got := packages[0].Metadata["condition"]; got != "" {
+		t.Errorf("Serilog condition: got %q, want none", got)
+	}
+	if got, want := packages[1].Metadata["condition"], "'$(TargetFramework)' == 'net48'"; got != want {
+		t.Errorf("System.Text.Json condition: got %q, want %q", got, want)
+	}
+
+	// Selecting a framework and configuration drops the packages of the other branches
+	packages, err = (&DotnetCsprojParser{TargetFramework: "net8.0", Configuration: "Release"}).Parse(project)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := map[string]string{
+		"Serilog":                              "3.1.1",
+		"System.Text.Json":                     "8.0.0",
+		"Microsoft.Extensions.Logging.Console": "8.0.0",
+	}
+	if len(packages) != len(want) {
+		t.Fatalf("Expected %d packages, got %d: %+v", len(want), len(packages), packages)
+	}
+	for _, pkg := range packages {
+		if pkg.Version != want[pkg.PackageName] {
+			t.Errorf("%s Version: got %q, want %q", pkg.PackageName, pkg.Version, want[pkg.PackageName])
+		}
+		if got := pkg.Metadata["targetFramework"]; got != "net8.0" {
+			t.Errorf("%s targetFramework: got %q, want %q", pkg.PackageName, got, "net8.0")
+		}
+	}
+	if packages[1].Locations[0].Line != 11 {
+		t.Errorf("System.Text.Json line: got %d, want 11", packages[1].Locations[0].Line)
+	}
+}
+
+func TestDotnetDirectoryPackagesPropsParser_Conditions(t *testing.T) {
+	propsFile := filepath.Join(t.TempDir(), DirectoryPackagesProps)
+	writeFile(t, propsFile, `<Project>
+  <ItemGroup>
+    <PackageVersion Include="Serilog" Version="3.1.1" />
+    <PackageVersion Include="System.Text.Json" Version="6.0.0" Condition="'$(TargetFramework)' == 'net48'" />
+    <PackageVersion Include="System.Text.Json" Version="8.0.0" Condition="'$(TargetFramework)' != 'net48'" />
+  </ItemGroup>
+</Project>`)
+
+	packages, err := (&DotnetDirectoryPackagesPropsParser{}).Parse(propsFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(packages) != 3 {
+		t.Fatalf("Expected 3 packages, got %d: %+v", len(packages), packages)
+	}
+
+	packages, err = (&DotnetDirectoryPackagesPropsParser{TargetFramework: "net48"}).Parse(propsFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(packages) != 2 || packages[1].Version != "6.0.0" || packages[1].Locations[0].Line != 3 {
+		t.Errorf("Expected System.Text.Json 6.0.0 on line 3, got %+v", packages)
+	}
+}
@@ -0,0 +1,110 @@
+package dotnet
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// PackageDownloadTag is the XML tag for packages that are downloaded during restore without being referenced
+const PackageDownloadTag = "PackageDownload"
+
+// SdkTag is the XML tag for MSBuild project SDK references
+const SdkTag = "Sdk"
+
+// ProjectTag is the XML tag of the project root element, its Sdk attribute references MSBuild project SDKs
+const ProjectTag = "Project"
+
+// SdkReference represents an <Sdk Name="..." Version="..."> element
+type SdkReference struct {
+	Name    string `xml:"Name,attr"`
+	Version string `xml:"Version,attr"`
+}
+
+// parseDownloadVersions returns the versions of a PackageDownload item.
+// PackageDownload only accepts exact versions, e.g. "[1.0.0]" or "[1.0.0];[2.0.0]".
+func parseDownloadVersions(version string) []string {
+	var versions []string
+	for _, v := range strings.Split(version, ";") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		if strings.HasPrefix(v, "[") && strings.HasSuffix(v, "]") && !strings.Contains(v, ",") {
+			v = strings.TrimSpace(v[1 : len(v)-1])
+		}
+		versions = append(versions, parseVersion(v))
+	}
+	if len(versions) == 0 {
+		versions = append(versions, "latest")
+	}
+	return versions
+}
+
+// parseSdkAttribute splits the Sdk attribute of a Project element, e.g. "Microsoft.Build.Traversal/3.4.0;My.Sdk"
+func parseSdkAttribute(value string) []SdkReference {
+	var sdks []SdkReference
+	for _, entry := range strings.Split(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, version, _ := strings.Cut(entry, "/")
+		sdks = append(sdks, SdkReference{Name: strings.TrimSpace(name), Version: strings.TrimSpace(version)})
+	}
+	return sdks
+}
+
+// findItemLine returns the line of the n-th element with the given tag and attribute value, or 0 if there is none
+func findItemLine(lines []string, tag, attr, value string, n int) int {
+	pattern := fmt.Sprintf(`<%s\b.*%s="%s"`, tag, attr, regexp.QuoteMeta(value))
+	return findNthLine(lines, regexp.MustCompile(pattern), n)
+}
+
+// computeSdkAttributeLocation finds an SDK entry of the Project element's Sdk attribute
+func computeSdkAttributeLocation(lines []string, sdk SdkReference) (models.Location, bool) {
+	entry := sdk.Name + "/" + sdk.Version
+	inProject := false
+	for i, line := range lines {
+		if strings.Contains(line, "<"+ProjectTag) {
+			inProject = true
+		}
+		if !inProject {
+			continue
+		}
+		if idx := strings.Index(line, entry); idx >= 0 {
+			return models.Location{Line: i, StartIndex: idx, EndIndex: idx + len(entry)}, true
+		}
+		// The Sdk attribute can only appear on the Project element itself
+		if strings.Contains(line, ">") {
+			break
+		}
+	}
+	return models.Location{}, false
+}
+
+// projectSdkPackages reports the versioned SDKs of the Project element's Sdk attribute.
+// SDKs without a version (e.g. Microsoft.NET.Sdk) ship with the .NET SDK and are not NuGet packages.
+func projectSdkPackages(sdkAttribute string, lines []string, manifestFile string) []models.Package {
+	var packages []models.Package
+	for _, sdk := range parseSdkAttribute(sdkAttribute) {
+		if sdk.Name == "" || sdk.Version == "" {
+			continue
+		}
+		location, ok := computeSdkAttributeLocation(lines, sdk)
+		if !ok {
+			continue
+		}
+		packages = append(packages, models.Package{
+			PackageManager: "nuget",
+			PackageName:    sdk.Name,
+			Version:        parseVersion(sdk.Version),
+			FilePath:       manifestFile,
+			Locations:      []models.Location{location},
+			Metadata:       map[string]string{"itemType": SdkTag},
+		})
+	}
+	return packages
+}
@@ -0,0 +1,131 @@
+package dotnet
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestDotnetCsprojParser_SdkAndDownloadItems(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "App.fsproj")
+	writeFile(t, manifestFile, `<Project Sdk="Microsoft.NET.Sdk;MSBuild.Sdk.Extras/3.0.44">
+  <Sdk Name="Microsoft.Build.CentralPackageVersions" Version="2.1.3" />
+  <Sdk Name="Microsoft.NET.Sdk.Web" />
+  <ItemGroup>
+    <PackageReference Include="FSharp.Core" Version="8.0.100" />
+    <PackageDownload Include="Microsoft.NETCore.App.Ref" Version="[6.0.0];[8.0.0]" />
+    <GlobalPackageReference Include="Nerdbank.GitVersioning" Version="3.6.133" />
+  </ItemGroup>
+</Project>`)
+
+	packages, err := (&DotnetCsprojParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{
+			PackageManager: "nuget",
+			PackageName:    "MSBuild.Sdk.Extras",
+			Version:        "3.0.44",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 0, StartIndex: 32, EndIndex: 57}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Microsoft.Build.CentralPackageVersions",
+			Version:        "2.1.3",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 1, StartIndex: 2, EndIndex: 71}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "FSharp.Core",
+			Version:        "8.0.100",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 4, StartIndex: 4, EndIndex: 64}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Microsoft.NETCore.App.Ref",
+			Version:        "6.0.0",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 5, StartIndex: 4, EndIndex: 85}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Microsoft.NETCore.App.Ref",
+			Version:        "8.0.0",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 5, StartIndex: 4, EndIndex: 85}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Nerdbank.GitVersioning",
+			Version:        "3.6.133",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 6, StartIndex: 4, EndIndex: 81}},
+		},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+
+	wantMetadata := []map[string]string{
+		{"itemType": SdkTag},
+		{"itemType": SdkTag},
+		nil,
+		{"itemType": PackageDownloadTag},
+		{"itemType": PackageDownloadTag},
+		{"global": "true"},
+	}
+	for i, pkg := range packages {
+		for key, value := range wantMetadata[i] {
+			if pkg.Metadata[key] != value {
+				t.Errorf("%s Metadata[%s]: got %q, want %q", pkg.PackageName, key, pkg.Metadata[key], value)
+			}
+		}
+	}
+}
+
+func TestDotnetCsprojParser_DirectoryBuildProps(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), DirectoryBuildProps)
+	writeFile(t, manifestFile, `<Project>
+  <PropertyGroup>
+    <AnalyzersVersion>8.0.0</AnalyzersVersion>
+  </PropertyGroup>
+  <ItemGroup>
+    <PackageReference Include="Microsoft.CodeAnalysis.NetAnalyzers" Version="$(AnalyzersVersion)" PrivateAssets="all" />
+  </ItemGroup>
+</Project>`)
+
+	packages, err := (&DotnetCsprojParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(packages) != 1 || packages[0].Version != "8.0.0" || packages[0].Locations[0].Line != 5 {
+		t.Errorf("Expected Microsoft.CodeAnalysis.NetAnalyzers 8.0.0 on line 5, got %+v", packages)
+	}
+}
+
+func TestParseDownloadVersions(t *testing.T) {
+	tests := map[string][]string{
+		"[1.0.0]":         {"1.0.0"},
+		"[1.0.0];[2.0.0]": {"1.0.0", "2.0.0"},
+		"[1.0.0, 2.0.0)":  {"latest"},
+		"":                {"latest"},
+	}
+	for input, want := range tests {
+		got := parseDownloadVersions(input)
+		if len(got) != len(want) {
+			t.Errorf("parseDownloadVersions(%q) = %v; want %v", input, got, want)
+			continue
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("parseDownloadVersions(%q) = %v; want %v", input, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package dotnet
+
+import (
+	"encoding/xml"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// MSBuild files that are imported implicitly by SDK-style projects
+const (
+	DirectoryBuildProps    = "Directory.Build.props"
+	DirectoryBuildTargets  = "Directory.Build.targets"
+	DirectoryPackagesProps = "Directory.Packages.props"
+)
+
+// propertyReferencePattern matches MSBuild property references such as $(SerilogVersion)
+var propertyReferencePattern = regexp.MustCompile(`\$\(([A-Za-z_][A-Za-z0-9_.\-]*)\)`)
+
+// msbuildProperties holds the MSBuild properties visible to a project
+type msbuildProperties map[string]string
+
+// expand substitutes $(Name) references with their values.
+// It returns the expanded value and the names of properties that could not be resolved.
+func (p msbuildProperties) expand(value string) (string, []string) {
+	var unresolved []string
+	expanded := propertyReferencePattern.ReplaceAllStringFunc(value, func(ref string) string {
+		name := propertyReferencePattern.FindStringSubmatch(ref)[1]
+		if resolved, ok := p.lookup(name); ok {
+			return resolved
+		}
+		unresolved = append(unresolved, name)
+		return ref
+	})
+	return expanded, unresolved
+}
+
+// lookup finds a property by name; MSBuild property names are case-insensitive
+func (p msbuildProperties) lookup(name string) (string, bool) {
+	if value, ok := p[name]; ok {
+		return value, true
+	}
+	for key, value := range p {
+		if strings.EqualFold(key, name) {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// set defines a property, replacing an existing definition that differs only in case
+func (p msbuildProperties) set(name, value string) {
+	for key := range p {
+		if key != name && strings.EqualFold(key, name) {
+			delete(p, key)
+		}
+	}
+	p[name] = value
+}
+
+// resolve expands a value and logs a diagnostic for every property that could not be resolved
+func (p msbuildProperties) resolve(value, manifestFile string) string {
+	expanded, unresolved := p.expand(value)
+	for _, name := range unresolved {
+		log.Printf("Unresolved MSBuild property $(%s) in %s", name, manifestFile)
+	}
+	return expanded
+}
+
+// findFileAbove returns the path of the first file with the given name in dir or one of its parents
+func findFileAbove(dir, name string) string {
+	for {
+		candidate := filepath.Join(dir, name)
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return candidate
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// evaluationContext carries the state shared while collecting properties across imported files
+type evaluationContext struct {
+	visited map[string]bool
+	// globals are properties selected by the caller, such as TargetFramework; MSBuild files cannot override them
+	globals map[string]string
+}
+
+// globalProperties returns the global properties for a caller-selected target framework and configuration
+func globalProperties(targetFramework, configuration string) map[string]string {
+	globals := make(map[string]string)
+	if targetFramework != "" {
+		globals["TargetFramework"] = targetFramework
+		for name, value := range targetFrameworkProperties(targetFramework) {
+			globals[name] = value
+		}
+	}
+	if configuration != "" {
+		globals["Configuration"] = configuration
+	}
+	return globals
+}
+
+// loadProjectProperties collects the properties of a project file the way MSBuild imports them:
+// Directory.Build.props and Directory.Packages.props first, then the project itself with its explicit imports,
+// then Directory.Build.targets. Global properties take precedence over every definition.
+func loadProjectProperties(projectFile string, globals map[string]string) msbuildProperties {
+	absPath, err := filepath.Abs(projectFile)
+	if err != nil {
+		absPath = projectFile
+	}
+	dir := filepath.Dir(absPath)
+
+	props := msbuildProperties{
+		"MSBuildProjectDirectory": dir,
+		"MSBuildProjectFullPath":  absPath,
+		"MSBuildProjectFile":      filepath.Base(absPath),
+		"MSBuildProjectName":      strings.TrimSuffix(filepath.Base(absPath), filepath.Ext(absPath)),
+		"MSBuildProjectExtension": filepath.Ext(absPath),
+	}
+	for name, value := range globals {
+		props[name] = value
+	}
+
+	ctx := &evaluationContext{visited: make(map[string]bool), globals: globals}
+	if buildProps := findFileAbove(dir, DirectoryBuildProps); buildProps != "" {
+		props.collect(buildProps, ctx)
+	}
+	if packagesProps := findFileAbove(dir, DirectoryPackagesProps); packagesProps != "" {
+		props.collect(packagesProps, ctx)
+	}
+	props.collect(absPath, ctx)
+	if buildTargets := findFileAbove(dir, DirectoryBuildTargets); buildTargets != "" {
+		props.collect(buildTargets, ctx)
+	}
+
+	// The SDK derives the framework identifier and version from the target framework
+	if targetFramework, ok := props.lookup("TargetFramework"); ok && targetFramework != "" {
+		for name, value := range targetFrameworkProperties(targetFramework) {
+			if _, defined := props.lookup(name); !defined {
+				props[name] = value
+			}
+		}
+	}
+
+	return props
+}
+
+// targetFrameworks returns the frameworks a project builds for, from TargetFrameworks or TargetFramework
+func (p msbuildProperties) targetFrameworks() []string {
+	value, _ := p.lookup("TargetFrameworks")
+	if value == "" {
+		value, _ = p.lookup("TargetFramework")
+	}
+
+	var frameworks []string
+	for _, framework := range strings.Split(value, ";") {
+		if framework = strings.TrimSpace(framework); framework != "" {
+			frameworks = append(frameworks, framework)
+		}
+	}
+	return frameworks
+}
+
+// collect reads the <PropertyGroup> definitions of an MSBuild file, following <Import> elements found on disk.
+// Definitions under false conditions are skipped; definitions under unknown conditions only provide defaults.
+func (p msbuildProperties) collect(file string, ctx *evaluationContext) {
+	if ctx.visited[file] {
+		return
+	}
+	ctx.visited[file] = true
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return
+	}
+
+	// MSBuildThisFile* properties refer to the file being evaluated
+	thisFile := msbuildProperties{
+		"MSBuildThisFileDirectory": filepath.Dir(file) + string(filepath.Separator),
+		"MSBuildThisFile":          filepath.Base(file),
+		"MSBuildThisFileFullPath":  file,
+	}
+	saved := make(msbuildProperties)
+	for key, value := range thisFile {
+		if previous, ok := p[key]; ok {
+			saved[key] = previous
+		}
+		p[key] = value
+	}
+	defer func() {
+		for key := range thisFile {
+			delete(p, key)
+			if previous, ok := saved[key]; ok {
+				p[key] = previous
+			}
+		}
+	}()
+
+	evaluator := conditionEvaluator{props: p, baseDir: filepath.Dir(file)}
+	stack := &conditionStack{}
+	decoder := xml.NewDecoder(strings.NewReader(string(content)))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if err != io.EOF {
+				log.Printf("Failed to read MSBuild properties from %s: %v", file, err)
+			}
+			return
+		}
+
+		switch elem := token.(type) {
+		case xml.StartElement:
+			parent := stack.parent()
+			switch {
+			case parent != nil && parent.name == "PropertyGroup":
+				var value struct {
+					Text string `xml:",chardata"`
+				}
+				if err := decoder.DecodeElement(&value, &elem); err != nil {
+					log.Printf("Failed to read MSBuild property %s from %s: %v", elem.Name.Local, file, err)
+					return
+				}
+				name := elem.Name.Local
+				if isGlobal(ctx.globals, name) {
+					continue
+				}
+
+				result := evaluator.evaluate(stack.combined(attributeValue(elem, "Condition")))
+				if result == conditionFalse {
+					continue
+				}
+				if _, defined := p.lookup(name); defined && result == conditionUnknown {
+					continue
+				}
+				expanded, _ := p.expand(strings.TrimSpace(value.Text))
+				p.set(name, expanded)
+			case elem.Name.Local == "Import":
+				if evaluator.evaluate(stack.combined(attributeValue(elem, "Condition"))) != conditionFalse {
+					p.collectImport(elem, file, ctx)
+				}
+				stack.push(elem)
+			default:
+				stack.push(elem)
+			}
+		case xml.EndElement:
+			stack.pop()
+		}
+	}
+}
+
+// isGlobal reports whether a property is one of the global properties
+func isGlobal(globals map[string]string, name string) bool {
+	for global := range globals {
+		if strings.EqualFold(global, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// collectImport follows an <Import Project="..."> element when the imported file exists on disk
+func (p msbuildProperties) collectImport(elem xml.StartElement, file string, ctx *evaluationContext) {
+	project := attributeValue(elem, "Project")
+	if project == "" {
+		return
+	}
+
+	path, unresolved := p.expand(project)
+	if len(unresolved) > 0 || strings.ContainsAny(path, "*?") {
+		return
+	}
+
+	// Project files are usually authored on Windows
+	path = filepath.FromSlash(strings.ReplaceAll(path, `\`, "/"))
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(file), path)
+	}
+	path = filepath.Clean(path)
+
+	if fileExists(path) {
+		p.collect(path, ctx)
+	}
+}
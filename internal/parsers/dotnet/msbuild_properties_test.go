@@ -0,0 +1,175 @@
+package dotnet
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// writeFile is a helper that writes a test file, creating its parent directories
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("Failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+}
+
+func TestMsbuildProperties_Expand(t *testing.T) {
+	props := msbuildProperties{"Major": "6", "Minor": "0", "SerilogVersion": "3.1.1"}
+
+	tests := []struct {
+		name           string
+		value          string
+		wantValue      string
+		wantUnresolved []string
+	}{
+		{"plain value", "1.2.3", "1.2.3", nil},
+		{"single property", "$(SerilogVersion)", "3.1.1", nil},
+		{"case-insensitive", "$(serilogversion)", "3.1.1", nil},
+		{"composed value", "$(Major).$(Minor).1", "6.0.1", nil},
+		{"unresolved property", "$(Missing)", "$(Missing)", []string{"Missing"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, unresolved := props.expand(tt.value)
+			if got != tt.wantValue {
+				t.Errorf("expand(%q) = %q, want %q", tt.value, got, tt.wantValue)
+			}
+			if !reflect.DeepEqual(unresolved, tt.wantUnresolved) {
+				t.Errorf("expand(%q) unresolved = %v, want %v", tt.value, unresolved, tt.wantUnresolved)
+			}
+		})
+	}
+}
+
+func TestLoadProjectProperties_Imports(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, DirectoryBuildProps), `<Project>
+  <PropertyGroup>
+    <SerilogVersion>3.1.1</SerilogVersion>
+    <LoggingVersion>6.0.0</LoggingVersion>
+  </PropertyGroup>
+</Project>`)
+	writeFile(t, filepath.Join(root, "build", "Versions.props"), `<Project>
+  <PropertyGroup>
+    <JsonVersion>13.0.3</JsonVersion>
+  </PropertyGroup>
+</Project>`)
+	writeFile(t, filepath.Join(root, DirectoryBuildTargets), `<Project>
+  <PropertyGroup>
+    <LoggingVersion>8.0.0</LoggingVersion>
+  </PropertyGroup>
+</Project>`)
+	project := filepath.Join(root, "src", "App", "App.csproj")
+	writeFile(t, project, `<Project Sdk="Microsoft.NET.Sdk">
+  <Import Project="$(MSBuildThisFileDirectory)..\..\build\Versions.props" />
+  <Import Project="$(MSBuildExtensionsPath)\Missing.targets" />
+  <PropertyGroup>
+    <SerilogSinksVersion>$(SerilogVersion)-sinks</SerilogSinksVersion>
+  </PropertyGroup>
+</Project>`)
+
+	props := loadProjectProperties(project, nil)
+
+	want := map[string]string{
+		"SerilogVersion":      "3.1.1",
+		"JsonVersion":         "13.0.3",
+		"SerilogSinksVersion": "3.1.1-sinks",
+		"LoggingVersion":      "8.0.0",
+		"MSBuildProjectName":  "App",
+	}
+	for name, value := range want {
+		if got, _ := props.lookup(name); got != value {
+			t.Errorf("property %s = %q, want %q", name, got, value)
+		}
+	}
+}
+
+func TestDotnetCsprojParser_PropertySubstitution(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, DirectoryBuildProps), `<Project>
+  <PropertyGroup>
+    <SerilogVersion>3.1.1</SerilogVersion>
+    <JsonPackage>Newtonsoft.Json</JsonPackage>
+  </PropertyGroup>
+</Project>`)
+	project := filepath.Join(root, "App", "App.csproj")
+	writeFile(t, project, `<Project Sdk="Microsoft.NET.Sdk">
+  <PropertyGroup>
+    <JsonVersion>13.0.3</JsonVersion>
+  </PropertyGroup>
+  <ItemGroup>
+    <PackageReference Include="Serilog" Version="$(SerilogVersion)" />
+    <PackageReference Include="$(JsonPackage)" Version="$(JsonVersion)" />
+    <PackageReference Include="Polly" Version="$(PollyVersion)" />
+    <PackageReference Update="Serilog" Version="3.1.2" />
+  </ItemGroup>
+</Project>`)
+
+	packages, err := (&DotnetCsprojParser{}).Parse(project)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	testdata.ValidatePackages(t, packages, []models.Package{
+		{
+			PackageManager: "nuget",
+			PackageName:    "Serilog",
+			Version:        "3.1.2",
+			FilePath:       project,
+			Locations:      []models.Location{{Line: 5, StartIndex: 4, EndIndex: 70}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Newtonsoft.Json",
+			Version:        "13.0.3",
+			FilePath:       project,
+			Locations:      []models.Location{{Line: 6, StartIndex: 4, EndIndex: 74}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Polly",
+			Version:        "latest",
+			FilePath:       project,
+			Locations:      []models.Location{{Line: 7, StartIndex: 4, EndIndex: 66}},
+		},
+	})
+}
+
+func TestDotnetDirectoryPackagesPropsParser_PropertySubstitution(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, DirectoryBuildProps), `<Project>
+  <PropertyGroup>
+    <MSTestPackageVersion>3.2.0</MSTestPackageVersion>
+  </PropertyGroup>
+</Project>`)
+	manifestFile := filepath.Join(root, "Directory.Packages.props")
+	writeFile(t, manifestFile, `<Project>
+  <ItemGroup>
+    <PackageVersion Include="MSTest.TestAdapter" Version="$(MSTestPackageVersion)" />
+  </ItemGroup>
+</Project>`)
+
+	packages, err := (&DotnetDirectoryPackagesPropsParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	testdata.ValidatePackages(t, packages, []models.Package{
+		{
+			PackageManager: "nuget",
+			PackageName:    "MSTest.TestAdapter",
+			Version:        "3.2.0",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 2, StartIndex: 4, EndIndex: 85}},
+		},
+	})
+}
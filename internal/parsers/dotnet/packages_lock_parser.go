@@ -0,0 +1,148 @@
+package dotnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/jsonutil"
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// DotnetPackagesLockParser implements parsing of NuGet lock files (packages.lock.json)
+type DotnetPackagesLockParser struct{}
+
+// NuGet lock and assets file names
+const (
+	PackagesLockJson  = "packages.lock.json"
+	ProjectAssetsJson = "project.assets.json"
+)
+
+// Dependency types used in packages.lock.json; every other type (Transitive, CentralTransitive) is transitive
+const (
+	lockTypeDirect  = "Direct"
+	lockTypeProject = "Project"
+)
+
+// runtimeIdentifierSeparator separates the framework from the runtime identifier in runtime specific graphs
+const runtimeIdentifierSeparator = "/"
+
+// lockDependency represents a single package entry of a target framework in packages.lock.json
+type lockDependency struct {
+	Type         string            `json:"type"`
+	Requested    string            `json:"requested"`
+	Resolved     string            `json:"resolved"`
+	ContentHash  string            `json:"contentHash"`
+	Dependencies map[string]string `json:"dependencies"`
+}
+
+// packagesLock represents the packages.lock.json structure
+type packagesLock struct {
+	Version      int                                  `json:"version"`
+	Dependencies map[string]map[string]lockDependency `json:"dependencies"`
+}
+
+// Parse implements the Parser interface for packages.lock.json files
+func (p *DotnetPackagesLockParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	var lock packagesLock
+	if err := json.Unmarshal(content, &lock); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	locations, err := jsonutil.FindLocations(content)
+	if err != nil {
+		return nil, err
+	}
+	frameworks := pkgutil.SortedKeys(lock.Dependencies)
+
+	var packages []models.Package
+	for _, framework := range frameworks {
+		// Runtime specific graphs (net8.0/win-x64) repeat the framework graph
+		if strings.Contains(framework, runtimeIdentifierSeparator) {
+			continue
+		}
+
+		for _, name := range pkgutil.SortedKeys(lock.Dependencies[framework]) {
+			dep := lock.Dependencies[framework][name]
+			if dep.Type == lockTypeProject {
+				continue
+			}
+
+			var depLocations []models.Location
+			if location, ok := locations.Find("dependencies", framework, name); ok {
+				depLocations = append(depLocations, location)
+			}
+
+			packages = append(packages, models.Package{
+				PackageManager: "nuget",
+				PackageName:    name,
+				Version:        dep.Resolved,
+				FilePath:       manifestFile,
+				Locations:      depLocations,
+				Transitive:     dep.Type != lockTypeDirect,
+				Hashes:         pkgutil.SRIHash("sha512", dep.ContentHash),
+				Metadata:       map[string]string{"targetFramework": framework},
+			})
+		}
+	}
+
+	pkgutil.SortByFirstLine(packages)
+	return packages, nil
+}
+
+// loadLockedVersions reads the lock file next to a project and maps lower-cased package ids to resolved versions.
+// packages.lock.json (or the file named by NuGetLockFilePath) is preferred over obj/project.assets.json.
+// Only the graphs of the given target frameworks are used; the graphs of all frameworks are merged when none of
+// them is locked.
+func loadLockedVersions(projectFile string, props msbuildProperties, frameworks []string) map[string]string {
+	dir := filepath.Dir(projectFile)
+
+	lockFile := filepath.Join(dir, PackagesLockJson)
+	if custom, ok := props.lookup("NuGetLockFilePath"); ok && custom != "" {
+		lockFile = filepath.FromSlash(strings.ReplaceAll(custom, `\`, "/"))
+		if !filepath.IsAbs(lockFile) {
+			lockFile = filepath.Join(dir, lockFile)
+		}
+	}
+
+	var packages []models.Package
+	if fileExists(lockFile) {
+		packages, _ = (&DotnetPackagesLockParser{}).Parse(lockFile)
+	} else if assetsFile := filepath.Join(dir, "obj", ProjectAssetsJson); fileExists(assetsFile) {
+		packages, _ = (&DotnetProjectAssetsParser{}).Parse(assetsFile)
+	}
+
+	var selected []models.Package
+	for _, pkg := range packages {
+		if containsFold(frameworks, pkg.Metadata["targetFramework"]) {
+			selected = append(selected, pkg)
+		}
+	}
+	if len(selected) > 0 {
+		packages = selected
+	}
+
+	locked := make(map[string]string)
+	for _, pkg := range packages {
+		key := strings.ToLower(pkg.PackageName)
+		// Prefer the versions of direct references when a package appears in several graphs
+		if _, exists := locked[key]; !exists || !pkg.Transitive {
+			locked[key] = pkg.Version
+		}
+	}
+	return locked
+}
+
+// fileExists reports whether path is an existing regular file
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
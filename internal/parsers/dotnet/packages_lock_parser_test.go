@@ -0,0 +1,235 @@
+package dotnet
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestDotnetPackagesLockParser_Parse(t *testing.T) {
+	parser := &DotnetPackagesLockParser{}
+	manifestFile := "../../../internal/testdata/packages.lock.json"
+
+	packages, err := parser.Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Error parsing manifest file: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{
+			PackageManager: "nuget",
+			PackageName:    "Newtonsoft.Json",
+			Version:        "13.0.3",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 4, StartIndex: 6, EndIndex: 23}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Serilog",
+			Version:        "3.1.1",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 10, StartIndex: 6, EndIndex: 15}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "System.Runtime",
+			Version:        "4.3.0",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 16, StartIndex: 6, EndIndex: 22}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Newtonsoft.Json",
+			Version:        "13.0.3",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 33, StartIndex: 6, EndIndex: 23}},
+		},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+
+	wantTransitive := []bool{false, false, true, false}
+	wantFrameworks := []string{"net6.0", "net6.0", "net6.0", "net8.0"}
+	for i, pkg := range packages {
+		if pkg.Transitive != wantTransitive[i] {
+			t.Errorf("%s Transitive: got %v, want %v", pkg.PackageName, pkg.Transitive, wantTransitive[i])
+		}
+		if got := pkg.Metadata["targetFramework"]; got != wantFrameworks[i] {
+			t.Errorf("%s targetFramework: got %q, want %q", pkg.PackageName, got, wantFrameworks[i])
+		}
+	}
+
+	wantHash := "sha512-P6G4/4Kt9bT635bhuwdXlJ2SCqqn2nhh4gqFqQueCOr9bK/e7W9ll/IoX1Ter948cV2Z/5+5v8pAfJYUISY03A=="
+	if len(packages[1].Hashes) != 1 || packages[1].Hashes[0] != wantHash {
+		t.Errorf("Serilog hashes: got %v, want [%s]", packages[1].Hashes, wantHash)
+	}
+}
+
+func TestDotnetPackagesLockParser_InvalidJson(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), PackagesLockJson)
+	writeFile(t, manifestFile, `{"version": 1, "dependencies": `)
+
+	if _, err := (&DotnetPackagesLockParser{}).Parse(manifestFile); err == nil {
+		t.Error("Expected error but got none")
+	}
+}
+
+func TestDotnetPackagesLockParser_NestedDependencyNames(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), PackagesLockJson)
+	writeFile(t, manifestFile, `{
+  "version": 1,
+  "dependencies": {
+    "net8.0": {
+      "Alpha": {
+        "type": "Direct",
+        "requested": "[1.0.0, )",
+        "resolved": "1.0.0",
+        "dependencies": {
+          "Zeta": "2.0.0"
+        }
+      },
+      "Zeta": {
+        "type": "Transitive",
+        "resolved": "2.0.0"
+      }
+    }
+  }
+}`)
+
+	packages, err := (&DotnetPackagesLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	testdata.ValidatePackages(t, packages, []models.Package{
+		{
+			PackageManager: "nuget",
+			PackageName:    "Alpha",
+			Version:        "1.0.0",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 4, StartIndex: 6, EndIndex: 13}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Zeta",
+			Version:        "2.0.0",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 12, StartIndex: 6, EndIndex: 12}},
+		},
+	})
+}
+
+func TestDotnetCsprojParser_FloatingVersionFromLockFile(t *testing.T) {
+	root := t.TempDir()
+	project := filepath.Join(root, "App.csproj")
+	writeFile(t, project, `<Project Sdk="Microsoft.NET.Sdk">
+  <ItemGroup>
+    <PackageReference Include="Newtonsoft.Json" Version="13.*" />
+    <PackageReference Include="Serilog" Version="[3.0.0,4.0.0)" />
+    <PackageReference Include="Polly" Version="8.*" />
+  </ItemGroup>
+</Project>`)
+	writeFile(t, filepath.Join(root, PackagesLockJson), `{
+  "version": 1,
+  "dependencies": {
+    "net8.0": {
+      "Newtonsoft.Json": { "type": "Direct", "requested": "[13.*, )", "resolved": "13.0.3" },
+      "Serilog": { "type": "Direct", "requested": "[3.0.0, 4.0.0)", "resolved": "3.1.1" }
+    }
+  }
+}`)
+
+	packages, err := (&DotnetCsprojParser{}).Parse(project)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := map[string]string{"Newtonsoft.Json": "13.0.3", "Serilog": "3.1.1", "Polly": "latest"}
+	if len(packages) != len(want) {
+		t.Fatalf("Expected %d packages, got %d", len(want), len(packages))
+	}
+	for _, pkg := range packages {
+		if pkg.Version != want[pkg.PackageName] {
+			t.Errorf("%s Version: got %q, want %q", pkg.PackageName, pkg.Version, want[pkg.PackageName])
+		}
+	}
+}
+
+func TestDotnetCsprojParser_FloatingVersionFromAssetsFile(t *testing.T) {
+	root := t.TempDir()
+	project := filepath.Join(root, "App.csproj")
+	writeFile(t, project, `<Project Sdk="Microsoft.NET.Sdk">
+  <ItemGroup>
+    <PackageReference Include="Serilog" Version="3.*" />
+  </ItemGroup>
+</Project>`)
+	writeFile(t, filepath.Join(root, "obj", ProjectAssetsJson), `{
+  "version": 3,
+  "targets": { "net8.0": { "Serilog/3.1.1": { "type": "package" } } },
+  "projectFileDependencyGroups": { "net8.0": [ "Serilog >= 3.0.0" ] }
+}`)
+
+	packages, err := (&DotnetCsprojParser{}).Parse(project)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(packages) != 1 || packages[0].Version != "3.1.1" {
+		t.Errorf("Expected Serilog 3.1.1, got %+v", packages)
+	}
+}
+
+func TestDotnetCsprojParser_LockedVersionOfSelectedFramework(t *testing.T) {
+	root := t.TempDir()
+	project := filepath.Join(root, "App.csproj")
+	writeFile(t, project, `<Project Sdk="Microsoft.NET.Sdk">
+  <PropertyGroup>
+    <TargetFrameworks>net6.0;net8.0</TargetFrameworks>
+  </PropertyGroup>
+  <ItemGroup>
+    <PackageReference Include="Serilog" Version="3.*" />
+  </ItemGroup>
+</Project>`)
+	writeFile(t, filepath.Join(root, PackagesLockJson), `{
+  "version": 1,
+  "dependencies": {
+    "net6.0": {
+      "Serilog": { "type": "Direct", "requested": "[3.*, )", "resolved": "3.0.1" }
+    },
+    "net8.0": {
+      "Serilog": { "type": "Direct", "requested": "[3.*, )", "resolved": "3.1.1" }
+    },
+    "net9.0": {
+      "Serilog": { "type": "Direct", "requested": "[3.*, )", "resolved": "3.2.0" }
+    }
+  }
+}`)
+
+	tests := []struct {
+		framework string
+		want      string
+	}{
+		{"net6.0", "3.0.1"},
+		{"NET8.0", "3.1.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.framework, func(t *testing.T) {
+			packages, err := (&DotnetCsprojParser{TargetFramework: tt.framework}).Parse(project)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(packages) != 1 || packages[0].Version != tt.want {
+				t.Errorf("Expected Serilog %s, got %+v", tt.want, packages)
+			}
+		})
+	}
+
+	packages, err := (&DotnetCsprojParser{TargetFramework: "net48"}).Parse(project)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(packages) != 1 || packages[0].Version == "latest" {
+		t.Errorf("Expected Serilog resolved from the merged lock file, got %+v", packages)
+	}
+}
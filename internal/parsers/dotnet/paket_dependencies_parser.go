@@ -0,0 +1,218 @@
+package dotnet
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// DotnetPaketDependenciesParser implements parsing of Paket dependency files (paket.dependencies)
+type DotnetPaketDependenciesParser struct{}
+
+// Paket file names
+const (
+	PaketDependencies = "paket.dependencies"
+	PaketLock         = "paket.lock"
+)
+
+// paketMainGroup is the name of the group that holds dependencies declared outside any group
+const paketMainGroup = "Main"
+
+// Package managers of the remote file sources supported by Paket
+const (
+	paketGithubManager  = "github"
+	paketGenericManager = "generic"
+)
+
+// paketOptionPattern matches the start of a "name: value" option, e.g. "restriction: >= net6.0".
+// The colon must be followed by a space so URLs like "https://..." are not taken for options.
+var paketOptionPattern = regexp.MustCompile(`(?:^|\s)([a-z_]+):(?:\s+|$)`)
+
+// paketPrereleaseChannels are keywords allowing prerelease versions in a version constraint
+var paketPrereleaseChannels = map[string]bool{"prerelease": true, "alpha": true, "beta": true, "rc": true}
+
+// splitPaketOptions splits a declaration into its leading part and its "name: value" options
+func splitPaketOptions(text string) (string, map[string]string) {
+	matches := paketOptionPattern.FindAllStringSubmatchIndex(text, -1)
+	if len(matches) == 0 {
+		return strings.TrimSpace(text), nil
+	}
+
+	options := make(map[string]string)
+	for i, match := range matches {
+		end := len(text)
+		if i+1 < len(matches) {
+			end = matches[i+1][0]
+		}
+		options[text[match[2]:match[3]]] = strings.TrimSpace(text[match[1]:end])
+	}
+	return strings.TrimSpace(text[:matches[0][0]]), options
+}
+
+// parsePaketVersion handles version resolution for Paket version constraints
+// - Returns the version for exact constraints ("1.2.3", "= 1.2.3" or "== 1.2.3")
+// - Returns "latest" for missing constraints, ranges and pessimistic constraints ("~> 1.2")
+func parsePaketVersion(constraint string) string {
+	var parts []string
+	for _, field := range strings.Fields(constraint) {
+		if !paketPrereleaseChannels[strings.ToLower(field)] {
+			parts = append(parts, field)
+		}
+	}
+
+	switch {
+	case len(parts) == 1 && !strings.ContainsAny(parts[0], "<>=~*"):
+		return parts[0]
+	case len(parts) == 2 && (parts[0] == "=" || parts[0] == "=="):
+		return parts[1]
+	default:
+		return "latest"
+	}
+}
+
+// paketRestriction returns the framework restriction of a declaration, falling back to the group restriction
+func paketRestriction(options map[string]string, groupRestriction string) string {
+	if restriction := options["restriction"]; restriction != "" {
+		return restriction
+	}
+	if framework := options["framework"]; framework != "" {
+		return framework
+	}
+	return groupRestriction
+}
+
+// paketMetadata builds the metadata of a Paket package
+func paketMetadata(restriction string, extra map[string]string) map[string]string {
+	metadata := make(map[string]string, len(extra)+1)
+	for key, value := range extra {
+		if value != "" {
+			metadata[key] = value
+		}
+	}
+	if restriction != "" {
+		metadata["restriction"] = restriction
+	}
+	if len(metadata) == 0 {
+		return nil
+	}
+	return metadata
+}
+
+// computePaketLineLocation calculates the location of a declaration line, without its indentation
+func computePaketLineLocation(lines []string, lineNum int) models.Location {
+	line := strings.TrimRight(lines[lineNum], " \t\r")
+	return models.Location{
+		Line:       lineNum,
+		StartIndex: len(line) - len(strings.TrimLeft(line, " \t")),
+		EndIndex:   len(line),
+	}
+}
+
+// stripPaketComment removes "//" and "#" comments from a paket.dependencies line
+func stripPaketComment(line string) string {
+	trimmed := strings.TrimSpace(line)
+	if strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "#") {
+		return ""
+	}
+	// Inline comments need a leading space so URLs like https://... are kept
+	if idx := strings.Index(line, " //"); idx >= 0 {
+		line = line[:idx]
+	}
+	return line
+}
+
+// parsePaketRemoteFile parses the arguments of a "github" or "gist" declaration, e.g. "owner/repo:commit path/file.fs"
+func parsePaketRemoteFile(args []string) (name, version, file string) {
+	name, version, _ = strings.Cut(args[0], ":")
+	if version == "" {
+		version = "latest"
+	}
+	if len(args) > 1 {
+		file = args[1]
+	}
+	return name, version, file
+}
+
+// Parse implements the Parser interface for paket.dependencies files
+func (p *DotnetPaketDependenciesParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	lines := strings.Split(string(content), "\n")
+	group := paketMainGroup
+	groupRestriction := ""
+	var packages []models.Package
+
+	for i, raw := range lines {
+		line := strings.TrimSpace(stripPaketComment(raw))
+		if line == "" {
+			continue
+		}
+
+		keyword, rest, _ := strings.Cut(line, " ")
+		rest = strings.TrimSpace(rest)
+		declaration, options := splitPaketOptions(rest)
+		args := strings.Fields(declaration)
+
+		pkg := models.Package{
+			FilePath:  manifestFile,
+			Locations: []models.Location{computePaketLineLocation(lines, i)},
+			Scopes:    []string{group},
+		}
+
+		switch strings.ToLower(keyword) {
+		case "group":
+			group = rest
+			groupRestriction = ""
+			continue
+		case "framework:", "restriction:":
+			groupRestriction = rest
+			continue
+		case "nuget":
+			if len(args) == 0 {
+				continue
+			}
+			pkg.PackageManager = "nuget"
+			pkg.PackageName = args[0]
+			pkg.Version = parsePaketVersion(strings.Join(args[1:], " "))
+			pkg.Metadata = paketMetadata(paketRestriction(options, groupRestriction), nil)
+		case "github", "gist":
+			if len(args) == 0 {
+				continue
+			}
+			var file string
+			pkg.PackageManager = paketGithubManager
+			pkg.PackageName, pkg.Version, file = parsePaketRemoteFile(args)
+			pkg.Metadata = paketMetadata("", map[string]string{"source": keyword, "file": file})
+		case "git", "http":
+			if len(args) == 0 {
+				continue
+			}
+			pkg.PackageManager = paketGenericManager
+			pkg.PackageName = args[0]
+			pkg.Version = "latest"
+			extra := map[string]string{"source": keyword}
+			if len(args) > 1 {
+				if keyword == "git" {
+					pkg.Version = parsePaketVersion(strings.Join(args[1:], " "))
+					extra["ref"] = args[1]
+				} else {
+					extra["file"] = args[1]
+				}
+			}
+			pkg.Metadata = paketMetadata("", extra)
+		default:
+			// Sources, caches and group settings
+			continue
+		}
+
+		packages = append(packages, pkg)
+	}
+
+	return packages, nil
+}
@@ -0,0 +1,96 @@
+package dotnet
+
+import (
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestDotnetPaketDependenciesParser_Parse(t *testing.T) {
+	parser := &DotnetPaketDependenciesParser{}
+	manifestFile := "../../../internal/testdata/paket.dependencies"
+
+	packages, err := parser.Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Error parsing manifest file: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{
+			PackageManager: "nuget",
+			PackageName:    "FSharp.Core",
+			Version:        "6.0.7",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 4, StartIndex: 0, EndIndex: 23}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Newtonsoft.Json",
+			Version:        "latest",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 5, StartIndex: 0, EndIndex: 52}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Argu",
+			Version:        "latest",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 6, StartIndex: 0, EndIndex: 28}},
+		},
+		{
+			PackageManager: "github",
+			PackageName:    "fsprojects/FSharp.TypeProviders.SDK",
+			Version:        "a1b2c3d",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 7, StartIndex: 0, EndIndex: 71}},
+		},
+		{
+			PackageManager: "generic",
+			PackageName:    "http://www.fssnip.net/raw/1M",
+			Version:        "latest",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 8, StartIndex: 0, EndIndex: 42}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Fake.Core.Target",
+			Version:        "5.23.1",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 13, StartIndex: 4, EndIndex: 35}},
+		},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+
+	wantGroups := []string{"Main", "Main", "Main", "Main", "Main", "Build"}
+	wantRestrictions := []string{"net6.0, netstandard2.0", ">= net6.0", "net6.0, netstandard2.0", "", "", ""}
+	for i, pkg := range packages {
+		if len(pkg.Scopes) != 1 || pkg.Scopes[0] != wantGroups[i] {
+			t.Errorf("%s Scopes: got %v, want [%s]", pkg.PackageName, pkg.Scopes, wantGroups[i])
+		}
+		if got := pkg.Metadata["restriction"]; got != wantRestrictions[i] {
+			t.Errorf("%s restriction: got %q, want %q", pkg.PackageName, got, wantRestrictions[i])
+		}
+	}
+	if got := packages[3].Metadata["file"]; got != "src/ProvidedTypes.fs" {
+		t.Errorf("github file: got %q, want %q", got, "src/ProvidedTypes.fs")
+	}
+}
+
+func TestParsePaketVersion(t *testing.T) {
+	tests := map[string]string{
+		"":                 "latest",
+		"1.2.3":            "1.2.3",
+		"= 1.2.3":          "1.2.3",
+		"== 1.2.3":         "1.2.3",
+		"~> 1.2":           "latest",
+		">= 1.0 < 2.0":     "latest",
+		"1.2.3 prerelease": "1.2.3",
+	}
+	for constraint, want := range tests {
+		if got := parsePaketVersion(constraint); got != want {
+			t.Errorf("parsePaketVersion(%q) = %q; want %q", constraint, got, want)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package dotnet
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// DotnetPaketLockParser implements parsing of Paket lock files (paket.lock)
+type DotnetPaketLockParser struct{}
+
+// Indentation of the lines in paket.lock; resolved entries are nested below their remote,
+// and the dependencies of an entry are nested below the entry
+const (
+	paketLockRemoteIndent     = 2
+	paketLockEntryIndent      = 4
+	paketLockDependencyIndent = 6
+)
+
+// paketLockEntry is a resolved entry of paket.lock, e.g. "Newtonsoft.Json (13.0.3) - restriction: >= net6.0"
+type paketLockEntry struct {
+	name    string
+	version string
+	options map[string]string
+}
+
+// parsePaketLockEntry parses a resolved entry line
+func parsePaketLockEntry(line string) paketLockEntry {
+	declaration, rest, _ := strings.Cut(line, " - ")
+	_, options := splitPaketOptions(rest)
+
+	var entry paketLockEntry
+	entry.options = options
+	if open := strings.LastIndex(declaration, "("); open >= 0 {
+		entry.name = strings.TrimSpace(declaration[:open])
+		entry.version = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(declaration[open+1:]), ")"))
+	} else {
+		entry.name = strings.TrimSpace(declaration)
+	}
+	return entry
+}
+
+// loadPaketDirectDependencies reads the paket.dependencies file next to a lock file.
+// It returns the lower-cased "group/name" keys of the declared NuGet packages, or nil if there is no such file.
+func loadPaketDirectDependencies(lockFile string) map[string]bool {
+	dependenciesFile := filepath.Join(filepath.Dir(lockFile), PaketDependencies)
+	if !fileExists(dependenciesFile) {
+		return nil
+	}
+
+	packages, err := (&DotnetPaketDependenciesParser{}).Parse(dependenciesFile)
+	if err != nil {
+		return nil
+	}
+
+	direct := make(map[string]bool)
+	for _, pkg := range packages {
+		if pkg.PackageManager == "nuget" {
+			direct[paketGroupKey(pkg.Scopes[0], pkg.PackageName)] = true
+		}
+	}
+	return direct
+}
+
+// paketGroupKey returns the case-insensitive key of a package in a group
+func paketGroupKey(group, name string) string {
+	return strings.ToLower(group + "/" + name)
+}
+
+// Parse implements the Parser interface for paket.lock files
+func (p *DotnetPaketLockParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	direct := loadPaketDirectDependencies(manifestFile)
+	lines := strings.Split(string(content), "\n")
+	group := paketMainGroup
+	groupRestriction := ""
+	section := ""
+	remote := ""
+	var packages []models.Package
+
+	for i, raw := range lines {
+		line := strings.TrimRight(raw, " \t\r")
+		trimmed := strings.TrimLeft(line, " ")
+		if trimmed == "" {
+			continue
+		}
+		indent := len(line) - len(trimmed)
+
+		// Group, section and remote headers
+		switch {
+		case indent == 0:
+			keyword, rest, _ := strings.Cut(trimmed, " ")
+			switch keyword {
+			case "GROUP":
+				group = strings.TrimSpace(rest)
+				groupRestriction = ""
+			case "RESTRICTION:", "FRAMEWORK:":
+				groupRestriction = strings.TrimSpace(rest)
+			default:
+				section = keyword
+			}
+			remote = ""
+			continue
+		case indent == paketLockRemoteIndent:
+			if value, found := strings.CutPrefix(trimmed, "remote:"); found {
+				remote = strings.TrimSpace(value)
+			}
+			continue
+		case indent < paketLockEntryIndent || indent >= paketLockDependencyIndent:
+			// Dependencies of an entry are resolved entries themselves
+			continue
+		}
+
+		entry := parsePaketLockEntry(trimmed)
+		pkg := models.Package{
+			FilePath:  manifestFile,
+			Locations: []models.Location{computePaketLineLocation(lines, i)},
+			Scopes:    []string{group},
+		}
+
+		switch section {
+		case "NUGET":
+			pkg.PackageManager = "nuget"
+			pkg.PackageName = entry.name
+			pkg.Version = entry.version
+			pkg.Transitive = direct != nil && !direct[paketGroupKey(group, entry.name)]
+			pkg.Metadata = paketMetadata(paketRestriction(entry.options, groupRestriction), nil)
+		case "GITHUB", "GIST":
+			pkg.PackageManager = paketGithubManager
+			pkg.PackageName = remote
+			pkg.Version = entry.version
+			pkg.Metadata = paketMetadata("", map[string]string{"source": strings.ToLower(section), "file": entry.name})
+		case "GIT":
+			pkg.PackageManager = paketGenericManager
+			pkg.PackageName = remote
+			pkg.Version = entry.version
+			pkg.Metadata = paketMetadata("", map[string]string{"source": "git"})
+		case "HTTP":
+			pkg.PackageManager = paketGenericManager
+			pkg.PackageName = strings.TrimSuffix(remote, "/") + entry.version
+			pkg.Version = "latest"
+			pkg.Metadata = paketMetadata("", map[string]string{"source": "http", "file": entry.name})
+		default:
+			continue
+		}
+
+		if pkg.PackageName == "" {
+			continue
+		}
+		packages = append(packages, pkg)
+	}
+
+	return packages, nil
+}
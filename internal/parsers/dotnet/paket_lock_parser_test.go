@@ -0,0 +1,113 @@
+package dotnet
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestDotnetPaketLockParser_Parse(t *testing.T) {
+	parser := &DotnetPaketLockParser{}
+	manifestFile := "../../../internal/testdata/paket.lock"
+
+	packages, err := parser.Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Error parsing manifest file: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{
+			PackageManager: "nuget",
+			PackageName:    "Argu",
+			Version:        "6.1.1",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 4, StartIndex: 4, EndIndex: 16}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "FSharp.Core",
+			Version:        "6.0.7",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 6, StartIndex: 4, EndIndex: 23}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Newtonsoft.Json",
+			Version:        "13.0.3",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 7, StartIndex: 4, EndIndex: 53}},
+		},
+		{
+			PackageManager: "github",
+			PackageName:    "fsprojects/FSharp.TypeProviders.SDK",
+			Version:        "a1b2c3d4e5f60718293a4b5c6d7e8f9012345678",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 10, StartIndex: 4, EndIndex: 67}},
+		},
+		{
+			PackageManager: "generic",
+			PackageName:    "http://www.fssnip.net/raw/1M",
+			Version:        "latest",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 13, StartIndex: 4, EndIndex: 22}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Fake.Core.Target",
+			Version:        "5.23.1",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 19, StartIndex: 4, EndIndex: 29}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Fake.Core.Trace",
+			Version:        "5.23.1",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 20, StartIndex: 4, EndIndex: 28}},
+		},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+
+	wantGroups := []string{"Main", "Main", "Main", "Main", "Main", "Build", "Build"}
+	wantTransitive := []bool{false, false, false, false, false, false, true}
+	wantRestrictions := []string{"|| (== net6.0) (== netstandard2.0)", "|| (== net6.0) (== netstandard2.0)", ">= net6.0", "", "", "== net6.0", "== net6.0"}
+	for i, pkg := range packages {
+		if len(pkg.Scopes) != 1 || pkg.Scopes[0] != wantGroups[i] {
+			t.Errorf("%s Scopes: got %v, want [%s]", pkg.PackageName, pkg.Scopes, wantGroups[i])
+		}
+		if pkg.Transitive != wantTransitive[i] {
+			t.Errorf("%s Transitive: got %v, want %v", pkg.PackageName, pkg.Transitive, wantTransitive[i])
+		}
+		if got := pkg.Metadata["restriction"]; got != wantRestrictions[i] {
+			t.Errorf("%s restriction: got %q, want %q", pkg.PackageName, got, wantRestrictions[i])
+		}
+	}
+}
+
+func TestDotnetPaketLockParser_WithoutDependenciesFile(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), PaketLock)
+	writeFile(t, manifestFile, `NUGET
+  remote: https://api.nuget.org/v3/index.json
+    Serilog (3.1.1)
+GIT
+  remote: https://github.com/fsprojects/Paket.git
+     (528024723f314aa1011499a122258167b53699f7)
+`)
+
+	packages, err := (&DotnetPaketLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(packages) != 2 {
+		t.Fatalf("Expected 2 packages, got %d: %+v", len(packages), packages)
+	}
+	if packages[0].Transitive {
+		t.Errorf("Serilog should not be transitive without paket.dependencies")
+	}
+	if packages[1].PackageName != "https://github.com/fsprojects/Paket.git" || packages[1].Version != "528024723f314aa1011499a122258167b53699f7" {
+		t.Errorf("Unexpected git package: %+v", packages[1])
+	}
+}
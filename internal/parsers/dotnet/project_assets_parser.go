@@ -0,0 +1,110 @@
+package dotnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/jsonutil"
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// DotnetProjectAssetsParser implements parsing of NuGet restore outputs (obj/project.assets.json)
+type DotnetProjectAssetsParser struct{}
+
+// assetsTypePackage is the library type of NuGet packages; project references use "project"
+const assetsTypePackage = "package"
+
+// assetsLibrary represents an entry of the "libraries" section in project.assets.json
+type assetsLibrary struct {
+	Sha512 string `json:"sha512"`
+	Type   string `json:"type"`
+	Path   string `json:"path"`
+}
+
+// assetsTargetLibrary represents a resolved package of a target graph in project.assets.json
+type assetsTargetLibrary struct {
+	Type         string            `json:"type"`
+	Dependencies map[string]string `json:"dependencies"`
+}
+
+// projectAssets represents the project.assets.json structure
+type projectAssets struct {
+	Version                     int                                       `json:"version"`
+	Targets                     map[string]map[string]assetsTargetLibrary `json:"targets"`
+	Libraries                   map[string]assetsLibrary                  `json:"libraries"`
+	ProjectFileDependencyGroups map[string][]string                       `json:"projectFileDependencyGroups"`
+}
+
+// directDependencies returns the lower-cased ids of the packages a project references directly for a target.
+// Entries of projectFileDependencyGroups look like "Newtonsoft.Json >= 13.0.1".
+func (a projectAssets) directDependencies(target string) map[string]bool {
+	direct := make(map[string]bool)
+	for _, entry := range a.ProjectFileDependencyGroups[target] {
+		if fields := strings.Fields(entry); len(fields) > 0 {
+			direct[strings.ToLower(fields[0])] = true
+		}
+	}
+	return direct
+}
+
+// Parse implements the Parser interface for project.assets.json files
+func (p *DotnetProjectAssetsParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	var assets projectAssets
+	if err := json.Unmarshal(content, &assets); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	locations, err := jsonutil.FindLocations(content)
+	if err != nil {
+		return nil, err
+	}
+	targets := pkgutil.SortedKeys(assets.Targets)
+
+	var packages []models.Package
+	for _, target := range targets {
+		// Runtime specific graphs (net8.0/win-x64) repeat the framework graph
+		if strings.Contains(target, runtimeIdentifierSeparator) {
+			continue
+		}
+
+		direct := assets.directDependencies(target)
+		for _, key := range pkgutil.SortedKeys(assets.Targets[target]) {
+			if assets.Targets[target][key].Type != assetsTypePackage {
+				continue
+			}
+
+			// Keys have the form "<id>/<version>"
+			name, version, found := strings.Cut(key, "/")
+			if !found {
+				continue
+			}
+
+			var depLocations []models.Location
+			if location, ok := locations.Find("targets", target, key); ok {
+				depLocations = append(depLocations, location)
+			}
+
+			packages = append(packages, models.Package{
+				PackageManager: "nuget",
+				PackageName:    name,
+				Version:        version,
+				FilePath:       manifestFile,
+				Locations:      depLocations,
+				Transitive:     !direct[strings.ToLower(name)],
+				Hashes:         pkgutil.SRIHash("sha512", assets.Libraries[key].Sha512),
+				Metadata:       map[string]string{"targetFramework": target},
+			})
+		}
+	}
+
+	pkgutil.SortByFirstLine(packages)
+	return packages, nil
+}
@@ -0,0 +1,57 @@
+package dotnet
+
+import (
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestDotnetProjectAssetsParser_Parse(t *testing.T) {
+	parser := &DotnetProjectAssetsParser{}
+	manifestFile := "../../../internal/testdata/project.assets.json"
+
+	packages, err := parser.Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Error parsing manifest file: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{
+			PackageManager: "nuget",
+			PackageName:    "Newtonsoft.Json",
+			Version:        "13.0.3",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 4, StartIndex: 6, EndIndex: 30}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "Serilog",
+			Version:        "3.1.1",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 10, StartIndex: 6, EndIndex: 21}},
+		},
+		{
+			PackageManager: "nuget",
+			PackageName:    "System.Runtime",
+			Version:        "4.3.0",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 16, StartIndex: 6, EndIndex: 28}},
+		},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+
+	wantTransitive := []bool{false, false, true}
+	for i, pkg := range packages {
+		if pkg.Transitive != wantTransitive[i] {
+			t.Errorf("%s Transitive: got %v, want %v", pkg.PackageName, pkg.Transitive, wantTransitive[i])
+		}
+		if got := pkg.Metadata["targetFramework"]; got != "net8.0" {
+			t.Errorf("%s targetFramework: got %q, want %q", pkg.PackageName, got, "net8.0")
+		}
+		if len(pkg.Hashes) != 1 {
+			t.Errorf("%s Hashes: got %v, want one hash", pkg.PackageName, pkg.Hashes)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package golang
+
+import (
+	"bytes"
+	"debug/buildinfo"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// GoBinaryParser is a parser for the build information embedded in compiled Go executables.
+type GoBinaryParser struct{}
+
+// executableMagics are the leading bytes of the executable formats Go can build
+var executableMagics = [][]byte{
+	[]byte("\x7FELF"),        // ELF
+	[]byte("MZ"),             // PE
+	{0xFE, 0xED, 0xFA, 0xCE}, // Mach-O 32-bit
+	{0xFE, 0xED, 0xFA, 0xCF}, // Mach-O 64-bit
+	{0xCE, 0xFA, 0xED, 0xFE}, // Mach-O 32-bit, little endian
+	{0xCF, 0xFA, 0xED, 0xFE}, // Mach-O 64-bit, little endian
+	{0x01, 0xDF},             // XCOFF 32-bit
+	{0x01, 0xF7},             // XCOFF 64-bit
+	{0x00, 0x61, 0x73, 0x6D}, // WebAssembly
+}
+
+// IsGoBinary reports whether a file is an executable with embedded Go build information.
+// The file header is checked first so that regular files are rejected without being parsed.
+func IsGoBinary(path string) bool {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+
+	header := make([]byte, 4)
+	n, err := io.ReadFull(file, header)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return false
+	}
+	header = header[:n]
+
+	isExecutable := false
+	for _, magic := range executableMagics {
+		if bytes.HasPrefix(header, magic) {
+			isExecutable = true
+			break
+		}
+	}
+	if !isExecutable {
+		return false
+	}
+
+	_, err = buildinfo.Read(file)
+	return err == nil
+}
+
+// binaryPackage converts a module of the build information into a package
+func binaryPackage(module *debug.Module, manifest string, goVersion string) models.Package {
+	pkg := models.Package{
+		PackageManager: "go",
+		PackageName:    module.Path,
+		Version:        module.Version,
+		FilePath:       manifest,
+		Metadata:       map[string]string{"goVersion": goVersion},
+	}
+
+	// The build information records the module that was actually built as the replacement
+	if module.Replace != nil {
+		if module.Replace.Version == "" {
+			pkg.Metadata["replacePath"] = module.Replace.Path
+			pkg.Metadata["localReplace"] = "true"
+		} else {
+			pkg.PackageName = module.Replace.Path
+			pkg.Version = module.Replace.Version
+			pkg.Metadata["originalName"] = module.Path
+			pkg.Metadata["originalVersion"] = module.Version
+		}
+		module = module.Replace
+	}
+
+	if module.Sum != "" {
+		pkg.Hashes = []string{module.Sum}
+	}
+	return pkg
+}
+
+// Parse reads the build information of a Go executable and returns the main module and its dependencies.
+// Executables have no source lines, so the packages have no locations.
+func (p *GoBinaryParser) Parse(manifest string) ([]models.Package, error) {
+	info, err := buildinfo.ReadFile(filepath.Clean(manifest))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Go build information: %w", err)
+	}
+
+	var packages []models.Package
+	if info.Main.Path != "" {
+		main := binaryPackage(&info.Main, manifest, info.GoVersion)
+		main.Metadata["mainModule"] = "true"
+		packages = append(packages, main)
+	}
+	for _, dep := range info.Deps {
+		packages = append(packages, binaryPackage(dep, manifest, info.GoVersion))
+	}
+	return packages, nil
+}
@@ -0,0 +1,56 @@
+package golang
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGoBinaryParser_Parse(t *testing.T) {
+	// The test binary embeds the build information of this module
+	manifestFile, err := os.Executable()
+	if err != nil {
+		t.Skipf("Test executable not available: %v", err)
+	}
+
+	packages, err := (&GoBinaryParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(packages) == 0 {
+		t.Fatal("Expected packages from the build information")
+	}
+
+	main := packages[0]
+	if main.PackageName != "github.com/Checkmarx/manifest-parser" || main.Metadata["mainModule"] != "true" {
+		t.Errorf("Expected the main module first, got %+v", main)
+	}
+
+	found := false
+	for _, pkg := range packages[1:] {
+		if pkg.PackageManager != "go" || pkg.FilePath != manifestFile || pkg.Metadata["goVersion"] == "" {
+			t.Errorf("Unexpected package %+v", pkg)
+		}
+		if pkg.PackageName == "golang.org/x/mod" {
+			found = true
+			if pkg.Version == "" || len(pkg.Hashes) != 1 {
+				t.Errorf("Expected golang.org/x/mod with version and hash, got %+v", pkg)
+			}
+		}
+	}
+	if !found {
+		t.Error("Expected golang.org/x/mod among the dependencies")
+	}
+}
+
+func TestGoBinaryParser_NotABinary(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "app")
+	writeTestFile(t, manifestFile, "#!/bin/sh\necho hello\n")
+
+	if IsGoBinary(manifestFile) {
+		t.Error("A shell script is not a Go binary")
+	}
+	if _, err := (&GoBinaryParser{}).Parse(manifestFile); err == nil {
+		t.Error("Expected error but got none")
+	}
+}
@@ -1,10 +1,13 @@
 package golang
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
 	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
 
 	"golang.org/x/mod/modfile"
@@ -13,8 +16,210 @@ import (
 // GoModParser is a parser for Go modules.
 type GoModParser struct{}
 
+// tokenLocations returns the locations of the tokens of a go.mod line, using the byte offsets of the syntax tree.
+// Comments after the tokens, such as "// indirect", are not part of any location.
+func tokenLocations(data []byte, line *modfile.Line) []models.Location {
+	if line == nil || line.Start.Byte < 0 || line.Start.Byte > len(data) {
+		return nil
+	}
+
+	lineStart := bytes.LastIndexByte(data[:line.Start.Byte], '\n') + 1
+	lineEnd := len(data)
+	if idx := bytes.IndexByte(data[lineStart:], '\n'); idx >= 0 {
+		lineEnd = lineStart + idx
+	}
+	text := string(data[lineStart:lineEnd])
+
+	var locations []models.Location
+	cursor := line.Start.Byte - lineStart
+	for _, token := range line.Token {
+		idx := strings.Index(text[cursor:], token)
+		if idx < 0 {
+			return nil
+		}
+		start := cursor + idx
+		cursor = start + len(token)
+
+		// Quoted tokens such as "github.com/google/uuid" are unquoted in the syntax tree
+		if start > 0 && cursor < len(text) && isQuote(text[start-1]) && text[cursor] == text[start-1] {
+			start--
+			cursor++
+		}
+
+		locations = append(locations, models.Location{
+			Line:       line.Start.Line - 1,
+			StartIndex: start,
+			EndIndex:   cursor,
+		})
+	}
+	return locations
+}
+
+// isQuote reports whether c quotes a go.mod token
+func isQuote(c byte) bool {
+	return c == '"' || c == '`'
+}
+
+// directiveLocations returns the location of a directive entry followed by the locations of its tokens.
+// The keyword of single-line directives ("require x v" as opposed to an entry of a require block) is skipped.
+func directiveLocations(data []byte, line *modfile.Line) []models.Location {
+	tokens := tokenLocations(data, line)
+	if len(tokens) > 0 && !line.InBlock {
+		tokens = tokens[1:]
+	}
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	entry := models.Location{
+		Line:       tokens[0].Line,
+		StartIndex: tokens[0].StartIndex,
+		EndIndex:   tokens[len(tokens)-1].EndIndex,
+	}
+	return append([]models.Location{entry}, tokens...)
+}
+
+// findReplacement returns the replace directive that applies to a module version.
+// A replacement of a specific version takes precedence over one for all versions of the module.
+func findReplacement(replaces []*modfile.Replace, path, version string) *modfile.Replace {
+	var match *modfile.Replace
+	for _, rep := range replaces {
+		if rep.Old.Path != path {
+			continue
+		}
+		if rep.Old.Version == version {
+			return rep
+		}
+		if rep.Old.Version == "" {
+			match = rep
+		}
+	}
+	return match
+}
+
+// isLocalReplacement reports whether a replacement points to a directory instead of a module
+func isLocalReplacement(rep *modfile.Replace) bool {
+	return rep.New.Version == ""
+}
+
+// toolsByModule maps module paths to the tools of the tool directives they provide.
+// A tool belongs to the required module with the longest path prefix.
+func toolsByModule(mf *modfile.File) map[string][]string {
+	tools := make(map[string][]string)
+	for _, tool := range mf.Tool {
+		owner := ""
+		for _, req := range mf.Require {
+			path := req.Mod.Path
+			if (tool.Path == path || strings.HasPrefix(tool.Path, path+"/")) && len(path) > len(owner) {
+				owner = path
+			}
+		}
+		if owner != "" {
+			tools[owner] = append(tools[owner], tool.Path)
+		}
+	}
+	return tools
+}
+
+// excludeScope is the scope of module versions that exclude directives keep out of the build.
+// They are not dependencies of the module.
+const excludeScope = "exclude"
+
+// excludedPackages returns a package for every exclude directive, keyed to the excluded module path.
+// Replacements do not apply since the exclusion is about the original module version.
+func excludedPackages(mf *modfile.File, data []byte, manifest string) []models.Package {
+	var packages []models.Package
+	for _, exclude := range mf.Exclude {
+		locations := directiveLocations(data, exclude.Syntax)
+		if len(locations) == 0 {
+			continue
+		}
+		packages = append(packages, models.Package{
+			PackageManager: "go",
+			PackageName:    exclude.Mod.Path,
+			Version:        exclude.Mod.Version,
+			FilePath:       manifest,
+			Locations:      locations,
+			Scopes:         []string{excludeScope},
+		})
+	}
+	return packages
+}
+
+// retractions formats the retract directives of the module as go.mod writes them, e.g. v1.0.1 or [v1.2.0, v1.3.0]
+func retractions(mf *modfile.File) []string {
+	var retracted []string
+	for _, retract := range mf.Retract {
+		if retract.Low == retract.High {
+			retracted = append(retracted, retract.Low)
+		} else {
+			retracted = append(retracted, "["+retract.Low+", "+retract.High+"]")
+		}
+	}
+	return retracted
+}
+
+// mainModulePackage returns the module declared by the module directive, which carries the go, toolchain and
+// retract directives of the file. Retractions are separated by semicolons since version intervals contain commas.
+func mainModulePackage(mf *modfile.File, data []byte, manifest string) (models.Package, bool) {
+	if mf.Module == nil {
+		return models.Package{}, false
+	}
+	locations := directiveLocations(data, mf.Module.Syntax)
+	if len(locations) == 0 {
+		return models.Package{}, false
+	}
+
+	metadata := map[string]string{"mainModule": "true"}
+	if mf.Go != nil {
+		metadata["goVersion"] = mf.Go.Version
+	}
+	if mf.Toolchain != nil {
+		metadata["toolchain"] = mf.Toolchain.Name
+	}
+	if retracted := retractions(mf); len(retracted) > 0 {
+		metadata["retracted"] = strings.Join(retracted, ";")
+	}
+	return models.Package{
+		PackageManager: "go",
+		PackageName:    mf.Module.Mod.Path,
+		FilePath:       manifest,
+		Locations:      locations[:1],
+		Metadata:       metadata,
+	}, true
+}
+
+// replacementFor returns the replace directive that applies to a module version and the location of the directive.
+// Replacements of the workspace take precedence over the ones of the module.
+func replacementFor(mf *modfile.File, data []byte, ws *workspace, path, version string) (*modfile.Replace, []models.Location) {
+	if ws != nil {
+		if rep := findReplacement(ws.replaces, path, version); rep != nil {
+			locations := directiveLocations(ws.data, rep.Syntax)
+			if len(locations) == 0 {
+				return rep, nil
+			}
+			locations[0].FilePath = ws.file
+			return rep, locations[:1]
+		}
+	}
+
+	rep := findReplacement(mf.Replace, path, version)
+	if rep == nil {
+		return nil, nil
+	}
+	if locations := directiveLocations(data, rep.Syntax); len(locations) > 0 {
+		return rep, locations[:1]
+	}
+	return rep, nil
+}
+
 // Parse parses the Go module file and returns a list of packages.
 func (p *GoModParser) Parse(manifest string) ([]models.Package, error) {
+	return p.parseModFile(manifest, nil)
+}
+
+// parseModFile parses a go.mod file, applying the go.work state when the module is part of a workspace.
+func (p *GoModParser) parseModFile(manifest string, ws *workspace) ([]models.Package, error) {
 	cleanPath := filepath.Clean(manifest)
 	data, err := os.ReadFile(cleanPath)
 	if err != nil {
@@ -25,41 +230,70 @@ func (p *GoModParser) Parse(manifest string) ([]models.Package, error) {
 		return nil, err
 	}
 
-	// Split file into lines for position calculation
-	lines := strings.Split(string(data), "\n")
+	tools := toolsByModule(mf)
+	hashes := loadGoSumHashes(manifest)
 
 	var packages []models.Package
+	if main, found := mainModulePackage(mf, data, manifest); found {
+		packages = append(packages, main)
+	}
 	for _, req := range mf.Require {
-		// Find the line where the dependency appears
+		// Locate the entry, the module path and the version
 		depName := req.Mod.Path
 		depVersion := req.Mod.Version
-		lineNum := req.Syntax.Start.Line
-		if lineNum <= 0 || lineNum > len(lines) {
-			continue // skip if out of range
-		}
-		line := lines[lineNum-1]
-
-		// Find the start index of the dependency name in the line
-		startIdx := strings.Index(line, depName)
-		if startIdx == -1 {
-			startIdx = 0 // fallback
+		locations := directiveLocations(data, req.Syntax)
+		if len(locations) == 0 {
+			continue // skip if the tokens cannot be located
 		}
-		// End index: end of the line (like csproj_parser.go logic)
-		endIdx := len(line)
 
-		packages = append(packages, models.Package{
+		pkg := models.Package{
 			PackageManager: "go",
 			PackageName:    depName,
 			Version:        depVersion,
 			FilePath:       manifest,
-			Locations: []models.Location{
-				{
-					Line:       lineNum - 1,
-					StartIndex: startIdx,
-					EndIndex:   endIdx,
-				},
-			},
-		})
+			Locations:      locations,
+			Transitive:     req.Indirect,
+			Metadata:       make(map[string]string),
+		}
+
+		if moduleTools, found := tools[depName]; found {
+			sort.Strings(moduleTools)
+			pkg.Metadata["tools"] = strings.Join(moduleTools, ",")
+		}
+
+		// Modules of the workspace are built from their directory instead of the required version
+		if ws != nil && ws.modules[depName] != "" {
+			pkg.Metadata["workspaceModule"] = "true"
+			pkg.Metadata["replacePath"] = filepath.Dir(ws.modules[depName])
+			packages = append(packages, pkg)
+			continue
+		}
+
+		// Replace directives change the module and version that is actually built
+		if rep, replaceLocations := replacementFor(mf, data, ws, depName, depVersion); rep != nil {
+			pkg.Locations = append(pkg.Locations, replaceLocations...)
+			if isLocalReplacement(rep) {
+				pkg.Metadata["replacePath"] = rep.New.Path
+				pkg.Metadata["localReplace"] = "true"
+			} else {
+				pkg.PackageName = rep.New.Path
+				pkg.Version = rep.New.Version
+				pkg.Metadata["originalName"] = depName
+				pkg.Metadata["originalVersion"] = depVersion
+			}
+		}
+
+		if hash, found := hashes[pkg.PackageName+"@"+pkg.Version]; found {
+			pkg.Hashes = []string{hash}
+		}
+
+		if len(pkg.Metadata) == 0 {
+			pkg.Metadata = nil
+		}
+		packages = append(packages, pkg)
 	}
+
+	packages = append(packages, excludedPackages(mf, data, manifest)...)
+	pkgutil.SortByFirstLine(packages)
 	return packages, nil
 }
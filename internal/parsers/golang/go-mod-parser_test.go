@@ -1,6 +1,8 @@
 package golang
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/Checkmarx/manifest-parser/internal/testdata"
@@ -18,47 +20,279 @@ func TestGoModParser_Parse(t *testing.T) {
 	}
 
 	expectedPackages := []models.Package{
+		{
+			PackageManager: "go",
+			PackageName:    "github.com/checkmarx/ast-cli",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 0, StartIndex: 7, EndIndex: 35}},
+		},
 		{
 			PackageManager: "go",
 			PackageName:    "github.com/Checkmarx/containers-resolver",
 			Version:        "v1.0.9",
 			FilePath:       manifestFile,
-			Locations:      []models.Location{{Line: 5, StartIndex: 8, EndIndex: 55}},
+			Locations:      []models.Location{{Line: 5, StartIndex: 8, EndIndex: 55}, {Line: 5, StartIndex: 8, EndIndex: 48}, {Line: 5, StartIndex: 49, EndIndex: 55}},
 		},
 		{
 			PackageManager: "go",
 			PackageName:    "github.com/Checkmarx/gen-ai-prompts",
 			Version:        "v0.0.0-20240807143411-708ceec12b63",
 			FilePath:       manifestFile,
-			Locations:      []models.Location{{Line: 6, StartIndex: 8, EndIndex: 78}},
+			Locations:      []models.Location{{Line: 6, StartIndex: 8, EndIndex: 78}, {Line: 6, StartIndex: 8, EndIndex: 43}, {Line: 6, StartIndex: 44, EndIndex: 78}},
 		},
 		{
 			PackageManager: "go",
 			PackageName:    "gotest.tools",
 			Version:        "v2.2.0+incompatible",
 			FilePath:       manifestFile,
-			Locations:      []models.Location{{Line: 7, StartIndex: 8, EndIndex: 40}},
+			Locations:      []models.Location{{Line: 7, StartIndex: 8, EndIndex: 40}, {Line: 7, StartIndex: 8, EndIndex: 20}, {Line: 7, StartIndex: 21, EndIndex: 40}},
 		},
 		{
 			PackageManager: "go",
 			PackageName:    "dario.cat/mergo",
 			Version:        "v1.0.1",
 			FilePath:       manifestFile,
-			Locations:      []models.Location{{Line: 11, StartIndex: 8, EndIndex: 42}},
+			Locations:      []models.Location{{Line: 11, StartIndex: 8, EndIndex: 30}, {Line: 11, StartIndex: 8, EndIndex: 23}, {Line: 11, StartIndex: 24, EndIndex: 30}},
 		},
 		{
 			PackageManager: "go",
 			PackageName:    "k8s.io/kube-openapi",
 			Version:        "v0.0.0-20250318190949-c8a335a9a2ff",
 			FilePath:       manifestFile,
-			Locations:      []models.Location{{Line: 12, StartIndex: 8, EndIndex: 74}},
+			Locations:      []models.Location{{Line: 12, StartIndex: 8, EndIndex: 62}, {Line: 12, StartIndex: 8, EndIndex: 27}, {Line: 12, StartIndex: 28, EndIndex: 62}},
 		},
 		{
 			PackageManager: "go",
 			PackageName:    "sigs.k8s.io/yaml",
 			Version:        "v1.4.0",
 			FilePath:       manifestFile,
-			Locations:      []models.Location{{Line: 13, StartIndex: 8, EndIndex: 43}},
+			Locations:      []models.Location{{Line: 13, StartIndex: 8, EndIndex: 31}, {Line: 13, StartIndex: 8, EndIndex: 24}, {Line: 13, StartIndex: 25, EndIndex: 31}},
+		},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+}
+
+func TestGoModParser_Directives(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "go.mod")
+	content := `module example.com/app
+
+go 1.24.0
+
+toolchain go1.24.2
+
+require (
+	github.com/old/lib v1.2.0
+	github.com/local/lib v0.1.0
+	golang.org/x/tools v0.31.0 // indirect
+	github.com/pinned/lib v1.0.0
+)
+
+tool golang.org/x/tools/cmd/stringer
+
+replace github.com/old/lib => github.com/new/lib v1.3.0
+
+replace github.com/local/lib => ../lib
+
+replace github.com/pinned/lib v0.9.0 => github.com/fork/lib v0.9.1
+
+exclude github.com/old/lib v1.1.0
+
+retract v1.0.1
+
+retract [v1.2.0, v1.2.3] // published from the wrong branch
+`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write go.mod: %v", err)
+	}
+
+	packages, err := (&GoModParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{
+			PackageManager: "go",
+			PackageName:    "example.com/app",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 0, StartIndex: 7, EndIndex: 22}},
+		},
+		{
+			PackageManager: "go",
+			PackageName:    "github.com/new/lib",
+			Version:        "v1.3.0",
+			FilePath:       manifestFile,
+			Locations: []models.Location{
+				{Line: 7, StartIndex: 1, EndIndex: 26},
+				{Line: 7, StartIndex: 1, EndIndex: 19},
+				{Line: 7, StartIndex: 20, EndIndex: 26},
+				{Line: 15, StartIndex: 8, EndIndex: 55},
+			},
+		},
+		{
+			PackageManager: "go",
+			PackageName:    "github.com/local/lib",
+			Version:        "v0.1.0",
+			FilePath:       manifestFile,
+			Locations: []models.Location{
+				{Line: 8, StartIndex: 1, EndIndex: 28},
+				{Line: 8, StartIndex: 1, EndIndex: 21},
+				{Line: 8, StartIndex: 22, EndIndex: 28},
+				{Line: 17, StartIndex: 8, EndIndex: 38},
+			},
+		},
+		{
+			PackageManager: "go",
+			PackageName:    "golang.org/x/tools",
+			Version:        "v0.31.0",
+			FilePath:       manifestFile,
+			Locations: []models.Location{
+				{Line: 9, StartIndex: 1, EndIndex: 27},
+				{Line: 9, StartIndex: 1, EndIndex: 19},
+				{Line: 9, StartIndex: 20, EndIndex: 27},
+			},
+		},
+		{
+			PackageManager: "go",
+			PackageName:    "github.com/pinned/lib",
+			Version:        "v1.0.0",
+			FilePath:       manifestFile,
+			Locations: []models.Location{
+				{Line: 10, StartIndex: 1, EndIndex: 29},
+				{Line: 10, StartIndex: 1, EndIndex: 22},
+				{Line: 10, StartIndex: 23, EndIndex: 29},
+			},
+		},
+		{
+			PackageManager: "go",
+			PackageName:    "github.com/old/lib",
+			Version:        "v1.1.0",
+			FilePath:       manifestFile,
+			Locations: []models.Location{
+				{Line: 21, StartIndex: 8, EndIndex: 33},
+				{Line: 21, StartIndex: 8, EndIndex: 26},
+				{Line: 21, StartIndex: 27, EndIndex: 33},
+			},
+		},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+
+	// The file level directives are only reported on the main module
+	wantMetadata := []map[string]string{
+		{"mainModule": "true", "goVersion": "1.24.0", "toolchain": "go1.24.2", "retracted": "v1.0.1;[v1.2.0, v1.2.3]"},
+		{"originalName": "github.com/old/lib", "originalVersion": "v1.2.0"},
+		{"localReplace": "true", "replacePath": "../lib"},
+		{"tools": "golang.org/x/tools/cmd/stringer"},
+		nil,
+		nil,
+	}
+	for i, pkg := range packages {
+		if len(pkg.Metadata) != len(wantMetadata[i]) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, wantMetadata[i])
+		}
+		for key, value := range wantMetadata[i] {
+			if pkg.Metadata[key] != value {
+				t.Errorf("%s Metadata[%s]: got %q, want %q", pkg.PackageName, key, pkg.Metadata[key], value)
+			}
+		}
+	}
+	if !packages[3].Transitive || packages[1].Transitive {
+		t.Errorf("Expected only golang.org/x/tools to be transitive")
+	}
+	for i, pkg := range packages {
+		excluded := len(pkg.Scopes) == 1 && pkg.Scopes[0] == excludeScope
+		if excluded != (i == 5) {
+			t.Errorf("%s Scopes: got %v, only the excluded version must have the %s scope", pkg.PackageName, pkg.Scopes, excludeScope)
+		}
+	}
+}
+
+func TestGoModParser_ExcludeWithoutRequire(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "go.mod")
+	content := `module example.com/app
+
+exclude (
+	github.com/old/lib v1.1.0
+	github.com/old/lib v1.1.1
+)
+
+replace github.com/old/lib => github.com/new/lib v1.3.0
+`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write go.mod: %v", err)
+	}
+
+	packages, err := (&GoModParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Every exclude directive is reported under the original module path, even if the module is not required
+	expectedPackages := []models.Package{
+		{
+			PackageManager: "go",
+			PackageName:    "example.com/app",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 0, StartIndex: 7, EndIndex: 22}},
+		},
+		{
+			PackageManager: "go",
+			PackageName:    "github.com/old/lib",
+			Version:        "v1.1.0",
+			FilePath:       manifestFile,
+			Locations: []models.Location{
+				{Line: 3, StartIndex: 1, EndIndex: 26},
+				{Line: 3, StartIndex: 1, EndIndex: 19},
+				{Line: 3, StartIndex: 20, EndIndex: 26},
+			},
+		},
+		{
+			PackageManager: "go",
+			PackageName:    "github.com/old/lib",
+			Version:        "v1.1.1",
+			FilePath:       manifestFile,
+			Locations: []models.Location{
+				{Line: 4, StartIndex: 1, EndIndex: 26},
+				{Line: 4, StartIndex: 1, EndIndex: 19},
+				{Line: 4, StartIndex: 20, EndIndex: 26},
+			},
+		},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+}
+
+func TestGoModParser_SingleLineRequireAndQuotedPath(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "go.mod")
+	content := "module example.com/app\n\nrequire \"github.com/google/uuid\" v1.6.0 // pinned\n"
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write go.mod: %v", err)
+	}
+
+	packages, err := (&GoModParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{
+			PackageManager: "go",
+			PackageName:    "example.com/app",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 0, StartIndex: 7, EndIndex: 22}},
+		},
+		{
+			PackageManager: "go",
+			PackageName:    "github.com/google/uuid",
+			Version:        "v1.6.0",
+			FilePath:       manifestFile,
+			Locations: []models.Location{
+				{Line: 2, StartIndex: 8, EndIndex: 39},
+				{Line: 2, StartIndex: 8, EndIndex: 32},
+				{Line: 2, StartIndex: 33, EndIndex: 39},
+			},
 		},
 	}
 
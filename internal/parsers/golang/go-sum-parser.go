@@ -0,0 +1,153 @@
+package golang
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+
+	"golang.org/x/mod/modfile"
+)
+
+// GoSumParser is a parser for Go checksum files (go.sum).
+type GoSumParser struct{}
+
+// goModSuffix marks go.sum entries that only hash the go.mod file of a module version
+const goModSuffix = "/go.mod"
+
+// goSumEntry is a module version of a go.sum file with its hashes
+type goSumEntry struct {
+	path    string
+	version string
+	// line is the 0-based line of the first entry of the module version
+	line int
+	// hash is the hash of the module content, empty when only the go.mod file was needed
+	hash      string
+	goModHash string
+}
+
+// readGoSum reads the module versions of a go.sum file in the order they first appear
+func readGoSum(sumFile string) ([]*goSumEntry, []string, error) {
+	data, err := os.ReadFile(filepath.Clean(sumFile))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lines := strings.Split(string(data), "\n")
+	var entries []*goSumEntry
+	byModule := make(map[string]*goSumEntry)
+
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 3 {
+			return nil, nil, fmt.Errorf("malformed go.sum line %d: %q", i+1, line)
+		}
+
+		path, version, hash := fields[0], fields[1], fields[2]
+		version, goModOnly := strings.CutSuffix(version, goModSuffix)
+
+		key := path + "@" + version
+		entry, found := byModule[key]
+		if !found {
+			entry = &goSumEntry{path: path, version: version, line: i}
+			byModule[key] = entry
+			entries = append(entries, entry)
+		}
+		if goModOnly {
+			entry.goModHash = hash
+		} else {
+			entry.hash = hash
+		}
+	}
+	return entries, lines, nil
+}
+
+// loadGoSumHashes reads the go.sum file next to a go.mod file and maps "path@version" to the module content hash
+func loadGoSumHashes(modFile string) map[string]string {
+	entries, _, err := readGoSum(filepath.Join(filepath.Dir(modFile), "go.sum"))
+	if err != nil {
+		return nil
+	}
+
+	hashes := make(map[string]string)
+	for _, entry := range entries {
+		if entry.hash != "" {
+			hashes[entry.path+"@"+entry.version] = entry.hash
+		}
+	}
+	return hashes
+}
+
+// loadRequiredModules reads the go.mod file next to a go.sum file and returns the required module paths,
+// or nil when there is no such file
+func loadRequiredModules(sumFile string) map[string]bool {
+	modFile := filepath.Join(filepath.Dir(sumFile), "go.mod")
+	data, err := os.ReadFile(modFile)
+	if err != nil {
+		return nil
+	}
+	mf, err := modfile.Parse(modFile, data, nil)
+	if err != nil {
+		return nil
+	}
+
+	required := make(map[string]bool)
+	for _, req := range mf.Require {
+		required[req.Mod.Path] = true
+	}
+	for _, rep := range mf.Replace {
+		if required[rep.Old.Path] && rep.New.Version != "" {
+			required[rep.New.Path] = true
+		}
+	}
+	return required
+}
+
+// Parse parses the go.sum file and returns a package for every module version with its hashes.
+func (p *GoSumParser) Parse(manifest string) ([]models.Package, error) {
+	entries, lines, err := readGoSum(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Modules only present in go.sum are leftovers of earlier requirements or only needed for the module graph
+	required := loadRequiredModules(manifest)
+
+	var packages []models.Package
+	for _, entry := range entries {
+		var hashes []string
+		var metadata map[string]string
+		if entry.hash != "" {
+			hashes = append(hashes, entry.hash)
+		} else {
+			metadata = map[string]string{"goModOnly": "true"}
+		}
+		if required != nil && !required[entry.path] {
+			if metadata == nil {
+				metadata = make(map[string]string)
+			}
+			metadata["notRequired"] = "true"
+		}
+
+		line := lines[entry.line]
+		packages = append(packages, models.Package{
+			PackageManager: "go",
+			PackageName:    entry.path,
+			Version:        entry.version,
+			FilePath:       manifest,
+			Locations: []models.Location{{
+				Line:       entry.line,
+				StartIndex: strings.Index(line, entry.path),
+				EndIndex:   len(strings.TrimRight(line, " \t\r")),
+			}},
+			Hashes:   hashes,
+			Metadata: metadata,
+		})
+	}
+	return packages, nil
+}
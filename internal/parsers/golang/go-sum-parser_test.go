@@ -0,0 +1,102 @@
+package golang
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+const testGoSum = `github.com/google/uuid v1.6.0 h1:NIvaJDMOsjHA8n1jAhLSgzrAzy1Hgr+hNrb57e+94F0=
+github.com/google/uuid v1.6.0/go.mod h1:TIyPZe4MgqvfeYDBFedMoGGpEw/LqOeaOT+nhxU+yHo=
+github.com/stretchr/testify v1.7.0/go.mod h1:6Fq8oRcR53rry900zMqJjRRixrwX3KX962/h/Wwjteg=
+golang.org/x/mod v0.24.0 h1:ZfthKaKaT4NrhGVZHO1/WDTwGES4De8KtWO0SIbNJMU=
+golang.org/x/mod v0.24.0/go.mod h1:IXM97Txy2VM4PJ3gI61r1YEk/gAj6zAHN3AdZt6S9Ww=
+`
+
+func writeTestFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write %s: %v", path, err)
+	}
+}
+
+func TestGoSumParser_Parse(t *testing.T) {
+	root := t.TempDir()
+	manifestFile := filepath.Join(root, "go.sum")
+	writeTestFile(t, manifestFile, testGoSum)
+	writeTestFile(t, filepath.Join(root, "go.mod"), "module example.com/app\n\ngo 1.23\n\nrequire github.com/google/uuid v1.6.0\n")
+
+	packages, err := (&GoSumParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{
+			PackageManager: "go",
+			PackageName:    "github.com/google/uuid",
+			Version:        "v1.6.0",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 0, StartIndex: 0, EndIndex: 77}},
+		},
+		{
+			PackageManager: "go",
+			PackageName:    "github.com/stretchr/testify",
+			Version:        "v1.7.0",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 2, StartIndex: 0, EndIndex: 89}},
+		},
+		{
+			PackageManager: "go",
+			PackageName:    "golang.org/x/mod",
+			Version:        "v0.24.0",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 3, StartIndex: 0, EndIndex: 72}},
+		},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+
+	if len(packages[0].Hashes) != 1 || packages[0].Hashes[0] != "h1:NIvaJDMOsjHA8n1jAhLSgzrAzy1Hgr+hNrb57e+94F0=" {
+		t.Errorf("uuid Hashes: got %v", packages[0].Hashes)
+	}
+	if packages[0].Metadata["notRequired"] != "" {
+		t.Errorf("uuid is required by go.mod")
+	}
+	if packages[1].Metadata["goModOnly"] != "true" || len(packages[1].Hashes) != 0 {
+		t.Errorf("testify should only have a go.mod hash: %+v", packages[1])
+	}
+	if packages[2].Metadata["notRequired"] != "true" {
+		t.Errorf("golang.org/x/mod is not required by go.mod")
+	}
+}
+
+func TestGoSumParser_Malformed(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "go.sum")
+	writeTestFile(t, manifestFile, "github.com/google/uuid v1.6.0\n")
+
+	if _, err := (&GoSumParser{}).Parse(manifestFile); err == nil {
+		t.Error("Expected error but got none")
+	}
+}
+
+func TestGoModParser_HashesFromGoSum(t *testing.T) {
+	root := t.TempDir()
+	manifestFile := filepath.Join(root, "go.mod")
+	writeTestFile(t, manifestFile, "module example.com/app\n\ngo 1.23\n\nrequire golang.org/x/mod v0.24.0\n")
+	writeTestFile(t, filepath.Join(root, "go.sum"), testGoSum)
+
+	packages, err := (&GoModParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(packages) != 2 || len(packages[1].Hashes) != 1 || packages[1].Hashes[0] != "h1:ZfthKaKaT4NrhGVZHO1/WDTwGES4De8KtWO0SIbNJMU=" {
+		t.Errorf("Expected golang.org/x/mod with its go.sum hash, got %+v", packages)
+	}
+}
@@ -0,0 +1,74 @@
+package golang
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+
+	"golang.org/x/mod/modfile"
+)
+
+// GoWorkParser is a parser for Go workspaces (go.work).
+type GoWorkParser struct{}
+
+// workspace holds the go.work state that applies to the modules of a workspace
+type workspace struct {
+	file     string
+	data     []byte
+	replaces []*modfile.Replace
+	// modules maps the module paths of the workspace to their go.mod files
+	modules map[string]string
+}
+
+// loadWorkspaceModules finds the go.mod file of every use directive and reads its module path
+func loadWorkspaceModules(workFile string, wf *modfile.WorkFile) map[string]string {
+	modules := make(map[string]string)
+	for _, use := range wf.Use {
+		modFile := filepath.Join(filepath.Dir(workFile), filepath.FromSlash(use.Path), "go.mod")
+		data, err := os.ReadFile(modFile)
+		if err != nil {
+			log.Printf("Failed to read workspace module %s: %v", modFile, err)
+			continue
+		}
+		if modulePath := modfile.ModulePath(data); modulePath != "" {
+			modules[modulePath] = modFile
+		}
+	}
+	return modules
+}
+
+// Parse parses the go.work file and returns the packages of every module of the workspace.
+func (p *GoWorkParser) Parse(manifest string) ([]models.Package, error) {
+	data, err := os.ReadFile(filepath.Clean(manifest))
+	if err != nil {
+		return nil, err
+	}
+	wf, err := modfile.ParseWork(manifest, data, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ws := &workspace{
+		file:     manifest,
+		data:     data,
+		replaces: wf.Replace,
+		modules:  loadWorkspaceModules(manifest, wf),
+	}
+
+	var packages []models.Package
+	for _, use := range wf.Use {
+		modFile := filepath.Join(filepath.Dir(manifest), filepath.FromSlash(use.Path), "go.mod")
+		if _, err := os.Stat(modFile); err != nil {
+			continue
+		}
+		modulePackages, err := (&GoModParser{}).parseModFile(modFile, ws)
+		if err != nil {
+			log.Printf("Failed to parse workspace module %s: %v", modFile, err)
+			continue
+		}
+		packages = append(packages, modulePackages...)
+	}
+	return packages, nil
+}
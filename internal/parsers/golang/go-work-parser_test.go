@@ -0,0 +1,75 @@
+package golang
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestGoWorkParser_Parse(t *testing.T) {
+	root := t.TempDir()
+	manifestFile := filepath.Join(root, "go.work")
+	writeTestFile(t, manifestFile, `go 1.23
+
+use (
+	./api
+	./service
+)
+
+replace github.com/google/uuid => github.com/google/uuid v1.6.1
+`)
+	writeTestFile(t, filepath.Join(root, "api", "go.mod"), `module example.com/api
+
+go 1.23
+
+require github.com/google/uuid v1.6.0
+`)
+	serviceMod := filepath.Join(root, "service", "go.mod")
+	writeTestFile(t, serviceMod, `module example.com/service
+
+go 1.23
+
+require (
+	example.com/api v0.0.0
+	github.com/google/uuid v1.5.0
+)
+
+replace github.com/google/uuid => github.com/google/uuid v1.4.0
+`)
+
+	packages, err := (&GoWorkParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(packages) != 5 {
+		t.Fatalf("Expected 5 packages, got %d: %+v", len(packages), packages)
+	}
+
+	// Every module of the workspace reports its main module first
+	for _, i := range []int{0, 2} {
+		if packages[i].Metadata["mainModule"] != "true" {
+			t.Errorf("Expected %s to be a main module", packages[i].PackageName)
+		}
+	}
+
+	// The workspace replacement wins over the replacement of the module
+	for _, i := range []int{1, 4} {
+		pkg := packages[i]
+		if pkg.PackageName != "github.com/google/uuid" || pkg.Version != "v1.6.1" {
+			t.Errorf("Expected github.com/google/uuid v1.6.1, got %s %s", pkg.PackageName, pkg.Version)
+		}
+		if len(pkg.Locations) != 4 || pkg.Locations[3].FilePath != manifestFile || pkg.Locations[3].Line != 7 {
+			t.Errorf("Expected the replace directive of go.work as last location, got %+v", pkg.Locations)
+		}
+	}
+	if packages[4].FilePath != serviceMod {
+		t.Errorf("FilePath: got %q, want %q", packages[4].FilePath, serviceMod)
+	}
+
+	api := packages[3]
+	if api.PackageName != "example.com/api" || api.Metadata["workspaceModule"] != "true" {
+		t.Errorf("Expected example.com/api to be a workspace module, got %+v", api)
+	}
+	if api.Metadata["replacePath"] != filepath.Join(root, "api") {
+		t.Errorf("replacePath: got %q, want %q", api.Metadata["replacePath"], filepath.Join(root, "api"))
+	}
+}
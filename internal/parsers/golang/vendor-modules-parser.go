@@ -0,0 +1,123 @@
+package golang
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// GoVendorModulesParser is a parser for the module list of a vendor directory (vendor/modules.txt).
+type GoVendorModulesParser struct{}
+
+// Prefixes of the lines of vendor/modules.txt
+const (
+	vendorModulePrefix     = "# "
+	vendorAnnotationPrefix = "## "
+	vendorExplicit         = "explicit"
+)
+
+// parseVendorModule parses a module line, e.g. "github.com/old/lib v1.2.0 => github.com/new/lib v1.3.0".
+// Modules replaced by a directory have no replacement version, e.g. "github.com/local/lib v0.1.0 => ../lib".
+func parseVendorModule(line string) (path, version, newPath, newVersion string, replaced bool) {
+	original, replacement, replaced := strings.Cut(line, "=>")
+
+	fields := strings.Fields(original)
+	if len(fields) > 0 {
+		path = fields[0]
+	}
+	if len(fields) > 1 {
+		version = fields[1]
+	}
+
+	fields = strings.Fields(replacement)
+	if len(fields) > 0 {
+		newPath = fields[0]
+	}
+	if len(fields) > 1 {
+		newVersion = fields[1]
+	}
+	return path, version, newPath, newVersion, replaced
+}
+
+// Parse parses vendor/modules.txt and returns the vendored modules.
+func (p *GoVendorModulesParser) Parse(manifest string) ([]models.Package, error) {
+	data, err := os.ReadFile(filepath.Clean(manifest))
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(string(data), "\n")
+	var packages []models.Package
+	var current *models.Package
+
+	for i, raw := range lines {
+		line := strings.TrimRight(raw, " \t\r")
+
+		// "## explicit; go 1.21" annotates the module above it; explicit modules are required by go.mod
+		if annotation, found := strings.CutPrefix(line, vendorAnnotationPrefix); found {
+			if current == nil {
+				continue
+			}
+			for _, field := range strings.Split(annotation, ";") {
+				field = strings.TrimSpace(field)
+				if field == vendorExplicit {
+					current.Transitive = false
+				} else if goVersion, found := strings.CutPrefix(field, "go "); found {
+					current.Metadata["goVersion"] = goVersion
+				}
+			}
+			continue
+		}
+
+		moduleLine, found := strings.CutPrefix(line, vendorModulePrefix)
+		if !found {
+			// Package lines list the vendored packages of the module above
+			continue
+		}
+
+		path, version, newPath, newVersion, replaced := parseVendorModule(moduleLine)
+		// Replacements of all versions of a module that is not required are listed without a version
+		if path == "" || version == "" {
+			current = nil
+			continue
+		}
+
+		pkg := models.Package{
+			PackageManager: "go",
+			PackageName:    path,
+			Version:        version,
+			FilePath:       manifest,
+			Locations: []models.Location{{
+				Line:       i,
+				StartIndex: strings.Index(line, path),
+				EndIndex:   len(line),
+			}},
+			Transitive: true,
+			Metadata:   make(map[string]string),
+		}
+
+		if replaced {
+			if newVersion == "" {
+				pkg.Metadata["replacePath"] = newPath
+				pkg.Metadata["localReplace"] = "true"
+			} else {
+				pkg.PackageName = newPath
+				pkg.Version = newVersion
+				pkg.Metadata["originalName"] = path
+				pkg.Metadata["originalVersion"] = version
+			}
+		}
+
+		packages = append(packages, pkg)
+		current = &packages[len(packages)-1]
+	}
+
+	for i := range packages {
+		if len(packages[i].Metadata) == 0 {
+			packages[i].Metadata = nil
+		}
+	}
+	return packages, nil
+}
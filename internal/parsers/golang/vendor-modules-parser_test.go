@@ -0,0 +1,78 @@
+package golang
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestGoVendorModulesParser_Parse(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "vendor", "modules.txt")
+	writeTestFile(t, manifestFile, `# github.com/google/uuid v1.6.0
+## explicit
+github.com/google/uuid
+# github.com/old/lib v1.2.0 => github.com/new/lib v1.3.0
+## explicit; go 1.21
+github.com/new/lib
+# github.com/local/lib v0.1.0 => ../lib
+## explicit; go 1.22
+github.com/local/lib/pkg
+# golang.org/x/sys v0.30.0
+## go 1.18
+golang.org/x/sys/unix
+# github.com/unused/lib => ../unused
+`)
+
+	packages, err := (&GoVendorModulesParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{
+			PackageManager: "go",
+			PackageName:    "github.com/google/uuid",
+			Version:        "v1.6.0",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 0, StartIndex: 2, EndIndex: 31}},
+		},
+		{
+			PackageManager: "go",
+			PackageName:    "github.com/new/lib",
+			Version:        "v1.3.0",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 3, StartIndex: 2, EndIndex: 56}},
+		},
+		{
+			PackageManager: "go",
+			PackageName:    "github.com/local/lib",
+			Version:        "v0.1.0",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 6, StartIndex: 2, EndIndex: 39}},
+		},
+		{
+			PackageManager: "go",
+			PackageName:    "golang.org/x/sys",
+			Version:        "v0.30.0",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 9, StartIndex: 2, EndIndex: 26}},
+		},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+
+	wantTransitive := []bool{false, false, false, true}
+	for i, pkg := range packages {
+		if pkg.Transitive != wantTransitive[i] {
+			t.Errorf("%s Transitive: got %v, want %v", pkg.PackageName, pkg.Transitive, wantTransitive[i])
+		}
+	}
+	if packages[1].Metadata["originalName"] != "github.com/old/lib" || packages[1].Metadata["goVersion"] != "1.21" {
+		t.Errorf("Unexpected metadata of the replaced module: %v", packages[1].Metadata)
+	}
+	if packages[2].Metadata["localReplace"] != "true" || packages[2].Metadata["replacePath"] != "../lib" {
+		t.Errorf("Unexpected metadata of the local replacement: %v", packages[2].Metadata)
+	}
+}
@@ -0,0 +1,86 @@
+package gradle
+
+import (
+	"bufio"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// GradleLockfileParser implements parsing of Gradle dependency lock files (gradle.lockfile)
+type GradleLockfileParser struct{}
+
+// emptyEntry lists configurations without any locked dependency
+const emptyEntry = "empty"
+
+// Parse implements the Parser interface for Gradle lock files
+func (p *GradleLockfileParser) Parse(manifestFile string) ([]models.Package, error) {
+	file, err := os.Open(manifestFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	// Legacy per-configuration lock files (gradle/dependency-locks/<configuration>.lockfile)
+	// list coordinates without the "=configurations" suffix
+	defaultConfiguration := ""
+	if filepath.Base(filepath.Dir(manifestFile)) == "dependency-locks" {
+		defaultConfiguration = strings.TrimSuffix(filepath.Base(manifestFile), ".lockfile")
+	}
+
+	var packages []models.Package
+	scanner := bufio.NewScanner(file)
+	lineNum := -1
+
+	for scanner.Scan() {
+		lineNum++
+		raw := scanner.Text()
+		line := strings.TrimSpace(raw)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		coordinates, configurations, _ := strings.Cut(line, "=")
+		if coordinates == emptyEntry {
+			continue
+		}
+
+		parts := strings.Split(coordinates, ":")
+		if len(parts) < 3 {
+			log.Printf("Skipping line %d in %s: invalid coordinates %q", lineNum, manifestFile, coordinates)
+			continue
+		}
+
+		var scopes []string
+		for _, configuration := range strings.Split(configurations, ",") {
+			if configuration = strings.TrimSpace(configuration); configuration != "" {
+				scopes = append(scopes, configuration)
+			}
+		}
+		if len(scopes) == 0 && defaultConfiguration != "" {
+			scopes = []string{defaultConfiguration}
+		}
+
+		startIdx := strings.Index(raw, line)
+		packages = append(packages, models.Package{
+			PackageManager: "mvn",
+			PackageName:    parts[0] + ":" + parts[1],
+			Version:        parts[2],
+			FilePath:       manifestFile,
+			Locations: []models.Location{{
+				Line:       lineNum,
+				StartIndex: startIdx,
+				EndIndex:   startIdx + len(line),
+			}},
+			Scopes: scopes,
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return packages, nil
+}
@@ -0,0 +1,95 @@
+package gradle
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestGradleLockfileParser_Parse(t *testing.T) {
+	parser := &GradleLockfileParser{}
+	manifestFile := "../../../internal/testdata/gradle.lockfile"
+
+	packages, err := parser.Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Error parsing manifest file: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{
+			PackageManager: "mvn",
+			PackageName:    "com.google.guava:failureaccess",
+			Version:        "1.0.1",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 3, StartIndex: 0, EndIndex: 70}},
+		},
+		{
+			PackageManager: "mvn",
+			PackageName:    "com.google.guava:guava",
+			Version:        "32.1.2-jre",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 4, StartIndex: 0, EndIndex: 67}},
+		},
+		{
+			PackageManager: "mvn",
+			PackageName:    "junit:junit",
+			Version:        "4.13.2",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 5, StartIndex: 0, EndIndex: 60}},
+		},
+		{
+			PackageManager: "mvn",
+			PackageName:    "org.hamcrest:hamcrest-core",
+			Version:        "1.3",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 6, StartIndex: 0, EndIndex: 51}},
+		},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+
+	wantScopes := [][]string{
+		{"compileClasspath", "runtimeClasspath"},
+		{"compileClasspath", "runtimeClasspath"},
+		{"testCompileClasspath", "testRuntimeClasspath"},
+		{"testRuntimeClasspath"},
+	}
+	for i, pkg := range packages {
+		if !reflect.DeepEqual(pkg.Scopes, wantScopes[i]) {
+			t.Errorf("%s scopes: got %v, want %v", pkg.PackageName, pkg.Scopes, wantScopes[i])
+		}
+	}
+}
+
+func TestGradleLockfileParser_LegacyPerConfiguration(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "dependency-locks")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("Failed to create dir: %v", err)
+	}
+	manifestFile := filepath.Join(dir, "compileClasspath.lockfile")
+	if err := os.WriteFile(manifestFile, []byte("# comment\norg.slf4j:slf4j-api:2.0.9\n"), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	packages, err := (&GradleLockfileParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	testdata.ValidatePackages(t, packages, []models.Package{
+		{
+			PackageManager: "mvn",
+			PackageName:    "org.slf4j:slf4j-api",
+			Version:        "2.0.9",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 1, StartIndex: 0, EndIndex: 25}},
+		},
+	})
+	if !reflect.DeepEqual(packages[0].Scopes, []string{"compileClasspath"}) {
+		t.Errorf("scopes: got %v, want [compileClasspath]", packages[0].Scopes)
+	}
+}
@@ -0,0 +1,246 @@
+package gradle
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/internal/tomlutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// GradleVersionCatalogParser implements parsing of Gradle version catalogs (libs.versions.toml)
+type GradleVersionCatalogParser struct{}
+
+// versionCatalog holds the resolved versions, libraries and plugins of a Gradle version catalog
+type versionCatalog struct {
+	versions  map[string]string
+	libraries map[string]models.Package
+	plugins   map[string]models.Package
+}
+
+// Catalog sections as defined by the Gradle version catalog TOML format
+const (
+	versionsSection  = "versions"
+	librariesSection = "libraries"
+	bundlesSection   = "bundles"
+	pluginsSection   = "plugins"
+)
+
+// parseVersion handles version resolution for Gradle version strings
+// - Returns exact version if specified
+// - Returns "latest" for ranges, dynamic versions and empty versions
+func parseVersion(version string) string {
+	version = strings.TrimSpace(version)
+	if version == "" {
+		return "latest"
+	}
+
+	// Ranges such as [1.0,2.0) or ]1.0,2.0[
+	if strings.ContainsAny(version, "[]()") {
+		return "latest"
+	}
+
+	// Dynamic versions such as 1.+ or latest.release
+	if strings.Contains(version, "+") || strings.HasPrefix(version, "latest.") {
+		return "latest"
+	}
+
+	return version
+}
+
+// richVersion extracts the most specific version out of a Gradle rich version declaration.
+// A rich version is either a plain string or a table with prefer/require/strictly keys.
+func richVersion(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case map[string]any:
+		for _, key := range []string{"prefer", "require", "strictly"} {
+			if s, ok := v[key].(string); ok && s != "" {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+// loadVersionCatalog reads a version catalog and resolves all of its libraries, bundles and plugins
+func loadVersionCatalog(manifestFile string) (*versionCatalog, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	var raw map[string]any
+	if _, err := toml.Decode(string(content), &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse TOML: %w", err)
+	}
+
+	lines := strings.Split(string(content), "\n")
+	catalog := &versionCatalog{
+		versions:  make(map[string]string),
+		libraries: make(map[string]models.Package),
+		plugins:   make(map[string]models.Package),
+	}
+
+	versions, _ := raw[versionsSection].(map[string]any)
+	for alias, value := range versions {
+		catalog.versions[alias] = richVersion(value)
+	}
+
+	// resolve returns the version of a library or plugin entry and the location of a referenced version
+	resolve := func(entry map[string]any) (string, string, []models.Location) {
+		switch v := entry["version"].(type) {
+		case string:
+			return v, "", nil
+		case map[string]any:
+			if ref, ok := v["ref"].(string); ok {
+				version, exists := catalog.versions[ref]
+				if !exists {
+					log.Printf("Version reference %q not found in %s", ref, manifestFile)
+					return "", ref, nil
+				}
+				return version, ref, tomlutil.FindEntryLocations(lines, []string{versionsSection}, ref)
+			}
+			return richVersion(v), "", nil
+		}
+		return "", "", nil
+	}
+
+	libraries, _ := raw[librariesSection].(map[string]any)
+	for alias, value := range libraries {
+		var module, version, ref string
+		var refLocations []models.Location
+
+		switch entry := value.(type) {
+		case string:
+			// Short notation: "group:name:version"
+			parts := strings.Split(entry, ":")
+			if len(parts) < 2 {
+				log.Printf("Skipping library %q in %s: invalid coordinates %q", alias, manifestFile, entry)
+				continue
+			}
+			module = parts[0] + ":" + parts[1]
+			if len(parts) > 2 {
+				version = parts[2]
+			}
+		case map[string]any:
+			if m, ok := entry["module"].(string); ok {
+				module = m
+			} else {
+				group, _ := entry["group"].(string)
+				name, _ := entry["name"].(string)
+				if group == "" || name == "" {
+					log.Printf("Skipping library %q in %s: missing module coordinates", alias, manifestFile)
+					continue
+				}
+				module = group + ":" + name
+			}
+			version, ref, refLocations = resolve(entry)
+		default:
+			continue
+		}
+
+		metadata := map[string]string{"alias": alias}
+		if ref != "" {
+			metadata["versionRef"] = ref
+		}
+
+		catalog.libraries[alias] = models.Package{
+			PackageManager: "mvn",
+			PackageName:    module,
+			Version:        parseVersion(version),
+			FilePath:       manifestFile,
+			Locations:      append(tomlutil.FindEntryLocations(lines, []string{librariesSection}, alias), refLocations...),
+			Metadata:       metadata,
+		}
+	}
+
+	bundles, _ := raw[bundlesSection].(map[string]any)
+	for bundle, value := range bundles {
+		members, _ := value.([]any)
+		for _, member := range members {
+			alias, ok := member.(string)
+			if !ok {
+				continue
+			}
+			pkg, exists := catalog.libraries[alias]
+			if !exists {
+				log.Printf("Bundle %q in %s references unknown library %q", bundle, manifestFile, alias)
+				continue
+			}
+
+			// Record bundle membership on the library itself
+			names := []string{bundle}
+			if existing := pkg.Metadata["bundles"]; existing != "" {
+				names = append(strings.Split(existing, ","), bundle)
+			}
+			sort.Strings(names)
+			pkg.Metadata["bundles"] = strings.Join(names, ",")
+		}
+	}
+
+	plugins, _ := raw[pluginsSection].(map[string]any)
+	for alias, value := range plugins {
+		var id, version, ref string
+		var refLocations []models.Location
+
+		switch entry := value.(type) {
+		case string:
+			// Short notation: "plugin.id:version"
+			id, version, _ = strings.Cut(entry, ":")
+		case map[string]any:
+			id, _ = entry["id"].(string)
+			version, ref, refLocations = resolve(entry)
+		}
+		if id == "" {
+			continue
+		}
+
+		metadata := map[string]string{"alias": alias, "pluginId": id}
+		if ref != "" {
+			metadata["versionRef"] = ref
+		}
+
+		// Plugins are published through their marker artifact
+		catalog.plugins[alias] = models.Package{
+			PackageManager: "mvn",
+			PackageName:    id + ":" + id + ".gradle.plugin",
+			Version:        parseVersion(version),
+			FilePath:       manifestFile,
+			Locations:      append(tomlutil.FindEntryLocations(lines, []string{pluginsSection}, alias), refLocations...),
+			Metadata:       metadata,
+		}
+	}
+
+	return catalog, nil
+}
+
+// packages returns all libraries and plugins of the catalog ordered by their declaration
+func (c *versionCatalog) packages() []models.Package {
+	var packages []models.Package
+	for _, pkg := range c.libraries {
+		packages = append(packages, pkg)
+	}
+	for _, pkg := range c.plugins {
+		packages = append(packages, pkg)
+	}
+
+	pkgutil.SortByFirstLine(packages)
+	return packages
+}
+
+// Parse implements the Parser interface for Gradle version catalogs
+func (p *GradleVersionCatalogParser) Parse(manifestFile string) ([]models.Package, error) {
+	catalog, err := loadVersionCatalog(manifestFile)
+	if err != nil {
+		return nil, err
+	}
+	return catalog.packages(), nil
+}
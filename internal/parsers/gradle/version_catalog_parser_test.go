@@ -0,0 +1,148 @@
+package gradle
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestGradleVersionCatalogParser_Parse(t *testing.T) {
+	parser := &GradleVersionCatalogParser{}
+	manifestFile := "../../../internal/testdata/libs.versions.toml"
+
+	packages, err := parser.Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Error parsing manifest file: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{
+			PackageManager: "mvn",
+			PackageName:    "org.codehaus.groovy:groovy",
+			Version:        "3.0.5",
+			FilePath:       manifestFile,
+			Locations: []models.Location{
+				{Line: 6, StartIndex: 0, EndIndex: 79},
+				{Line: 1, StartIndex: 0, EndIndex: 16},
+			},
+		},
+		{
+			PackageManager: "mvn",
+			PackageName:    "org.codehaus.groovy:groovy-json",
+			Version:        "3.0.5",
+			FilePath:       manifestFile,
+			Locations: []models.Location{
+				{Line: 7, StartIndex: 0, EndIndex: 93},
+				{Line: 1, StartIndex: 0, EndIndex: 16},
+			},
+		},
+		{
+			PackageManager: "mvn",
+			PackageName:    "org.apache.commons:commons-lang3",
+			Version:        "3.9",
+			FilePath:       manifestFile,
+			Locations: []models.Location{
+				{Line: 8, StartIndex: 0, EndIndex: 103},
+				{Line: 3, StartIndex: 0, EndIndex: 59},
+			},
+		},
+		{
+			PackageManager: "mvn",
+			PackageName:    "com.google.guava:guava",
+			Version:        "32.1.2-jre",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 9, StartIndex: 0, EndIndex: 43}},
+		},
+		{
+			PackageManager: "mvn",
+			PackageName:    "junit:junit",
+			Version:        "latest",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 10, StartIndex: 0, EndIndex: 51}},
+		},
+		{
+			PackageManager: "mvn",
+			PackageName:    "com.github.ben-manes.versions:com.github.ben-manes.versions.gradle.plugin",
+			Version:        "0.45.0",
+			FilePath:       manifestFile,
+			Locations:      []models.Location{{Line: 16, StartIndex: 0, EndIndex: 71}},
+		},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+
+	if got := packages[0].Metadata["bundles"]; got != "groovy" {
+		t.Errorf("groovy-core bundles: got %q, want %q", got, "groovy")
+	}
+	if got := packages[0].Metadata["versionRef"]; got != "groovy" {
+		t.Errorf("groovy-core versionRef: got %q, want %q", got, "groovy")
+	}
+}
+
+func TestGradleVersionCatalogParser_SubTableEntries(t *testing.T) {
+	content := `[versions]
+kotlin = "1.9.22"
+
+[libraries.kotlin-stdlib]
+module = "org.jetbrains.kotlin:kotlin-stdlib"
+version.ref = "kotlin"
+`
+	manifestFile := filepath.Join(t.TempDir(), "libs.versions.toml")
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	packages, err := (&GradleVersionCatalogParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	testdata.ValidatePackages(t, packages, []models.Package{
+		{
+			PackageManager: "mvn",
+			PackageName:    "org.jetbrains.kotlin:kotlin-stdlib",
+			Version:        "1.9.22",
+			FilePath:       manifestFile,
+			Locations: []models.Location{
+				{Line: 3, StartIndex: 0, EndIndex: 25},
+				{Line: 1, StartIndex: 0, EndIndex: 17},
+			},
+		},
+	})
+}
+
+func TestGradleVersionCatalogParser_InvalidToml(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "libs.versions.toml")
+	if err := os.WriteFile(manifestFile, []byte("[libraries\nfoo = "), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	if _, err := (&GradleVersionCatalogParser{}).Parse(manifestFile); err == nil {
+		t.Error("Expected error but got none")
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected string
+	}{
+		{"exact version", "1.2.3", "1.2.3"},
+		{"empty", "", "latest"},
+		{"range", "[1.0,2.0)", "latest"},
+		{"dynamic", "1.+", "latest"},
+		{"latest release", "latest.release", "latest"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := parseVersion(tt.version); result != tt.expected {
+				t.Errorf("parseVersion(%q) = %q, want %q", tt.version, result, tt.expected)
+			}
+		})
+	}
+}
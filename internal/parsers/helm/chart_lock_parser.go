@@ -0,0 +1,34 @@
+package helm
+
+import (
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// ChartLockParser implements parsing of Helm lock files (Chart.lock)
+type ChartLockParser struct{}
+
+// Parse implements the Parser interface for Chart.lock files.
+// Helm locks only the dependencies of Chart.yaml, the charts they depend on are vendored in their archives.
+func (p *ChartLockParser) Parse(manifestFile string) ([]models.Package, error) {
+	deps, err := readDependencies(manifestFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var packages []models.Package
+	for _, dep := range deps {
+		metadata := dep.metadata()
+		if len(metadata) == 0 {
+			metadata = nil
+		}
+		packages = append(packages, models.Package{
+			PackageManager: "helm",
+			PackageName:    dep.name,
+			Version:        dep.version,
+			FilePath:       manifestFile,
+			Locations:      dep.locations,
+			Metadata:       metadata,
+		})
+	}
+	return packages, nil
+}
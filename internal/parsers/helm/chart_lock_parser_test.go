@@ -0,0 +1,37 @@
+package helm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestChartLockParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/Chart.lock"
+
+	packages, err := (&ChartLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "helm", PackageName: "postgresql", Version: "12.5.9", FilePath: manifestFile, Locations: []models.Location{{Line: 1, StartIndex: 2, EndIndex: 18}, {Line: 3, StartIndex: 2, EndIndex: 17}}},
+		{PackageManager: "helm", PackageName: "redis", Version: "18.4.0", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 2, EndIndex: 13}, {Line: 6, StartIndex: 2, EndIndex: 17}}},
+		{PackageManager: "helm", PackageName: "redis", Version: "18.4.0", FilePath: manifestFile, Locations: []models.Location{{Line: 7, StartIndex: 2, EndIndex: 13}, {Line: 9, StartIndex: 2, EndIndex: 17}}},
+		{PackageManager: "helm", PackageName: "common", Version: "0.1.2", FilePath: manifestFile, Locations: []models.Location{{Line: 10, StartIndex: 2, EndIndex: 14}, {Line: 12, StartIndex: 2, EndIndex: 16}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	if want := map[string]string{"repository": "https://charts.bitnami.com/bitnami"}; !reflect.DeepEqual(packages[0].Metadata, want) {
+		t.Errorf("postgresql Metadata: got %v, want %v", packages[0].Metadata, want)
+	}
+	if want := map[string]string{"source": "path", "path": "../common"}; !reflect.DeepEqual(packages[3].Metadata, want) {
+		t.Errorf("common Metadata: got %v, want %v", packages[3].Metadata, want)
+	}
+}
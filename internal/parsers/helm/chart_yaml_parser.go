@@ -0,0 +1,164 @@
+package helm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Checkmarx/manifest-parser/internal/yamlutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// ChartYamlParser implements parsing of the dependencies of Helm charts (Chart.yaml)
+type ChartYamlParser struct{}
+
+// Helm file names
+const (
+	ChartYaml = "Chart.yaml"
+	ChartLock = "Chart.lock"
+)
+
+// fileScheme prefixes the repository of charts in a directory, e.g. file://../common
+const fileScheme = "file://"
+
+// chartDependency is an entry of the dependencies of Chart.yaml or Chart.lock
+type chartDependency struct {
+	name       string
+	version    string
+	repository string
+	locations  []models.Location
+	entry      *yaml.Node
+}
+
+// lockKey identifies a chart by its name and repository
+func (d chartDependency) lockKey() string {
+	return d.name + "|" + d.repository
+}
+
+// metadata describes where a chart comes from
+func (d chartDependency) metadata() map[string]string {
+	metadata := make(map[string]string)
+	if path, ok := strings.CutPrefix(d.repository, fileScheme); ok {
+		metadata["source"] = "path"
+		metadata["path"] = path
+	} else if d.repository != "" {
+		metadata["repository"] = d.repository
+	}
+	return metadata
+}
+
+// readDependencies reads the dependencies of Chart.yaml or Chart.lock, located by their name and version entries
+func readDependencies(manifestFile string) ([]chartDependency, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	doc, err := yamlutil.Parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var deps []chartDependency
+	_, dependencies := yamlutil.Lookup(doc.Root, "dependencies")
+	if dependencies == nil || dependencies.Kind != yaml.SequenceNode {
+		return nil, nil
+	}
+	for _, entry := range dependencies.Content {
+		nameKey, nameValue := yamlutil.Lookup(entry, "name")
+		if nameKey == nil || nameValue.Value == "" {
+			continue
+		}
+		dep := chartDependency{
+			name:       nameValue.Value,
+			version:    yamlutil.Value(entry, "version"),
+			repository: yamlutil.Value(entry, "repository"),
+			locations:  []models.Location{doc.EntryLocation(nameKey, nameValue)},
+			entry:      entry,
+		}
+		if versionKey, versionValue := yamlutil.Lookup(entry, "version"); versionKey != nil {
+			dep.locations = append(dep.locations, doc.EntryLocation(versionKey, versionValue))
+		}
+		deps = append(deps, dep)
+	}
+	return deps, nil
+}
+
+// exactVersion returns the version a constraint pins, or "" for ranges such as ^12.1.0, 1.2.x or >= 1.0
+func exactVersion(constraint string) string {
+	constraint = strings.TrimPrefix(strings.TrimSpace(constraint), "=")
+	constraint = strings.TrimSpace(constraint)
+	if constraint == "" || strings.ContainsAny(constraint, "^~<>!*|, ") {
+		return ""
+	}
+	for _, part := range strings.Split(constraint, ".") {
+		if part == "x" || part == "X" {
+			return ""
+		}
+	}
+	return constraint
+}
+
+// loadLockedVersions reads the Chart.lock next to Chart.yaml and maps charts to their locked versions
+func loadLockedVersions(manifestFile string) map[string]string {
+	lockFile := filepath.Join(filepath.Dir(manifestFile), ChartLock)
+	if _, err := os.Stat(lockFile); err != nil {
+		return nil
+	}
+	deps, err := readDependencies(lockFile)
+	if err != nil {
+		return nil
+	}
+
+	locked := make(map[string]string)
+	for _, dep := range deps {
+		locked[dep.lockKey()] = dep.version
+	}
+	return locked
+}
+
+// Parse implements the Parser interface for Chart.yaml files
+func (p *ChartYamlParser) Parse(manifestFile string) ([]models.Package, error) {
+	deps, err := readDependencies(manifestFile)
+	if err != nil {
+		return nil, err
+	}
+
+	locked := loadLockedVersions(manifestFile)
+
+	var packages []models.Package
+	for _, dep := range deps {
+		// Exact versions pin the chart, ranges are resolved by Chart.lock
+		version := exactVersion(dep.version)
+		if lockedVersion, ok := locked[dep.lockKey()]; ok && version == "" {
+			version = lockedVersion
+		}
+		if version == "" {
+			version = "latest"
+		}
+
+		metadata := dep.metadata()
+		if alias := yamlutil.Value(dep.entry, "alias"); alias != "" {
+			metadata["alias"] = alias
+		}
+		if condition := yamlutil.Value(dep.entry, "condition"); condition != "" {
+			metadata["condition"] = condition
+		}
+		if len(metadata) == 0 {
+			metadata = nil
+		}
+
+		packages = append(packages, models.Package{
+			PackageManager: "helm",
+			PackageName:    dep.name,
+			Version:        version,
+			FilePath:       manifestFile,
+			Locations:      dep.locations,
+			Metadata:       metadata,
+		})
+	}
+	return packages, nil
+}
@@ -0,0 +1,73 @@
+package helm
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestChartYamlParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/Chart.yaml"
+
+	packages, err := (&ChartYamlParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Ranges are resolved by the Chart.lock next to Chart.yaml
+	expectedPackages := []models.Package{
+		{PackageManager: "helm", PackageName: "postgresql", Version: "12.5.9", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 4, EndIndex: 20}, {Line: 5, StartIndex: 4, EndIndex: 22}}},
+		{PackageManager: "helm", PackageName: "redis", Version: "18.4.0", FilePath: manifestFile, Locations: []models.Location{{Line: 8, StartIndex: 4, EndIndex: 15}, {Line: 9, StartIndex: 4, EndIndex: 19}}},
+		{PackageManager: "helm", PackageName: "redis", Version: "18.4.0", FilePath: manifestFile, Locations: []models.Location{{Line: 11, StartIndex: 4, EndIndex: 15}, {Line: 13, StartIndex: 4, EndIndex: 19}}},
+		{PackageManager: "helm", PackageName: "common", Version: "0.1.2", FilePath: manifestFile, Locations: []models.Location{{Line: 15, StartIndex: 4, EndIndex: 16}, {Line: 16, StartIndex: 4, EndIndex: 22}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	wantMetadata := []map[string]string{
+		{"repository": "https://charts.bitnami.com/bitnami", "condition": "postgresql.enabled"},
+		{"repository": "oci://registry-1.docker.io/bitnamicharts"},
+		{"repository": "oci://registry-1.docker.io/bitnamicharts", "alias": "sessions"},
+		{"source": "path", "path": "../common"},
+	}
+	for i, pkg := range packages {
+		if !reflect.DeepEqual(pkg.Metadata, wantMetadata[i]) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, wantMetadata[i])
+		}
+	}
+}
+
+func TestChartYamlParser_NoLockFile(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "Chart.yaml")
+	content := `apiVersion: v2
+name: api
+dependencies:
+  - name: nginx
+    version: 15.x.x
+    repository: https://charts.bitnami.com/bitnami
+  - name: keycloak
+    version: "=18.2.1"
+    repository: https://charts.bitnami.com/bitnami
+`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write Chart.yaml: %v", err)
+	}
+
+	packages, err := (&ChartYamlParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "helm", PackageName: "nginx", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 3, StartIndex: 4, EndIndex: 15}, {Line: 4, StartIndex: 4, EndIndex: 19}}},
+		{PackageManager: "helm", PackageName: "keycloak", Version: "18.2.1", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 4, EndIndex: 18}, {Line: 7, StartIndex: 4, EndIndex: 22}}},
+	}
+	testdata.ValidatePackages(t, packages, expectedPackages)
+}
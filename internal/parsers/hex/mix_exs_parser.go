@@ -0,0 +1,233 @@
+package hex
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// MixExsParser implements parsing of Elixir project files (mix.exs)
+type MixExsParser struct{}
+
+// Mix and rebar3 file names
+const (
+	MixExs      = "mix.exs"
+	MixLock     = "mix.lock"
+	RebarConfig = "rebar.config"
+	RebarLock   = "rebar.lock"
+)
+
+// defaultRepo is the Hex repository packages are fetched from by default
+const defaultRepo = "hexpm"
+
+// depsFunctionPattern matches the function returning the dependencies of a project, e.g. defp deps do
+var depsFunctionPattern = regexp.MustCompile(`(?m)^\s*defp?\s+deps(?:\s*\(\s*\))?\s*(?:do\b|,\s*do:)`)
+
+// depsKeywordPattern matches dependencies listed inline in the project keyword list, e.g. deps: [
+var depsKeywordPattern = regexp.MustCompile(`\bdeps:\s*\[`)
+
+// exactVersionPattern matches requirements pinning a single version, e.g. "1.2.3" or "== 1.2.3"
+var exactVersionPattern = regexp.MustCompile(`^(?:==\s*)?(\d+\.\d+\.\d+(?:-[0-9A-Za-z.-]+)?)$`)
+
+// exactVersion returns the version a requirement pins, or "" for ranges such as "~> 1.7"
+func exactVersion(requirement string) string {
+	if match := exactVersionPattern.FindStringSubmatch(strings.TrimSpace(requirement)); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// findDepsList returns the offset of the list of dependencies of a mix.exs, or -1 if there is none
+func findDepsList(content []byte) int {
+	if match := depsFunctionPattern.FindIndex(content); match != nil {
+		// The body of deps/0 is usually the list itself, comments may come first
+		p := &termParser{src: content, pos: match[1], elixir: true}
+		p.skipSpace()
+		if p.pos < len(content) && content[p.pos] == '[' {
+			return p.pos
+		}
+		if start := bytes.IndexByte(content[match[1]:], '['); start >= 0 {
+			return match[1] + start
+		}
+	}
+	if match := depsKeywordPattern.FindIndex(content); match != nil {
+		return match[1] - 1
+	}
+	return -1
+}
+
+// mixOptions collects the options of a dependency tuple, written as keywords or as a keyword list
+func mixOptions(dep *term) *term {
+	options := &term{kind: listTerm}
+	for _, item := range dep.items[1:] {
+		switch {
+		case item.is(tupleTerm):
+			options.items = append(options.items, item)
+		case item.is(listTerm):
+			options.items = append(options.items, item.items...)
+		}
+	}
+	return options
+}
+
+// mixDependency is a dependency declared in mix.exs
+type mixDependency struct {
+	app         string
+	requirement string
+	options     *term
+	start       int
+	end         int
+}
+
+// packageName returns the name of the Hex package of a dependency, which differs from its application with hex:
+func (d mixDependency) packageName() string {
+	if name := d.options.keyword("hex").text(); name != "" {
+		return name
+	}
+	return d.app
+}
+
+// metadata describes where a declared dependency comes from
+func (d mixDependency) metadata() map[string]string {
+	metadata := make(map[string]string)
+	if d.packageName() != d.app {
+		metadata["alias"] = d.app
+	}
+
+	options := d.options
+	switch {
+	case options.keyword("git").text() != "":
+		metadata["source"] = "git"
+		metadata["git"] = options.keyword("git").text()
+	case options.keyword("github").text() != "":
+		metadata["source"] = "git"
+		metadata["git"] = "https://github.com/" + options.keyword("github").text() + ".git"
+	case options.keyword("path").text() != "":
+		metadata["source"] = "path"
+		metadata["path"] = options.keyword("path").text()
+	case options.keyword("in_umbrella").text() == "true":
+		metadata["source"] = "umbrella"
+	}
+	for _, key := range []string{"ref", "tag", "branch"} {
+		if value := options.keyword(key).text(); value != "" {
+			metadata[key] = value
+		}
+	}
+	// sparse: is the former name of subdir:
+	for _, key := range []string{"subdir", "sparse"} {
+		if value := options.keyword(key).text(); value != "" {
+			metadata["gitPath"] = value
+		}
+	}
+
+	if organization := options.keyword("organization").text(); organization != "" {
+		metadata["registry"] = defaultRepo + ":" + organization
+	}
+	if repo := options.keyword("repo").text(); repo != "" && repo != defaultRepo {
+		metadata["registry"] = repo
+	}
+	if options.keyword("override").text() == "true" {
+		metadata["overridden"] = "true"
+	}
+	if options.keyword("optional").text() == "true" {
+		metadata["optional"] = "true"
+	}
+	return metadata
+}
+
+// findMixDependencies finds the dependencies declared in the content of a mix.exs
+func findMixDependencies(content []byte) ([]mixDependency, error) {
+	start := findDepsList(content)
+	if start < 0 {
+		return nil, nil
+	}
+	list, err := parseElixirTerm(content, start)
+	if err != nil {
+		return nil, err
+	}
+
+	var deps []mixDependency
+	for _, item := range list.items {
+		// Dependencies are tuples of the application and an optional requirement, e.g. {:phoenix, "~> 1.7", only: :dev}
+		if !item.is(tupleTerm) || !item.item(0).is(atomTerm) || item.item(0).value == "" {
+			continue
+		}
+		dep := mixDependency{app: item.items[0].value, options: mixOptions(item), start: item.start, end: item.end}
+		if requirement := item.item(1); requirement.is(stringTerm) {
+			dep.requirement = requirement.value
+		}
+		deps = append(deps, dep)
+	}
+	return deps, nil
+}
+
+// loadLockedMixVersions reads the mix.lock next to a manifest and maps applications to their locked versions
+func loadLockedMixVersions(manifestFile string) map[string]string {
+	lockFile := filepath.Join(filepath.Dir(manifestFile), MixLock)
+	if _, err := os.Stat(lockFile); err != nil {
+		return nil
+	}
+	packages, err := (&MixLockParser{}).Parse(lockFile)
+	if err != nil {
+		return nil
+	}
+
+	locked := make(map[string]string)
+	for _, pkg := range packages {
+		app := pkg.PackageName
+		if alias, ok := pkg.Metadata["alias"]; ok {
+			app = alias
+		}
+		locked[app] = pkg.Version
+	}
+	return locked
+}
+
+// Parse implements the Parser interface for mix.exs files
+func (p *MixExsParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	deps, err := findMixDependencies(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Elixir terms: %w", err)
+	}
+
+	locked := loadLockedMixVersions(manifestFile)
+
+	var packages []models.Package
+	for _, dep := range deps {
+		// Exact requirements pin the package, everything else is resolved by mix.lock
+		version := exactVersion(dep.requirement)
+		if lockedVersion, ok := locked[dep.app]; ok && version == "" {
+			version = lockedVersion
+		}
+		if version == "" {
+			version = "latest"
+		}
+
+		metadata := dep.metadata()
+		if len(metadata) == 0 {
+			metadata = nil
+		}
+
+		// Dependencies restricted to environments with only: are scoped to them
+		packages = append(packages, models.Package{
+			PackageManager: "hex",
+			PackageName:    dep.packageName(),
+			Version:        version,
+			FilePath:       manifestFile,
+			Locations:      spanLocations(content, dep.start, dep.end),
+			Scopes:         dep.options.keyword("only").atoms(),
+			Metadata:       metadata,
+		})
+	}
+	return packages, nil
+}
@@ -0,0 +1,86 @@
+package hex
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestMixExsParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/mix.exs"
+
+	packages, err := (&MixExsParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Requirements are resolved from the mix.lock next to mix.exs, exact requirements are kept
+	expectedPackages := []models.Package{
+		{PackageManager: "hex", PackageName: "phoenix", Version: "1.7.10", FilePath: manifestFile, Locations: []models.Location{{Line: 17, StartIndex: 6, EndIndex: 29}}},
+		{PackageManager: "hex", PackageName: "jason", Version: "1.4.1", FilePath: manifestFile, Locations: []models.Location{{Line: 18, StartIndex: 6, EndIndex: 23}}},
+		{PackageManager: "hex", PackageName: "plug_cowboy", Version: "2.6.1", FilePath: manifestFile, Locations: []models.Location{{Line: 19, StartIndex: 6, EndIndex: 48}}},
+		{PackageManager: "hex", PackageName: "ex_doc", Version: "0.31.0", FilePath: manifestFile, Locations: []models.Location{{Line: 20, StartIndex: 6, EndIndex: 54}}},
+		{PackageManager: "hex", PackageName: "mox", Version: "1.1.0", FilePath: manifestFile, Locations: []models.Location{{Line: 21, StartIndex: 6, EndIndex: 37}}},
+		{PackageManager: "hex", PackageName: "broadway", Version: "5b9b5f3fd8b0b3d2a0a5e0b6c1e0f4f5a6b7c8d9", FilePath: manifestFile, Locations: []models.Location{{Line: 22, StartIndex: 6, EndIndex: 82}}},
+		{PackageManager: "hex", PackageName: "gen_rmq", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 23, StartIndex: 6, EndIndex: 16}, {Line: 24, StartIndex: 7, EndIndex: 35}, {Line: 25, StartIndex: 7, EndIndex: 22}}},
+		{PackageManager: "hex", PackageName: "shared", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 26, StartIndex: 6, EndIndex: 34}}},
+		{PackageManager: "hex", PackageName: "uuid_utils", Version: "2.1.0", FilePath: manifestFile, Locations: []models.Location{{Line: 27, StartIndex: 6, EndIndex: 66}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	wantScopes := map[string][]string{"phoenix": nil, "ex_doc": {"dev"}, "mox": {"test"}}
+	for _, pkg := range packages {
+		if want, ok := wantScopes[pkg.PackageName]; ok && !reflect.DeepEqual(pkg.Scopes, want) {
+			t.Errorf("%s Scopes: got %v, want %v", pkg.PackageName, pkg.Scopes, want)
+		}
+	}
+
+	wantMetadata := map[string]map[string]string{
+		"phoenix":     nil,
+		"plug_cowboy": {"overridden": "true"},
+		"broadway":    {"source": "git", "git": "https://github.com/dashbitco/broadway.git", "tag": "v1.0.7"},
+		"gen_rmq":     {"source": "git", "git": "https://github.com/meltwater/gen_rmq.git", "branch": "main"},
+		"shared":      {"source": "path", "path": "../shared"},
+		"uuid_utils":  {"alias": "my_uuid", "registry": "hexpm:acme"},
+	}
+	for _, pkg := range packages {
+		if want, ok := wantMetadata[pkg.PackageName]; ok && !reflect.DeepEqual(pkg.Metadata, want) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, want)
+		}
+	}
+}
+
+func TestMixExsParser_InlineDeps(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "mix.exs")
+	content := `defmodule Tiny.MixProject do
+  use Mix.Project
+
+  def project do
+    [app: :tiny, deps: [{:decimal, "== 2.1.1"}, {:telemetry, "~> " <> @telemetry}]]
+  end
+end
+`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write mix.exs: %v", err)
+	}
+
+	packages, err := (&MixExsParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "hex", PackageName: "decimal", Version: "2.1.1", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 24, EndIndex: 46}}},
+		{PackageManager: "hex", PackageName: "telemetry", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 48, EndIndex: 81}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+}
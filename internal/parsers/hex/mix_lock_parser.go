@@ -0,0 +1,129 @@
+package hex
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// MixLockParser implements parsing of Elixir lock files (mix.lock)
+type MixLockParser struct{}
+
+// Sources of the entries of mix.lock
+const (
+	hexSource = "hex"
+	gitSource = "git"
+)
+
+// loadDeclaredApps reads the mix.exs next to a mix.lock and collects the applications it declares
+func loadDeclaredApps(lockFile string) map[string]bool {
+	content, err := os.ReadFile(filepath.Join(filepath.Dir(lockFile), MixExs))
+	if err != nil {
+		return nil
+	}
+	deps, err := findMixDependencies(content)
+	if err != nil {
+		return nil
+	}
+
+	declared := make(map[string]bool)
+	for _, dep := range deps {
+		declared[dep.app] = true
+	}
+	return declared
+}
+
+// lockDependencies returns the sorted package names of the requirements of a locked hex package,
+// e.g. [{:castore, ">= 0.0.0", [hex: :castore, repo: "hexpm", optional: true]}]
+func lockDependencies(requirements *term) []string {
+	var names []string
+	for _, requirement := range requirements.elements() {
+		if !requirement.item(0).is(atomTerm) {
+			continue
+		}
+		name := requirement.items[0].value
+		if pkg := requirement.item(2).keyword("hex").text(); pkg != "" {
+			name = pkg
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Parse implements the Parser interface for mix.lock files
+func (p *MixLockParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	lock, err := parseElixirTerm(content, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Elixir terms: %w", err)
+	}
+
+	declared := loadDeclaredApps(manifestFile)
+
+	var packages []models.Package
+	for _, entry := range lock.items {
+		// Entries map applications to their sources, e.g. "phoenix": {:hex, :phoenix, "1.7.10", ...}
+		app, source := entry.item(0).text(), entry.item(1)
+		if app == "" || !source.is(tupleTerm) {
+			continue
+		}
+
+		pkg := models.Package{
+			PackageManager: "hex",
+			PackageName:    app,
+			FilePath:       manifestFile,
+			Locations:      spanLocations(content, entry.start, entry.end),
+			Transitive:     declared != nil && !declared[app],
+		}
+		metadata := make(map[string]string)
+
+		switch source.item(0).text() {
+		case hexSource:
+			// {:hex, package, version, inner checksum, managers, requirements, repo, outer checksum}
+			if name := source.item(1).text(); name != "" && name != app {
+				pkg.PackageName = name
+				metadata["alias"] = app
+			}
+			pkg.Version = source.item(2).text()
+			pkg.Dependencies = lockDependencies(source.item(5))
+			if repo := source.item(6).text(); repo != "" && repo != defaultRepo {
+				metadata["registry"] = repo
+			}
+			pkg.Hashes = pkgutil.HexSRIHash("sha256", source.item(7).text())
+		case gitSource:
+			// {:git, url, revision, options}, the revision identifies the package
+			pkg.Version = source.item(2).text()
+			metadata["source"] = "git"
+			metadata["git"] = source.item(1).text()
+			metadata["rev"] = pkg.Version
+			options := source.item(3)
+			for _, key := range []string{"ref", "tag", "branch"} {
+				if value := options.keyword(key).text(); value != "" {
+					metadata[key] = value
+				}
+			}
+			for _, key := range []string{"subdir", "sparse"} {
+				if value := options.keyword(key).text(); value != "" {
+					metadata["gitPath"] = value
+				}
+			}
+		default:
+			continue
+		}
+
+		if len(metadata) > 0 {
+			pkg.Metadata = metadata
+		}
+		packages = append(packages, pkg)
+	}
+	return packages, nil
+}
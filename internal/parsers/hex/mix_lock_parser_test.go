@@ -0,0 +1,64 @@
+package hex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestMixLockParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/mix.lock"
+
+	packages, err := (&MixLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "hex", PackageName: "broadway", Version: "5b9b5f3fd8b0b3d2a0a5e0b6c1e0f4f5a6b7c8d9", FilePath: manifestFile, Locations: []models.Location{{Line: 1, StartIndex: 2, EndIndex: 126}}},
+		{PackageManager: "hex", PackageName: "castore", Version: "1.0.5", FilePath: manifestFile, Locations: []models.Location{{Line: 2, StartIndex: 2, EndIndex: 195}}},
+		{PackageManager: "hex", PackageName: "ex_doc", Version: "0.31.0", FilePath: manifestFile, Locations: []models.Location{{Line: 3, StartIndex: 2, EndIndex: 280}}},
+		{PackageManager: "hex", PackageName: "jason", Version: "1.4.1", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 2, EndIndex: 269}}},
+		{PackageManager: "hex", PackageName: "mime", Version: "2.0.5", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 2, EndIndex: 189}}},
+		{PackageManager: "hex", PackageName: "mox", Version: "1.1.0", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 2, EndIndex: 187}}},
+		{PackageManager: "hex", PackageName: "uuid_utils", Version: "2.1.0", FilePath: manifestFile, Locations: []models.Location{{Line: 7, StartIndex: 2, EndIndex: 203}}},
+		{PackageManager: "hex", PackageName: "phoenix", Version: "1.7.10", FilePath: manifestFile, Locations: []models.Location{{Line: 8, StartIndex: 2, EndIndex: 411}}},
+		{PackageManager: "hex", PackageName: "plug_cowboy", Version: "2.6.1", FilePath: manifestFile, Locations: []models.Location{{Line: 9, StartIndex: 2, EndIndex: 276}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	// Applications missing from the mix.exs next to mix.lock are dependencies of dependencies
+	transitive := map[string]bool{"castore": true, "mime": true}
+	for _, pkg := range packages {
+		if pkg.Transitive != transitive[pkg.PackageName] {
+			t.Errorf("%s Transitive: got %v", pkg.PackageName, pkg.Transitive)
+		}
+	}
+
+	if want := []string{"castore", "jason", "plug_cowboy"}; !reflect.DeepEqual(packages[7].Dependencies, want) {
+		t.Errorf("phoenix Dependencies: got %v, want %v", packages[7].Dependencies, want)
+	}
+	if want := []string{"sha256-z3hJMuAQ/XNtZW1/6talhKRJjv7+W4In6fODvxW7edA="}; !reflect.DeepEqual(packages[7].Hashes, want) {
+		t.Errorf("phoenix Hashes: got %v, want %v", packages[7].Hashes, want)
+	}
+	if packages[0].Hashes != nil {
+		t.Errorf("Git dependencies must not have hashes, got %v", packages[0].Hashes)
+	}
+
+	wantMetadata := map[string]map[string]string{
+		"broadway":   {"source": "git", "git": "https://github.com/dashbitco/broadway.git", "rev": "5b9b5f3fd8b0b3d2a0a5e0b6c1e0f4f5a6b7c8d9", "tag": "v1.0.7"},
+		"castore":    nil,
+		"uuid_utils": {"alias": "my_uuid", "registry": "hexpm:acme"},
+	}
+	for _, pkg := range packages {
+		if want, ok := wantMetadata[pkg.PackageName]; ok && !reflect.DeepEqual(pkg.Metadata, want) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, want)
+		}
+	}
+}
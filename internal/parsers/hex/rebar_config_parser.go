@@ -0,0 +1,179 @@
+package hex
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// RebarConfigParser implements parsing of Erlang rebar3 project files (rebar.config)
+type RebarConfigParser struct{}
+
+// rebarDependency is a dependency declared in rebar.config
+type rebarDependency struct {
+	app         string
+	requirement string
+	source      *term
+	scope       string
+	start       int
+	end         int
+}
+
+// parseRebarDependency reads a dependency: an application, e.g. cowboy, or a tuple of the application,
+// an optional requirement and its source, e.g. {jsx, "3.1.0"} or {recon, {git, "https://...", {tag, "2.5.3"}}}
+func parseRebarDependency(dep *term, scope string) (rebarDependency, bool) {
+	if dep.is(atomTerm) {
+		return rebarDependency{app: dep.value, scope: scope, start: dep.start, end: dep.end}, true
+	}
+	if !dep.is(tupleTerm) || !dep.item(0).is(atomTerm) {
+		return rebarDependency{}, false
+	}
+
+	parsed := rebarDependency{app: dep.items[0].value, scope: scope, start: dep.start, end: dep.end}
+	for _, item := range dep.items[1:] {
+		switch {
+		case item.is(stringTerm):
+			parsed.requirement = item.value
+		case item.is(tupleTerm):
+			parsed.source = item
+		}
+	}
+	return parsed, true
+}
+
+// packageName returns the name of the Hex package of a dependency, which differs from its application with {pkg, Name}
+func (d rebarDependency) packageName() string {
+	if d.source.item(0).text() == "pkg" && d.source.item(1).text() != "" {
+		return d.source.item(1).text()
+	}
+	return d.app
+}
+
+// metadata describes where a declared dependency comes from
+func (d rebarDependency) metadata() map[string]string {
+	metadata := make(map[string]string)
+	if d.packageName() != d.app {
+		metadata["alias"] = d.app
+	}
+	addSourceMetadata(metadata, d.source)
+	return metadata
+}
+
+// addSourceMetadata describes a git source, e.g. {git, Url, {tag, "v1"}} or {git_subdir, Url, {branch, "main"}, Path}
+func addSourceMetadata(metadata map[string]string, source *term) {
+	kind := source.item(0).text()
+	if kind != "git" && kind != "git_subdir" {
+		return
+	}
+	metadata["source"] = "git"
+	metadata["git"] = source.item(1).text()
+
+	switch ref := source.item(2); {
+	case ref.is(tupleTerm) && ref.item(1).text() != "":
+		// {ref, Sha} pins a revision, {tag, Tag} and {branch, Branch} name it
+		key := ref.item(0).text()
+		if key == "ref" {
+			key = "rev"
+		}
+		metadata[key] = ref.item(1).text()
+	case ref.is(stringTerm):
+		metadata["rev"] = ref.value
+	}
+	if path := source.item(3).text(); kind == "git_subdir" && path != "" {
+		metadata["gitPath"] = path
+	}
+}
+
+// findRebarDependencies finds the dependencies of rebar.config, those of the default profile first
+func findRebarDependencies(terms []*term) []rebarDependency {
+	var deps []rebarDependency
+	collect := func(config *term, scope string) {
+		for _, item := range config.keyword("deps").elements() {
+			if dep, ok := parseRebarDependency(item, scope); ok {
+				deps = append(deps, dep)
+			}
+		}
+	}
+
+	config := &term{kind: listTerm, items: terms}
+	collect(config, "")
+	for _, profile := range config.keyword("profiles").elements() {
+		if name := profile.item(0).text(); name != "" {
+			collect(profile.item(1), name)
+		}
+	}
+	return deps
+}
+
+// loadLockedRebarVersions reads the rebar.lock next to a manifest and maps applications to their locked versions
+func loadLockedRebarVersions(manifestFile string) map[string]string {
+	lockFile := filepath.Join(filepath.Dir(manifestFile), RebarLock)
+	if _, err := os.Stat(lockFile); err != nil {
+		return nil
+	}
+	packages, err := (&RebarLockParser{}).Parse(lockFile)
+	if err != nil {
+		return nil
+	}
+
+	locked := make(map[string]string)
+	for _, pkg := range packages {
+		app := pkg.PackageName
+		if alias, ok := pkg.Metadata["alias"]; ok {
+			app = alias
+		}
+		locked[app] = pkg.Version
+	}
+	return locked
+}
+
+// Parse implements the Parser interface for rebar.config files
+func (p *RebarConfigParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	terms, err := parseErlangTerms(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Erlang terms: %w", err)
+	}
+
+	locked := loadLockedRebarVersions(manifestFile)
+
+	var packages []models.Package
+	for _, dep := range findRebarDependencies(terms) {
+		// Exact requirements pin the package, everything else is resolved by rebar.lock
+		version := exactVersion(dep.requirement)
+		if lockedVersion, ok := locked[dep.app]; ok && version == "" {
+			version = lockedVersion
+		}
+		if version == "" {
+			version = "latest"
+		}
+
+		metadata := dep.metadata()
+		if len(metadata) == 0 {
+			metadata = nil
+		}
+
+		// Dependencies of profiles are scoped to them, e.g. test
+		var scopes []string
+		if dep.scope != "" {
+			scopes = []string{dep.scope}
+		}
+
+		packages = append(packages, models.Package{
+			PackageManager: "hex",
+			PackageName:    dep.packageName(),
+			Version:        version,
+			FilePath:       manifestFile,
+			Locations:      spanLocations(content, dep.start, dep.end),
+			Scopes:         scopes,
+			Metadata:       metadata,
+		})
+	}
+	return packages, nil
+}
@@ -0,0 +1,50 @@
+package hex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestRebarConfigParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/rebar.config"
+
+	packages, err := (&RebarConfigParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Requirements are resolved from the rebar.lock next to rebar.config, exact requirements are kept
+	expectedPackages := []models.Package{
+		{PackageManager: "hex", PackageName: "cowboy", Version: "2.10.0", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 4, EndIndex: 10}}},
+		{PackageManager: "hex", PackageName: "jsx", Version: "3.1.0", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 4, EndIndex: 18}}},
+		{PackageManager: "hex", PackageName: "lager", Version: "3.9.2", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 4, EndIndex: 21}}},
+		{PackageManager: "hex", PackageName: "hackney", Version: "1.20.1", FilePath: manifestFile, Locations: []models.Location{{Line: 7, StartIndex: 4, EndIndex: 34}}},
+		{PackageManager: "hex", PackageName: "recon", Version: "c2a76855be3a226a3148c0dfc21ce000b6186ef8", FilePath: manifestFile, Locations: []models.Location{{Line: 8, StartIndex: 4, EndIndex: 71}}},
+		{PackageManager: "hex", PackageName: "meck", Version: "0.9.2", FilePath: manifestFile, Locations: []models.Location{{Line: 9, StartIndex: 4, EndIndex: 19}, {Line: 10, StartIndex: 8, EndIndex: 73}}},
+		{PackageManager: "hex", PackageName: "proper", Version: "1.4.0", FilePath: manifestFile, Locations: []models.Location{{Line: 15, StartIndex: 16, EndIndex: 33}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	if want := []string{"test"}; !reflect.DeepEqual(packages[6].Scopes, want) {
+		t.Errorf("proper Scopes: got %v, want %v", packages[6].Scopes, want)
+	}
+
+	wantMetadata := map[string]map[string]string{
+		"cowboy":  nil,
+		"hackney": {"alias": "hackney_fork"},
+		"recon":   {"source": "git", "git": "https://github.com/ferd/recon.git", "tag": "2.5.3"},
+		"meck":    {"source": "git", "git": "https://github.com/eproxus/meck.git", "branch": "master"},
+	}
+	for _, pkg := range packages {
+		if want, ok := wantMetadata[pkg.PackageName]; ok && !reflect.DeepEqual(pkg.Metadata, want) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, want)
+		}
+	}
+}
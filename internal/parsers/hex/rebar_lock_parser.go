@@ -0,0 +1,92 @@
+package hex
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// RebarLockParser implements parsing of Erlang rebar3 lock files (rebar.lock), the 1.0 format without version and later formats
+type RebarLockParser struct{}
+
+// loadRebarHashes maps the packages of the hash sections of rebar.lock to their outer checksums,
+// e.g. [{pkg_hash, [...]}, {pkg_hash_ext, [{<<"cowboy">>, <<"F3E3...">>}]}]
+func loadRebarHashes(sections *term) map[string]string {
+	hashes := make(map[string]string)
+	for _, entry := range sections.keyword("pkg_hash_ext").elements() {
+		if app := entry.item(0).text(); app != "" {
+			hashes[app] = strings.ToLower(entry.item(1).text())
+		}
+	}
+	return hashes
+}
+
+// Parse implements the Parser interface for rebar.lock files
+func (p *RebarLockParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	terms, err := parseErlangTerms(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Erlang terms: %w", err)
+	}
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	// Versioned lock files wrap the locked packages in {"1.2.0", [...]} and follow them with their hashes
+	entries := terms[0]
+	if entries.is(tupleTerm) {
+		entries = entries.item(1)
+	}
+	var hashes map[string]string
+	if len(terms) > 1 {
+		hashes = loadRebarHashes(terms[1])
+	}
+
+	var packages []models.Package
+	for _, entry := range entries.elements() {
+		// {<<"cowboy">>, {pkg, <<"cowboy">>, <<"2.10.0">>}, 0}, the level is 0 for dependencies of rebar.config
+		app, source := entry.item(0).text(), entry.item(1)
+		if app == "" || !source.is(tupleTerm) {
+			continue
+		}
+
+		level := entry.item(2)
+		pkg := models.Package{
+			PackageManager: "hex",
+			PackageName:    app,
+			FilePath:       manifestFile,
+			Locations:      spanLocations(content, entry.start, entry.end),
+			Transitive:     level != nil && level.value != "0",
+		}
+		metadata := make(map[string]string)
+
+		switch source.item(0).text() {
+		case "pkg":
+			if name := source.item(1).text(); name != "" && name != app {
+				pkg.PackageName = name
+				metadata["alias"] = app
+			}
+			pkg.Version = source.item(2).text()
+			pkg.Hashes = pkgutil.HexSRIHash("sha256", hashes[app])
+		case "git", "git_subdir":
+			// Git dependencies are locked to {ref, Sha}, the revision identifies them
+			addSourceMetadata(metadata, source)
+			pkg.Version = metadata["rev"]
+		default:
+			continue
+		}
+
+		if len(metadata) > 0 {
+			pkg.Metadata = metadata
+		}
+		packages = append(packages, pkg)
+	}
+	return packages, nil
+}
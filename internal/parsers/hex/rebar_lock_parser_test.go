@@ -0,0 +1,86 @@
+package hex
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestRebarLockParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/rebar.lock"
+
+	packages, err := (&RebarLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "hex", PackageName: "cowboy", Version: "2.10.0", FilePath: manifestFile, Locations: []models.Location{{Line: 1, StartIndex: 1, EndIndex: 49}}},
+		{PackageManager: "hex", PackageName: "cowlib", Version: "2.12.1", FilePath: manifestFile, Locations: []models.Location{{Line: 2, StartIndex: 1, EndIndex: 49}}},
+		{PackageManager: "hex", PackageName: "hackney", Version: "1.20.1", FilePath: manifestFile, Locations: []models.Location{{Line: 3, StartIndex: 1, EndIndex: 56}}},
+		{PackageManager: "hex", PackageName: "jsx", Version: "3.1.0", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 1, EndIndex: 42}}},
+		{PackageManager: "hex", PackageName: "lager", Version: "3.9.2", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 1, EndIndex: 46}}},
+		{PackageManager: "hex", PackageName: "recon", Version: "c2a76855be3a226a3148c0dfc21ce000b6186ef8", FilePath: manifestFile, Locations: []models.Location{
+			{Line: 6, StartIndex: 1, EndIndex: 14},
+			{Line: 7, StartIndex: 2, EndIndex: 43},
+			{Line: 8, StartIndex: 7, EndIndex: 57},
+			{Line: 9, StartIndex: 2, EndIndex: 4},
+		}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	// Packages above level 0 are dependencies of dependencies
+	for _, pkg := range packages {
+		if pkg.Transitive != (pkg.PackageName == "cowlib") {
+			t.Errorf("%s Transitive: got %v", pkg.PackageName, pkg.Transitive)
+		}
+	}
+
+	if want := []string{"sha256-e1x/Kwo+ij5ejj227Dx+HNzG5afw+gp8LT5PWmt8jZ4="}; !reflect.DeepEqual(packages[0].Hashes, want) {
+		t.Errorf("cowboy Hashes: got %v, want %v", packages[0].Hashes, want)
+	}
+	if packages[3].Hashes != nil {
+		t.Errorf("jsx has no pkg_hash_ext entry, got hashes %v", packages[3].Hashes)
+	}
+
+	wantMetadata := map[string]map[string]string{
+		"cowboy":  nil,
+		"hackney": {"alias": "hackney_fork"},
+		"recon":   {"source": "git", "git": "https://github.com/ferd/recon.git", "rev": "c2a76855be3a226a3148c0dfc21ce000b6186ef8"},
+	}
+	for _, pkg := range packages {
+		if want, ok := wantMetadata[pkg.PackageName]; ok && !reflect.DeepEqual(pkg.Metadata, want) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, want)
+		}
+	}
+}
+
+func TestRebarLockParser_UnversionedFormat(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "rebar.lock")
+	content := `[{<<"goldrush">>,{pkg,<<"goldrush">>,<<"0.1.9">>},1},
+ {<<"lager">>,{pkg,<<"lager">>,<<"3.6.1">>},0}].
+`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write rebar.lock: %v", err)
+	}
+
+	packages, err := (&RebarLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "hex", PackageName: "goldrush", Version: "0.1.9", FilePath: manifestFile, Locations: []models.Location{{Line: 0, StartIndex: 1, EndIndex: 52}}},
+		{PackageManager: "hex", PackageName: "lager", Version: "3.6.1", FilePath: manifestFile, Locations: []models.Location{{Line: 1, StartIndex: 1, EndIndex: 46}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+}
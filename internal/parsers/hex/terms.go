@@ -0,0 +1,412 @@
+package hex
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// termKind is the kind of an Erlang or Elixir term
+type termKind int
+
+const (
+	// exprTerm is any other expression, e.g. a number, a variable or a function call
+	exprTerm termKind = iota
+	atomTerm
+	stringTerm
+	tupleTerm
+	listTerm
+	mapTerm
+)
+
+// term is a literal Erlang or Elixir term with the offsets of its source.
+// Keyword list entries (only: :test) and map entries ("key" => value) are read as two element tuples, as Elixir does.
+type term struct {
+	kind  termKind
+	value string
+	items []*term
+	start int
+	end   int
+}
+
+// item returns an element of a tuple or list, or nil if there is no such element
+func (t *term) item(i int) *term {
+	if t == nil || i < 0 || i >= len(t.items) {
+		return nil
+	}
+	return t.items[i]
+}
+
+// elements returns the elements of a tuple, list or map, or nil for other terms
+func (t *term) elements() []*term {
+	if t == nil {
+		return nil
+	}
+	return t.items
+}
+
+// is reports whether a term is of the given kind
+func (t *term) is(kind termKind) bool {
+	return t != nil && t.kind == kind
+}
+
+// text returns the name of an atom or the contents of a string, or "" for other terms
+func (t *term) text() string {
+	if t.is(atomTerm) || t.is(stringTerm) {
+		return t.value
+	}
+	return ""
+}
+
+// keyword returns the value of a {key, value} element of a tuple or list, e.g. {tag, "v1"} or tag: "v1"
+func (t *term) keyword(key string) *term {
+	if t == nil {
+		return nil
+	}
+	for _, item := range t.items {
+		if item.is(tupleTerm) && len(item.items) == 2 && item.items[0].text() == key {
+			return item.items[1]
+		}
+	}
+	return nil
+}
+
+// atoms returns the names of an atom or of a list of atoms, e.g. :test or [:dev, :test]
+func (t *term) atoms() []string {
+	if t.is(atomTerm) {
+		return []string{t.value}
+	}
+	var names []string
+	if t.is(listTerm) {
+		for _, item := range t.items {
+			if item.is(atomTerm) {
+				names = append(names, item.value)
+			}
+		}
+	}
+	return names
+}
+
+// termParser reads literal terms of Erlang (rebar.config, rebar.lock) or Elixir (mix.exs, mix.lock) source.
+// Anything that is not a literal is skipped up to the next separator and kept as an expression.
+type termParser struct {
+	src    []byte
+	pos    int
+	elixir bool
+}
+
+// parseErlangTerms parses the dot terminated terms of an Erlang terms file, e.g. rebar.config
+func parseErlangTerms(src []byte) ([]*term, error) {
+	p := &termParser{src: src}
+	var terms []*term
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.src) {
+			return terms, nil
+		}
+		t, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if !p.consume(".") {
+			return nil, p.unexpected()
+		}
+		terms = append(terms, t)
+	}
+}
+
+// parseElixirTerm parses the Elixir term starting at an offset of the source
+func parseElixirTerm(src []byte, pos int) (*term, error) {
+	p := &termParser{src: src, pos: pos, elixir: true}
+	return p.parseTerm()
+}
+
+// unexpected reports the character at the current position
+func (p *termParser) unexpected() error {
+	line := bytes.Count(p.src[:p.pos], []byte("\n")) + 1
+	if p.pos >= len(p.src) {
+		return fmt.Errorf("unexpected end of file at line %d", line)
+	}
+	return fmt.Errorf("unexpected %q at line %d", p.src[p.pos], line)
+}
+
+// peek reports whether the source continues with a prefix at the current position
+func (p *termParser) peek(prefix string) bool {
+	return bytes.HasPrefix(p.src[p.pos:], []byte(prefix))
+}
+
+// consume skips a prefix at the current position and reports whether it was there
+func (p *termParser) consume(prefix string) bool {
+	if !p.peek(prefix) {
+		return false
+	}
+	p.pos += len(prefix)
+	return true
+}
+
+// skipSpace skips whitespace and comments, # in Elixir and % in Erlang
+func (p *termParser) skipSpace() {
+	comment := byte('%')
+	if p.elixir {
+		comment = '#'
+	}
+	for p.pos < len(p.src) {
+		switch c := p.src[p.pos]; {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			p.pos++
+		case c == comment && !(!p.elixir && p.peek("#{")):
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || (c >= '0' && c <= '9') || c == '@'
+}
+
+// parseIdent reads an identifier, including the ? or ! suffix of Elixir names
+func (p *termParser) parseIdent() string {
+	start := p.pos
+	for p.pos < len(p.src) && isIdentChar(p.src[p.pos]) {
+		p.pos++
+	}
+	if p.elixir && p.pos < len(p.src) && (p.src[p.pos] == '?' || p.src[p.pos] == '!') {
+		p.pos++
+	}
+	return string(p.src[start:p.pos])
+}
+
+// parseQuoted reads a quoted string or atom, dropping the backslashes of escapes
+func (p *termParser) parseQuoted() (string, error) {
+	quote := p.src[p.pos]
+	p.pos++
+	var value strings.Builder
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == '\\' && p.pos+1 < len(p.src):
+			value.WriteByte(p.src[p.pos+1])
+			p.pos += 2
+		case c == quote:
+			p.pos++
+			return value.String(), nil
+		default:
+			value.WriteByte(c)
+			p.pos++
+		}
+	}
+	return "", p.unexpected()
+}
+
+// parseTerm reads the term at the current position
+func (p *termParser) parseTerm() (*term, error) {
+	p.skipSpace()
+	if p.pos >= len(p.src) {
+		return nil, p.unexpected()
+	}
+
+	start := p.pos
+	t := &term{start: start}
+	c := p.src[p.pos]
+	var err error
+	switch {
+	case c == '{':
+		t.kind = tupleTerm
+		t.items, err = p.parseItems('}')
+	case c == '[':
+		t.kind = listTerm
+		t.items, err = p.parseItems(']')
+	case (p.elixir && p.peek("%{")) || (!p.elixir && p.peek("#{")):
+		p.pos++
+		t.kind = mapTerm
+		t.items, err = p.parseItems('}')
+	case p.peek("<<"):
+		// Binaries of a string, e.g. <<"cowboy">>
+		p.pos += 2
+		p.skipSpace()
+		if p.pos < len(p.src) && p.src[p.pos] == '"' {
+			t.kind = stringTerm
+			if t.value, err = p.parseQuoted(); err != nil {
+				return nil, err
+			}
+		}
+		if end := bytes.Index(p.src[p.pos:], []byte(">>")); end >= 0 {
+			p.pos += end + 2
+		} else {
+			return nil, p.unexpected()
+		}
+	case c == '"':
+		t.kind = stringTerm
+		t.value, err = p.parseQuoted()
+		if err == nil && p.elixir && p.peek(": ") {
+			// Quoted keyword keys, e.g. "phoenix": {:hex, ...}
+			return p.parsePair(t, 1)
+		}
+	case c == '\'':
+		// Quoted atoms in Erlang, charlists in Elixir
+		t.kind = atomTerm
+		if p.elixir {
+			t.kind = stringTerm
+		}
+		t.value, err = p.parseQuoted()
+	case p.elixir && c == ':' && p.pos+1 < len(p.src) && p.src[p.pos+1] == '"':
+		p.pos++
+		t.kind = atomTerm
+		t.value, err = p.parseQuoted()
+	case p.elixir && c == ':' && p.pos+1 < len(p.src) && isIdentStart(p.src[p.pos+1]):
+		p.pos++
+		t.kind = atomTerm
+		t.value = p.parseIdent()
+	case isIdentStart(c):
+		name := p.parseIdent()
+		switch {
+		case p.elixir && p.peek(":") && !p.peek("::"):
+			// Keyword keys, e.g. only: :test
+			t.kind, t.value = atomTerm, name
+			return p.parsePair(t, 1)
+		case p.elixir && (name == "true" || name == "false" || name == "nil"):
+			t.kind, t.value = atomTerm, name
+		case !p.elixir && c >= 'a' && c <= 'z':
+			t.kind, t.value = atomTerm, name
+		default:
+			p.pos = start
+			return p.parseExpression(start), nil
+		}
+	default:
+		return p.parseExpression(start), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	t.end = p.pos
+
+	// Map entries, e.g. "key" => value or key => value
+	p.skipSpace()
+	if p.peek("=>") {
+		return p.parsePair(t, 2)
+	}
+	// Operators and calls make the literal a part of a larger expression, e.g. "~> " <> @version.
+	// Elixir ends expressions at line breaks, e.g. the list of deps/0 before its end.
+	endsLine := p.elixir && bytes.ContainsRune(p.src[t.end:p.pos], '\n')
+	if !endsLine && !p.atSeparator() {
+		return p.parseExpression(start), nil
+	}
+	return t, nil
+}
+
+// parsePair reads the value of a keyword or map entry after its key and a separator of the given length
+func (p *termParser) parsePair(key *term, separator int) (*term, error) {
+	key.end = p.pos
+	p.pos += separator
+	value, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	return &term{kind: tupleTerm, items: []*term{key, value}, start: key.start, end: value.end}, nil
+}
+
+// atSeparator reports whether the current position ends a term
+func (p *termParser) atSeparator() bool {
+	if p.pos >= len(p.src) {
+		return true
+	}
+	switch p.src[p.pos] {
+	case ',', '}', ']', ')', '|':
+		return true
+	case '.':
+		return !p.elixir
+	}
+	return p.peek(">>")
+}
+
+// parseItems reads the comma separated elements of a tuple, list or map up to its closing character
+func (p *termParser) parseItems(closing byte) ([]*term, error) {
+	p.pos++
+	var items []*term
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.src) {
+			return nil, p.unexpected()
+		}
+		if p.src[p.pos] == closing {
+			p.pos++
+			return items, nil
+		}
+		item, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+
+		p.skipSpace()
+		if !p.consume(",") && !p.consume("|") && !p.peek(string(closing)) {
+			return nil, p.unexpected()
+		}
+	}
+}
+
+// parseExpression skips an expression up to the comma or closing bracket that ends it
+func (p *termParser) parseExpression(start int) *term {
+	p.pos = start
+	depth := 0
+	end := start
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == '"' || (c == '\'' && !p.elixir):
+			if _, err := p.parseQuoted(); err != nil {
+				p.pos = len(p.src)
+			}
+			end = p.pos
+			continue
+		case (c == '#' && p.elixir) || (c == '%' && !p.elixir):
+			p.skipSpace()
+			continue
+		case c == '(' || c == '[' || c == '{':
+			depth++
+		case c == ')' || c == ']' || c == '}':
+			if depth == 0 {
+				return &term{kind: exprTerm, value: string(p.src[start:end]), start: start, end: end}
+			}
+			depth--
+		case depth == 0 && (c == ',' || (c == '.' && !p.elixir && (p.pos+1 == len(p.src) || !isIdentChar(p.src[p.pos+1])))):
+			return &term{kind: exprTerm, value: string(p.src[start:end]), start: start, end: end}
+		}
+		p.pos++
+		if c != ' ' && c != '\t' && c != '\r' && c != '\n' {
+			end = p.pos
+		}
+	}
+	return &term{kind: exprTerm, value: string(p.src[start:end]), start: start, end: end}
+}
+
+// spanLocations returns the locations of a span of content, one per line, without the indentation of its lines
+func spanLocations(content []byte, start, end int) []models.Location {
+	line := bytes.Count(content[:start], []byte("\n"))
+	column := start - (bytes.LastIndexByte(content[:start], '\n') + 1)
+
+	var locations []models.Location
+	for i, part := range strings.Split(string(content[start:end]), "\n") {
+		if i > 0 {
+			column = 0
+		}
+		trimmed := strings.TrimRight(part, " \t\r")
+		if strings.TrimSpace(trimmed) == "" {
+			continue
+		}
+		indent := len(trimmed) - len(strings.TrimLeft(trimmed, " \t"))
+		locations = append(locations, models.Location{Line: line + i, StartIndex: column + indent, EndIndex: column + len(trimmed)})
+	}
+	return locations
+}
@@ -0,0 +1,82 @@
+package hex
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseErlangTerms(t *testing.T) {
+	content := `%% comment
+{deps, [cowboy, {'quoted-atom', "1.0"}, {bin, <<"value">>}, #{key => Value}]}.
+{erl_opts, [debug_info, {d, 'VERSION', 1.2}]}.
+`
+	terms, err := parseErlangTerms([]byte(content))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(terms) != 2 {
+		t.Fatalf("Expected 2 terms, got %d", len(terms))
+	}
+
+	deps := (&term{kind: listTerm, items: terms}).keyword("deps")
+	if got := len(deps.elements()); got != 4 {
+		t.Fatalf("Expected 4 deps, got %d", got)
+	}
+	if got := deps.item(0); !got.is(atomTerm) || got.value != "cowboy" {
+		t.Errorf("Expected atom cowboy, got %+v", got)
+	}
+	if got := deps.item(1).item(0).text(); got != "quoted-atom" {
+		t.Errorf("Expected quoted atom, got %q", got)
+	}
+	if got := deps.item(2).item(1); !got.is(stringTerm) || got.value != "value" {
+		t.Errorf("Expected binary string, got %+v", got)
+	}
+	if got := deps.item(3).keyword("key"); !got.is(exprTerm) || got.value != "Value" {
+		t.Errorf("Expected map entry with a variable, got %+v", got)
+	}
+}
+
+func TestParseElixirTerm(t *testing.T) {
+	content := `[
+  {:a, "~> 1.0", only: [:dev, :test], runtime: false}, # comment
+  {:b, version(), "key" => :"quoted atom"},
+  {:c, "~> " <> @version, hex: :c_pkg}
+]`
+	list, err := parseElixirTerm([]byte(content), 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := len(list.elements()); got != 3 {
+		t.Fatalf("Expected 3 items, got %d", got)
+	}
+
+	a := list.item(0)
+	if got := a.keyword("only").atoms(); !reflect.DeepEqual(got, []string{"dev", "test"}) {
+		t.Errorf("only: got %v", got)
+	}
+	if got := a.keyword("runtime").text(); got != "false" {
+		t.Errorf("runtime: got %q", got)
+	}
+
+	b := list.item(1)
+	if got := b.item(1); !got.is(exprTerm) || got.value != "version()" {
+		t.Errorf("Expected call expression, got %+v", got)
+	}
+	if got := b.keyword("key").text(); got != "quoted atom" {
+		t.Errorf("Expected map entry, got %q", got)
+	}
+
+	c := list.item(2)
+	if got := c.item(1); !got.is(exprTerm) || got.value != `"~> " <> @version` {
+		t.Errorf("Expected operator expression, got %+v", got)
+	}
+	if got := c.keyword("hex").text(); got != "c_pkg" {
+		t.Errorf("hex: got %q", got)
+	}
+}
+
+func TestParseErlangTerms_Unterminated(t *testing.T) {
+	if _, err := parseErlangTerms([]byte(`{deps, [cowboy]}`)); err == nil {
+		t.Errorf("Expected an error for a term without its dot")
+	}
+}
@@ -5,9 +5,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"sort"
 	"strings"
 
+	"github.com/Checkmarx/manifest-parser/internal/jsonutil"
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
 	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
 )
 
@@ -40,63 +41,6 @@ type lockFile struct {
 // NpmParser extracts packages with position information from package.json
 type NpmPackageJsonParser struct{}
 
-// Extract line and character positions for a key in JSON
-func findPositions(fileContent string, key string) (lineStart, startIndex, endIndex int) {
-	lines := strings.Split(fileContent, "\n")
-
-	keyPattern := fmt.Sprintf("\"%s\"", key)
-	for i, line := range lines {
-		if strings.Contains(line, keyPattern) {
-			// Find the start of the key (after indentation)
-			startPos := strings.Index(line, keyPattern)
-			if startPos < 0 {
-				continue
-			}
-
-			// Find the end of the value (including quotes and comma)
-			endPos := startPos
-			valueStart := strings.Index(line[startPos:], ":")
-			if valueStart < 0 {
-				continue
-			}
-			valueStart += startPos + 1
-
-			// Skip whitespace after colon
-			for valueStart < len(line) && (line[valueStart] == ' ' || line[valueStart] == '\t') {
-				valueStart++
-			}
-
-			// Find the end of the value
-			if valueStart < len(line) {
-				if line[valueStart] == '"' {
-					// String value
-					endPos = strings.Index(line[valueStart+1:], "\"")
-					if endPos >= 0 {
-						endPos += valueStart + 2 // +2 for both quotes
-					}
-				} else {
-					// Non-string value (number, boolean, etc.)
-					endPos = strings.IndexAny(line[valueStart:], ",}\n")
-					if endPos >= 0 {
-						endPos += valueStart
-					}
-				}
-			}
-
-			// If we found a comma, include it
-			if endPos < len(line) && line[endPos] == ',' {
-				endPos++
-			}
-
-			lineStart = i
-			startIndex = startPos
-			endIndex = endPos
-			return
-		}
-	}
-	return 0, 0, 0
-}
-
 func (p *NpmPackageJsonParser) Parse(manifestFile string) ([]models.Package, error) {
 	// Read the entire file for position tracking
 	fileContent, err := os.ReadFile(manifestFile)
@@ -109,6 +53,10 @@ func (p *NpmPackageJsonParser) Parse(manifestFile string) ([]models.Package, err
 	if err := json.Unmarshal(fileContent, &pkg); err != nil {
 		return nil, fmt.Errorf("failed to parse JSON: %w", err)
 	}
+	locations, err := jsonutil.FindLocations(fileContent)
+	if err != nil {
+		return nil, err
+	}
 
 	// Try to load package-lock.json
 	lockPath := filepath.Join(filepath.Dir(manifestFile), "package-lock.json")
@@ -127,18 +75,18 @@ func (p *NpmPackageJsonParser) Parse(manifestFile string) ([]models.Package, err
 	processDeps := func(depMap map[string]string, depType string) {
 		for name, version := range depMap {
 			resolvedVersion := getResolvedVersion(name, version, lock)
-			lineStart, startIndex, endIndex := findPositions(string(fileContent), name)
+
+			var depLocations []models.Location
+			if location, ok := locations.Find(depType, name); ok {
+				depLocations = append(depLocations, location)
+			}
 
 			results = append(results, models.Package{
 				PackageManager: "npm",
 				PackageName:    name,
 				Version:        resolvedVersion,
 				FilePath:       manifestFile,
-				Locations: []models.Location{{
-					Line:       lineStart,
-					StartIndex: startIndex,
-					EndIndex:   endIndex,
-				}},
+				Locations:      depLocations,
 			})
 		}
 	}
@@ -149,9 +97,7 @@ func (p *NpmPackageJsonParser) Parse(manifestFile string) ([]models.Package, err
 	processDeps(pkg.OptionalDependencies, "optionalDependencies")
 
 	// Sort packages by line number
-	sort.Slice(results, func(i, j int) bool {
-		return results[i].Locations[0].Line < results[j].Locations[0].Line
-	})
+	pkgutil.SortByFirstLine(results)
 
 	return results, nil
 }
@@ -341,6 +341,35 @@ func TestPositionTracking(t *testing.T) {
 	}
 }
 
+// TestPositionTrackingBySection tests that packages are located in their dependency section, even when their name
+// also appears elsewhere in the file
+func TestPositionTrackingBySection(t *testing.T) {
+	packageJSON := `{
+  "name": "react",
+  "peerDependencies": {
+    "react-dom": "^18.0.0"
+  },
+  "devDependencies": {"react": "18.2.0",
+    "react-dom": "18.2.0"}
+}`
+	packageJSONPath := filepath.Join(t.TempDir(), "package.json")
+	if err := os.WriteFile(packageJSONPath, []byte(packageJSON), 0644); err != nil {
+		t.Fatalf("failed to write package.json: %v", err)
+	}
+
+	packages, err := (&NpmPackageJsonParser{}).Parse(packageJSONPath)
+	if err != nil {
+		t.Fatalf("parsing failed: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "npm", PackageName: "react-dom", Version: "latest", FilePath: packageJSONPath, Locations: []models.Location{{Line: 3, StartIndex: 4, EndIndex: 26}}},
+		{PackageManager: "npm", PackageName: "react", Version: "18.2.0", FilePath: packageJSONPath, Locations: []models.Location{{Line: 5, StartIndex: 22, EndIndex: 40}}},
+		{PackageManager: "npm", PackageName: "react-dom", Version: "18.2.0", FilePath: packageJSONPath, Locations: []models.Location{{Line: 6, StartIndex: 4, EndIndex: 25}}},
+	}
+	testdata.ValidatePackages(t, packages, expectedPackages)
+}
+
 // TestMalformedPackageJson tests parser behavior with a malformed package.json
 func TestMalformedPackageJson(t *testing.T) {
 	// Package.json with syntax error
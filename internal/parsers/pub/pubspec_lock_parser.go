@@ -0,0 +1,112 @@
+package pub
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/internal/yamlutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// PubspecLockParser implements parsing of Dart and Flutter lock files (pubspec.lock)
+type PubspecLockParser struct{}
+
+// dependencyScopes maps the dependency kinds of pubspec.lock to the sections of pubspec.yaml
+var dependencyScopes = map[string]string{
+	"direct main":       dependenciesSection,
+	"direct dev":        devDependenciesSection,
+	"direct overridden": dependencyOverridesSection,
+}
+
+// transitiveDependency is the dependency kind of packages that are not declared in pubspec.yaml
+const transitiveDependency = "transitive"
+
+// lockMetadata describes where a locked package comes from
+func lockMetadata(source string, description *yaml.Node) map[string]string {
+	metadata := make(map[string]string)
+	switch source {
+	case "hosted":
+		if url := yamlutil.Value(description, "url"); url != "" && strings.TrimSuffix(url, "/") != defaultHostedURL {
+			metadata["registry"] = url
+		}
+	case "git":
+		metadata["source"] = "git"
+		metadata["git"] = yamlutil.Value(description, "url")
+		if ref := yamlutil.Value(description, "ref"); ref != "" {
+			metadata["ref"] = ref
+		}
+		if rev := yamlutil.Value(description, "resolved-ref"); rev != "" {
+			metadata["rev"] = rev
+		}
+		if path := yamlutil.Value(description, "path"); path != "" && path != "." {
+			metadata["gitPath"] = path
+		}
+	case "path":
+		metadata["source"] = "path"
+		metadata["path"] = yamlutil.Value(description, "path")
+	case "sdk":
+		// The description of sdk packages is the name of the sdk, e.g. description: flutter
+		metadata["source"] = "sdk"
+		metadata["sdk"] = description.Value
+	}
+	return metadata
+}
+
+// Parse implements the Parser interface for pubspec.lock files
+func (p *PubspecLockParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	doc, err := yamlutil.Parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var packages []models.Package
+	_, lockedPackages := yamlutil.Lookup(doc.Root, "packages")
+	for _, pair := range yamlutil.Pairs(lockedPackages) {
+		locked := pair.Value
+		kind := yamlutil.Value(locked, "dependency")
+		_, description := yamlutil.Lookup(locked, "description")
+		if description == nil {
+			description = &yaml.Node{}
+		}
+
+		locations := []models.Location{doc.EntryLocation(pair.Key, locked)}
+		if versionKey, versionValue := yamlutil.Lookup(locked, "version"); versionKey != nil {
+			locations = append(locations, doc.EntryLocation(versionKey, versionValue))
+		}
+
+		metadata := lockMetadata(yamlutil.Value(locked, "source"), description)
+		if kind == "direct overridden" {
+			metadata["overridden"] = "true"
+		}
+		if len(metadata) == 0 {
+			metadata = nil
+		}
+
+		var scopes []string
+		if scope, ok := dependencyScopes[kind]; ok {
+			scopes = []string{scope}
+		}
+
+		packages = append(packages, models.Package{
+			PackageManager: "pub",
+			PackageName:    pair.Key.Value,
+			Version:        yamlutil.Value(locked, "version"),
+			FilePath:       manifestFile,
+			Locations:      locations,
+			Scopes:         scopes,
+			Transitive:     kind == transitiveDependency,
+			Hashes:         pkgutil.HexSRIHash("sha256", yamlutil.Value(description, "sha256")),
+			Metadata:       metadata,
+		})
+	}
+	return packages, nil
+}
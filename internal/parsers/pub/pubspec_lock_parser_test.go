@@ -0,0 +1,68 @@
+package pub
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestPubspecLockParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/pubspec.lock"
+
+	packages, err := (&PubspecLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "pub", PackageName: "collection", Version: "1.18.0", FilePath: manifestFile, Locations: []models.Location{{Line: 3, StartIndex: 2, EndIndex: 13}, {Line: 10, StartIndex: 4, EndIndex: 21}}},
+		{PackageManager: "pub", PackageName: "flutter", Version: "0.0.0", FilePath: manifestFile, Locations: []models.Location{{Line: 11, StartIndex: 2, EndIndex: 10}, {Line: 15, StartIndex: 4, EndIndex: 20}}},
+		{PackageManager: "pub", PackageName: "http", Version: "1.2.0", FilePath: manifestFile, Locations: []models.Location{{Line: 16, StartIndex: 2, EndIndex: 7}, {Line: 23, StartIndex: 4, EndIndex: 20}}},
+		{PackageManager: "pub", PackageName: "intl", Version: "0.18.1", FilePath: manifestFile, Locations: []models.Location{{Line: 24, StartIndex: 2, EndIndex: 7}, {Line: 31, StartIndex: 4, EndIndex: 21}}},
+		{PackageManager: "pub", PackageName: "lints", Version: "3.0.0", FilePath: manifestFile, Locations: []models.Location{{Line: 32, StartIndex: 2, EndIndex: 8}, {Line: 39, StartIndex: 4, EndIndex: 20}}},
+		{PackageManager: "pub", PackageName: "local_pkg", Version: "0.0.1", FilePath: manifestFile, Locations: []models.Location{{Line: 40, StartIndex: 2, EndIndex: 12}, {Line: 46, StartIndex: 4, EndIndex: 20}}},
+		{PackageManager: "pub", PackageName: "my_git", Version: "2.1.0", FilePath: manifestFile, Locations: []models.Location{{Line: 47, StartIndex: 2, EndIndex: 9}, {Line: 55, StartIndex: 4, EndIndex: 20}}},
+		{PackageManager: "pub", PackageName: "private_pkg", Version: "2.3.1", FilePath: manifestFile, Locations: []models.Location{{Line: 56, StartIndex: 2, EndIndex: 14}, {Line: 63, StartIndex: 4, EndIndex: 20}}},
+		{PackageManager: "pub", PackageName: "meta", Version: "1.10.0", FilePath: manifestFile, Locations: []models.Location{{Line: 64, StartIndex: 2, EndIndex: 7}, {Line: 71, StartIndex: 4, EndIndex: 21}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	for _, pkg := range packages {
+		if pkg.Transitive != (pkg.PackageName == "meta") {
+			t.Errorf("%s Transitive: got %v", pkg.PackageName, pkg.Transitive)
+		}
+	}
+
+	wantScopes := map[string][]string{"collection": {"dependency_overrides"}, "flutter": {"dependencies"}, "lints": {"dev_dependencies"}, "meta": nil}
+	for _, pkg := range packages {
+		if want, ok := wantScopes[pkg.PackageName]; ok && !reflect.DeepEqual(pkg.Scopes, want) {
+			t.Errorf("%s Scopes: got %v, want %v", pkg.PackageName, pkg.Scopes, want)
+		}
+	}
+
+	if want := []string{"sha256-O8EyqdvOc6fkohoX0G4YeIOf+/l1VovIdcYFN4JLDE0="}; !reflect.DeepEqual(packages[3].Hashes, want) {
+		t.Errorf("intl Hashes: got %v, want %v", packages[3].Hashes, want)
+	}
+	if packages[7].Hashes != nil {
+		t.Errorf("An empty sha256 must not produce hashes, got %v", packages[7].Hashes)
+	}
+
+	wantMetadata := map[string]map[string]string{
+		"collection": {"overridden": "true"},
+		"flutter":    {"source": "sdk", "sdk": "flutter"},
+		"my_git":     {"source": "git", "git": "https://github.com/acme/my_git.git", "ref": "main", "rev": "8f2c5a1e9b7d3c4f6a0e1b2c3d4e5f6a7b8c9d0e", "gitPath": "packages/my_git"},
+		"local_pkg":  {"source": "path", "path": "../local_pkg"},
+		"meta":       nil,
+	}
+	for _, pkg := range packages {
+		if want, ok := wantMetadata[pkg.PackageName]; ok && !reflect.DeepEqual(pkg.Metadata, want) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, want)
+		}
+	}
+}
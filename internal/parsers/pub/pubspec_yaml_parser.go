@@ -0,0 +1,189 @@
+package pub
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Checkmarx/manifest-parser/internal/yamlutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// PubspecYamlParser implements parsing of Dart and Flutter manifests (pubspec.yaml)
+type PubspecYamlParser struct{}
+
+// Pub file names
+const (
+	PubspecYaml = "pubspec.yaml"
+	PubspecLock = "pubspec.lock"
+)
+
+// Dependency sections of pubspec.yaml
+const (
+	dependenciesSection        = "dependencies"
+	devDependenciesSection     = "dev_dependencies"
+	dependencyOverridesSection = "dependency_overrides"
+)
+
+// defaultHostedURL is the registry hosted dependencies are fetched from by default
+const defaultHostedURL = "https://pub.dev"
+
+// dependency is a dependency declaration: a version constraint or a map with its source
+type dependency struct {
+	name       string
+	key        *yaml.Node
+	constraint string
+	metadata   map[string]string
+}
+
+// parseDependency reads a dependency declaration, e.g. http: ^1.1.0 or my_pkg: {git: {url: ..., ref: main}}
+func parseDependency(key, value *yaml.Node) dependency {
+	dep := dependency{name: key.Value, key: key, metadata: make(map[string]string)}
+	if value.Kind == yaml.ScalarNode {
+		dep.constraint = value.Value
+		return dep
+	}
+
+	dep.constraint = yamlutil.Value(value, "version")
+	for _, pair := range yamlutil.Pairs(value) {
+		source := pair.Value
+		switch pair.Key.Value {
+		case "hosted":
+			// hosted: https://pub.example.com or hosted: {name: pkg, url: https://pub.example.com}
+			url := source.Value
+			if source.Kind == yaml.MappingNode {
+				url = yamlutil.Value(source, "url")
+			}
+			if url != "" && strings.TrimSuffix(url, "/") != defaultHostedURL {
+				dep.metadata["registry"] = url
+			}
+		case "git":
+			dep.metadata["source"] = "git"
+			dep.metadata["git"] = source.Value
+			if source.Kind == yaml.MappingNode {
+				dep.metadata["git"] = yamlutil.Value(source, "url")
+				if ref := yamlutil.Value(source, "ref"); ref != "" {
+					dep.metadata["ref"] = ref
+				}
+				if path := yamlutil.Value(source, "path"); path != "" {
+					dep.metadata["gitPath"] = path
+				}
+			}
+		case "path":
+			dep.metadata["source"] = "path"
+			dep.metadata["path"] = source.Value
+		case "sdk":
+			dep.metadata["source"] = "sdk"
+			dep.metadata["sdk"] = source.Value
+		}
+	}
+	return dep
+}
+
+// isExactVersion reports whether a constraint pins a single version, e.g. "1.2.3" but not "^1.2.3" or "any"
+func isExactVersion(constraint string) bool {
+	return constraint != "" && constraint != "any" && !strings.ContainsAny(constraint, "^<>= ")
+}
+
+// getResolvedVersion resolves a version constraint of pubspec.yaml
+// - Returns the exact version directly if specified in pubspec.yaml
+// - Looks up in pubspec.lock if the constraint is a range, "any" or missing
+// - Returns "latest" when the package is not locked
+func getResolvedVersion(dep dependency, locked map[string]string) string {
+	if isExactVersion(dep.constraint) {
+		return dep.constraint
+	}
+	if version, ok := locked[dep.name]; ok {
+		return version
+	}
+	return "latest"
+}
+
+// loadLockedVersions reads the pubspec.lock next to a manifest and maps package names to their versions
+func loadLockedVersions(manifestFile string) map[string]string {
+	lockFile := filepath.Join(filepath.Dir(manifestFile), PubspecLock)
+	if _, err := os.Stat(lockFile); err != nil {
+		return nil
+	}
+	packages, err := (&PubspecLockParser{}).Parse(lockFile)
+	if err != nil {
+		return nil
+	}
+
+	locked := make(map[string]string)
+	for _, pkg := range packages {
+		locked[pkg.PackageName] = pkg.Version
+	}
+	return locked
+}
+
+// Parse implements the Parser interface for pubspec.yaml files
+func (p *PubspecYamlParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	doc, err := yamlutil.Parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	// Overrides replace the declaration of a dependency wherever it comes from
+	overrides := make(map[string]dependency)
+	_, overrideSection := yamlutil.Lookup(doc.Root, dependencyOverridesSection)
+	for _, pair := range yamlutil.Pairs(overrideSection) {
+		overrides[pair.Key.Value] = parseDependency(pair.Key, pair.Value)
+	}
+
+	locked := loadLockedVersions(manifestFile)
+	newPackage := func(dep dependency, scope string, locations []models.Location) models.Package {
+		metadata := dep.metadata
+		if len(metadata) == 0 {
+			metadata = nil
+		}
+		return models.Package{
+			PackageManager: "pub",
+			PackageName:    dep.name,
+			Version:        getResolvedVersion(dep, locked),
+			FilePath:       manifestFile,
+			Locations:      locations,
+			Scopes:         []string{scope},
+			Metadata:       metadata,
+		}
+	}
+
+	var packages []models.Package
+	declared := make(map[string]bool)
+	for _, section := range []string{dependenciesSection, devDependenciesSection} {
+		_, dependencies := yamlutil.Lookup(doc.Root, section)
+		for _, pair := range yamlutil.Pairs(dependencies) {
+			dep := parseDependency(pair.Key, pair.Value)
+			locations := []models.Location{doc.EntryLocation(pair.Key, pair.Value)}
+			declared[dep.name] = true
+
+			if override, ok := overrides[dep.name]; ok {
+				_, overrideValue := yamlutil.Lookup(overrideSection, dep.name)
+				locations = append(locations, doc.EntryLocation(override.key, overrideValue))
+				dep = override
+				dep.metadata["overridden"] = "true"
+			}
+			packages = append(packages, newPackage(dep, section, locations))
+		}
+	}
+
+	// Overrides of packages that are not declared pin dependencies of dependencies; pub lists them as "direct overridden"
+	for _, pair := range yamlutil.Pairs(overrideSection) {
+		if declared[pair.Key.Value] {
+			continue
+		}
+		dep := overrides[pair.Key.Value]
+		dep.metadata["overridden"] = "true"
+		packages = append(packages, newPackage(dep, dependencyOverridesSection, []models.Location{doc.EntryLocation(pair.Key, pair.Value)}))
+	}
+
+	return packages, nil
+}
@@ -0,0 +1,91 @@
+package pub
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestPubspecYamlParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/pubspec.yaml"
+
+	packages, err := (&PubspecYamlParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Ranges are resolved from the pubspec.lock next to pubspec.yaml, overrides replace the declared constraint
+	expectedPackages := []models.Package{
+		{PackageManager: "pub", PackageName: "flutter", Version: "0.0.0", FilePath: manifestFile, Locations: []models.Location{{Line: 8, StartIndex: 2, EndIndex: 10}}},
+		{PackageManager: "pub", PackageName: "http", Version: "1.2.0", FilePath: manifestFile, Locations: []models.Location{{Line: 10, StartIndex: 2, EndIndex: 14}, {Line: 30, StartIndex: 2, EndIndex: 13}}},
+		{PackageManager: "pub", PackageName: "provider", Version: "6.0.5", FilePath: manifestFile, Locations: []models.Location{{Line: 11, StartIndex: 2, EndIndex: 17}}},
+		{PackageManager: "pub", PackageName: "intl", Version: "0.18.1", FilePath: manifestFile, Locations: []models.Location{{Line: 12, StartIndex: 2, EndIndex: 11}}},
+		{PackageManager: "pub", PackageName: "my_git", Version: "2.1.0", FilePath: manifestFile, Locations: []models.Location{{Line: 13, StartIndex: 2, EndIndex: 9}}},
+		{PackageManager: "pub", PackageName: "local_pkg", Version: "0.0.1", FilePath: manifestFile, Locations: []models.Location{{Line: 18, StartIndex: 2, EndIndex: 12}}},
+		{PackageManager: "pub", PackageName: "private_pkg", Version: "2.3.1", FilePath: manifestFile, Locations: []models.Location{{Line: 20, StartIndex: 2, EndIndex: 14}}},
+		{PackageManager: "pub", PackageName: "flutter_test", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 25, StartIndex: 2, EndIndex: 15}}},
+		{PackageManager: "pub", PackageName: "lints", Version: "3.0.0", FilePath: manifestFile, Locations: []models.Location{{Line: 27, StartIndex: 2, EndIndex: 15}}},
+		{PackageManager: "pub", PackageName: "collection", Version: "1.18.0", FilePath: manifestFile, Locations: []models.Location{{Line: 31, StartIndex: 2, EndIndex: 20}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	wantScopes := map[string]string{"flutter": "dependencies", "flutter_test": "dev_dependencies", "collection": "dependency_overrides"}
+	wantMetadata := map[string]map[string]string{
+		"flutter":     {"source": "sdk", "sdk": "flutter"},
+		"http":        {"overridden": "true"},
+		"provider":    nil,
+		"my_git":      {"source": "git", "git": "https://github.com/acme/my_git.git", "ref": "main", "gitPath": "packages/my_git"},
+		"local_pkg":   {"source": "path", "path": "../local_pkg"},
+		"private_pkg": {"registry": "https://pub.example.com"},
+		"collection":  {"overridden": "true"},
+	}
+	for _, pkg := range packages {
+		if want, ok := wantScopes[pkg.PackageName]; ok && !reflect.DeepEqual(pkg.Scopes, []string{want}) {
+			t.Errorf("%s Scopes: got %v, want [%s]", pkg.PackageName, pkg.Scopes, want)
+		}
+		if want, ok := wantMetadata[pkg.PackageName]; ok && !reflect.DeepEqual(pkg.Metadata, want) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, want)
+		}
+	}
+}
+
+func TestPubspecYamlParser_WithoutLock(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "pubspec.yaml")
+	content := `name: cli
+dependencies:
+  args: ">=2.4.0 <3.0.0"
+  path: '1.8.3'
+  yaml:
+    hosted:
+      name: yaml
+      url: https://pub.dev
+    version: ^3.1.0
+`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write pubspec.yaml: %v", err)
+	}
+
+	packages, err := (&PubspecYamlParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "pub", PackageName: "args", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 2, StartIndex: 2, EndIndex: 24}}},
+		{PackageManager: "pub", PackageName: "path", Version: "1.8.3", FilePath: manifestFile, Locations: []models.Location{{Line: 3, StartIndex: 2, EndIndex: 15}}},
+		{PackageManager: "pub", PackageName: "yaml", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 2, EndIndex: 7}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) == 3 && packages[2].Metadata != nil {
+		t.Errorf("Packages hosted on pub.dev must not have a registry, got %v", packages[2].Metadata)
+	}
+}
@@ -13,11 +13,21 @@ import (
 // PypiParser implements parsing of requirements.txt
 type PypiParser struct{}
 
-func extractPackageName(line string, re *regexp.Regexp, lineNum int, manifestFile string) (string, bool) {
-	if match := re.FindStringSubmatch(line); match != nil {
+// requirementPattern matches a requirement and captures its package name, e.g. requests[socks]>=2.31
+var requirementPattern = regexp.MustCompile(`^([a-zA-Z0-9_\-\.]+)(?:\[.*\])?(?:[>=<!~,\s].*)?$`)
+
+// Requirement is a requirement line with the columns of the line it spans
+type Requirement struct {
+	Name       string
+	Version    string
+	StartIndex int
+	EndIndex   int
+}
+
+func extractPackageName(line string) (string, bool) {
+	if match := requirementPattern.FindStringSubmatch(line); match != nil {
 		return match[1], true
 	}
-	log.Printf("Skipping line %d in %s: no valid package name found", lineNum, manifestFile)
 	return "", false
 }
 
@@ -67,6 +77,30 @@ func computeIndices(raw, pkgName string) (int, int) {
 	return startIdx, endIdx
 }
 
+// ParseRequirement parses a requirement line the way lines of requirements.txt are parsed, e.g. requests==2.25.1  # pinned.
+// It reports false for blank lines, comments and lines without a package name, such as options.
+func ParseRequirement(raw string) (Requirement, bool) {
+	line := strings.TrimSpace(raw)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return Requirement{}, false
+	}
+	if strings.Contains(line, "#") {
+		line = strings.SplitN(line, "#", 2)[0]
+		line = strings.TrimSpace(line)
+	}
+	if strings.Contains(line, ";") {
+		line = strings.SplitN(line, ";", 2)[0]
+		line = strings.TrimSpace(line)
+	}
+
+	pkgName, ok := extractPackageName(line)
+	if !ok {
+		return Requirement{}, false
+	}
+	startCol, endCol := computeIndices(raw, pkgName)
+	return Requirement{Name: pkgName, Version: extractVersion(line), StartIndex: startCol, EndIndex: endCol}, true
+}
+
 func (p *PypiParser) Parse(manifestFile string) ([]models.Package, error) {
 	file, err := os.Open(manifestFile)
 	if err != nil {
@@ -78,8 +112,6 @@ func (p *PypiParser) Parse(manifestFile string) ([]models.Package, error) {
 	scanner := bufio.NewScanner(file)
 	lineNum := 0
 
-	re := regexp.MustCompile(`^([a-zA-Z0-9_\-\.]+)(?:\[.*\])?(?:[>=<!~,\s].*)?$`)
-
 	for scanner.Scan() {
 		raw := scanner.Text()
 		line := strings.TrimSpace(raw)
@@ -87,32 +119,23 @@ func (p *PypiParser) Parse(manifestFile string) ([]models.Package, error) {
 			lineNum++
 			continue
 		}
-		if strings.Contains(line, "#") {
-			line = strings.SplitN(line, "#", 2)[0]
-			line = strings.TrimSpace(line)
-		}
-		if strings.Contains(line, ";") {
-			line = strings.SplitN(line, ";", 2)[0]
-			line = strings.TrimSpace(line)
-		}
 
-		pkgName, ok := extractPackageName(line, re, lineNum, manifestFile)
+		requirement, ok := ParseRequirement(raw)
 		if !ok {
+			log.Printf("Skipping line %d in %s: no valid package name found", lineNum, manifestFile)
 			lineNum++
 			continue
 		}
-		version := extractVersion(line)
-		startCol, endCol := computeIndices(raw, pkgName)
 
 		packages = append(packages, models.Package{
 			PackageManager: "pypi",
-			PackageName:    pkgName,
-			Version:        version,
+			PackageName:    requirement.Name,
+			Version:        requirement.Version,
 			FilePath:       manifestFile,
 			Locations: []models.Location{{
 				Line:       lineNum,
-				StartIndex: startCol,
-				EndIndex:   endCol,
+				StartIndex: requirement.StartIndex,
+				EndIndex:   requirement.EndIndex,
 			}},
 		})
 		lineNum++
@@ -162,3 +162,23 @@ func TestPypiParser_Parse_RealFile(t *testing.T) {
 
 	testdata.ValidatePackages(t, pkgs, expected)
 }
+
+func TestParseRequirement(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want Requirement
+		ok   bool
+	}{
+		{"requests==2.31.0", Requirement{Name: "requests", Version: "2.31.0", StartIndex: 0, EndIndex: 16}, true},
+		{"  flask>=2.0  # web", Requirement{Name: "flask", Version: "latest", StartIndex: 2, EndIndex: 12}, true},
+		{"pywin32==306; sys_platform == 'win32'", Requirement{Name: "pywin32", Version: "306", StartIndex: 0, EndIndex: 12}, true},
+		{"# comment", Requirement{}, false},
+		{"==1.0", Requirement{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseRequirement(tt.raw)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("ParseRequirement(%q) = %+v, %v; want %+v, %v", tt.raw, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package ruby
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// RubyGemfileLockParser implements parsing of Bundler lock files (Gemfile.lock and gems.locked)
+type RubyGemfileLockParser struct{}
+
+// Sections of a Bundler lock file
+const (
+	gemSection          = "GEM"
+	gitSection          = "GIT"
+	pathSection         = "PATH"
+	pluginSourceSection = "PLUGIN SOURCE"
+	dependenciesSection = "DEPENDENCIES"
+	checksumsSection    = "CHECKSUMS"
+)
+
+// Indentation of the entries of a lock file: source options, specs and the dependencies of a spec
+const (
+	optionIndent     = "  "
+	specIndent       = "    "
+	specDependIndent = "      "
+)
+
+// lockSource is a GEM, GIT or PATH section of a lock file
+type lockSource struct {
+	kind    string
+	options map[string]string
+}
+
+// metadata describes where the specs of a source come from
+func (s lockSource) metadata() map[string]string {
+	metadata := make(map[string]string)
+	switch s.kind {
+	case gemSection:
+		if remote := s.options["remote"]; remote != "" {
+			metadata["registry"] = remote
+		}
+	case gitSection:
+		metadata["source"] = "git"
+		metadata["git"] = s.options["remote"]
+		if revision := s.options["revision"]; revision != "" {
+			metadata["rev"] = revision
+		}
+		for _, ref := range []string{"branch", "tag", "ref"} {
+			if value := s.options[ref]; value != "" {
+				metadata[ref] = value
+			}
+		}
+	case pathSection:
+		metadata["source"] = "path"
+		metadata["path"] = s.options["remote"]
+	}
+	return metadata
+}
+
+// parseSpec parses a spec or dependency entry, e.g. "nokogiri (1.15.4-x86_64-linux)" or "rails!"
+func parseSpec(entry string) (name, version string) {
+	name, rest, _ := strings.Cut(strings.TrimSpace(entry), " ")
+	name = strings.TrimSuffix(name, "!")
+	rest = strings.TrimSpace(rest)
+	if strings.HasPrefix(rest, "(") {
+		version, _, _ = strings.Cut(rest[1:], ")")
+	}
+	return name, version
+}
+
+// splitPlatform splits a locked version from its platform, e.g. "1.15.4-x86_64-linux"
+func splitPlatform(version string) (string, string) {
+	version, platform, _ := strings.Cut(version, "-")
+	return version, platform
+}
+
+// parseChecksum converts a checksum entry, e.g. "rack (3.0.8) sha256=<hex>", into its spec key and SRI hashes
+func parseChecksum(entry string) (string, []string) {
+	spec, checksums, _ := strings.Cut(strings.TrimSpace(entry), ") ")
+	var hashes []string
+	for _, checksum := range strings.Split(checksums, ",") {
+		algorithm, digest, found := strings.Cut(strings.TrimSpace(checksum), "=")
+		if !found {
+			continue
+		}
+		hashes = append(hashes, pkgutil.HexSRIHash(algorithm, digest)...)
+	}
+	return spec + ")", hashes
+}
+
+// entryLocation returns the location of an indented entry of a lock file
+func entryLocation(line string, lineNum int) models.Location {
+	content := strings.TrimRight(line, " \t\r")
+	return models.Location{
+		Line:       lineNum,
+		StartIndex: len(content) - len(strings.TrimLeft(content, " ")),
+		EndIndex:   len(content),
+	}
+}
+
+// Parse implements the Parser interface for Gemfile.lock files
+func (p *RubyGemfileLockParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	var packages []models.Package
+	specKeys := make(map[string]int)
+	direct := make(map[string]bool)
+	hasDependencies := false
+
+	var section string
+	var source *lockSource
+	var current *models.Package
+
+	for i, raw := range strings.Split(string(content), "\n") {
+		line := strings.TrimRight(raw, " \t\r")
+		if line == "" {
+			continue
+		}
+
+		// Section headers start at the first column
+		if !strings.HasPrefix(line, " ") {
+			section = line
+			source = nil
+			current = nil
+			switch section {
+			case gemSection, gitSection, pathSection, pluginSourceSection:
+				source = &lockSource{kind: section, options: make(map[string]string)}
+			case dependenciesSection:
+				hasDependencies = true
+			}
+			continue
+		}
+
+		switch {
+		case section == dependenciesSection:
+			name, _ := parseSpec(line)
+			direct[name] = true
+
+		case section == checksumsSection:
+			key, hashes := parseChecksum(line)
+			if idx, ok := specKeys[key]; ok {
+				packages[idx].Hashes = hashes
+			}
+
+		case source == nil:
+			// PLATFORMS, RUBY VERSION and BUNDLED WITH hold no gems
+
+		case strings.HasPrefix(line, specDependIndent):
+			if current != nil {
+				name, _ := parseSpec(line)
+				current.Dependencies = append(current.Dependencies, name)
+			}
+
+		case strings.HasPrefix(line, specIndent):
+			name, lockedVersion := parseSpec(line)
+			version, platform := splitPlatform(lockedVersion)
+			metadata := source.metadata()
+			if platform != "" {
+				metadata["platform"] = platform
+			}
+			if len(metadata) == 0 {
+				metadata = nil
+			}
+
+			packages = append(packages, models.Package{
+				PackageManager: "gem",
+				PackageName:    name,
+				Version:        version,
+				FilePath:       manifestFile,
+				Locations:      []models.Location{entryLocation(line, i)},
+				Metadata:       metadata,
+			})
+			current = &packages[len(packages)-1]
+			specKeys[fmt.Sprintf("%s (%s)", name, lockedVersion)] = len(packages) - 1
+
+		default:
+			// Source options, e.g. "  remote: https://rubygems.org/"
+			key, value, found := strings.Cut(strings.TrimPrefix(line, optionIndent), ":")
+			if found {
+				source.options[key] = strings.TrimSpace(value)
+			}
+		}
+	}
+
+	// The DEPENDENCIES section lists the gems of the Gemfile, all other gems are pulled in by them
+	if hasDependencies {
+		for i := range packages {
+			packages[i].Transitive = !direct[packages[i].PackageName]
+		}
+	}
+	return packages, nil
+}
@@ -0,0 +1,69 @@
+package ruby
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestRubyGemfileLockParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/Gemfile.lock"
+
+	packages, err := (&RubyGemfileLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "gem", PackageName: "rack-attack", Version: "6.7.0", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 4, EndIndex: 23}}},
+		{PackageManager: "gem", PackageName: "my_engine", Version: "0.1.0", FilePath: manifestFile, Locations: []models.Location{{Line: 11, StartIndex: 4, EndIndex: 21}}},
+		{PackageManager: "gem", PackageName: "actionpack", Version: "7.1.3", FilePath: manifestFile, Locations: []models.Location{{Line: 17, StartIndex: 4, EndIndex: 22}}},
+		{PackageManager: "gem", PackageName: "nokogiri", Version: "1.15.4", FilePath: manifestFile, Locations: []models.Location{{Line: 19, StartIndex: 4, EndIndex: 34}}},
+		{PackageManager: "gem", PackageName: "puma", Version: "6.4.0", FilePath: manifestFile, Locations: []models.Location{{Line: 21, StartIndex: 4, EndIndex: 16}}},
+		{PackageManager: "gem", PackageName: "racc", Version: "1.7.3", FilePath: manifestFile, Locations: []models.Location{{Line: 23, StartIndex: 4, EndIndex: 16}}},
+		{PackageManager: "gem", PackageName: "rack", Version: "3.0.8", FilePath: manifestFile, Locations: []models.Location{{Line: 24, StartIndex: 4, EndIndex: 16}}},
+		{PackageManager: "gem", PackageName: "rails", Version: "7.1.3", FilePath: manifestFile, Locations: []models.Location{{Line: 25, StartIndex: 4, EndIndex: 17}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	// Gems missing from the DEPENDENCIES section are pulled in by other gems
+	transitive := map[string]bool{"actionpack": true, "racc": true, "rack": true}
+	dependencies := map[string][]string{
+		"rack-attack": {"rack"},
+		"my_engine":   {"rails"},
+		"nokogiri":    {"racc"},
+		"rails":       {"actionpack"},
+		"racc":        nil,
+	}
+	for _, pkg := range packages {
+		if pkg.Transitive != transitive[pkg.PackageName] {
+			t.Errorf("%s Transitive: got %v, want %v", pkg.PackageName, pkg.Transitive, transitive[pkg.PackageName])
+		}
+		if want, ok := dependencies[pkg.PackageName]; ok && !reflect.DeepEqual(pkg.Dependencies, want) {
+			t.Errorf("%s Dependencies: got %v, want %v", pkg.PackageName, pkg.Dependencies, want)
+		}
+	}
+
+	wantMetadata := []map[string]string{
+		{"source": "git", "git": "https://github.com/rack/rack-attack.git", "rev": "3f6a8b8d4e1c2f9a7b6c5d4e3f2a1b0c9d8e7f6a", "branch": "main"},
+		{"source": "path", "path": "engines/my_engine"},
+		{"registry": "https://rubygems.org/"},
+		{"registry": "https://rubygems.org/", "platform": "x86_64-linux"},
+	}
+	for i, want := range wantMetadata {
+		if !reflect.DeepEqual(packages[i].Metadata, want) {
+			t.Errorf("%s Metadata: got %v, want %v", packages[i].PackageName, packages[i].Metadata, want)
+		}
+	}
+
+	wantHashes := []string{"sha256-Co0zq6C25djiT1bIPSPkD/3OHJp7yNBupqxugce9xuA="}
+	if !reflect.DeepEqual(packages[6].Hashes, wantHashes) {
+		t.Errorf("rack Hashes: got %v, want %v", packages[6].Hashes, wantHashes)
+	}
+}
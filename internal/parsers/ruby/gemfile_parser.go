@@ -0,0 +1,227 @@
+package ruby
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/rubyutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// RubyGemfileParser implements parsing of Bundler manifests (Gemfile and gems.rb)
+type RubyGemfileParser struct{}
+
+// Bundler file names
+const (
+	Gemfile     = "Gemfile"
+	GemfileLock = "Gemfile.lock"
+	GemsRb      = "gems.rb"
+	GemsLocked  = "gems.locked"
+)
+
+// defaultGroup is the group of gems declared outside of any group
+const defaultGroup = "default"
+
+// sourceKeys are the metadata keys describing a git or path source
+var sourceKeys = []string{"source", "git", "path", "branch", "tag", "ref"}
+
+// gemfileBlock is a block of a Gemfile, e.g. group :test do ... end, with the settings it applies to its gems
+type gemfileBlock struct {
+	groups    []string
+	platforms []string
+	metadata  map[string]string
+}
+
+// githubURL expands the github: shorthand of Bundler, e.g. "rails/rails"
+func githubURL(repository string) string {
+	if !strings.Contains(repository, "/") {
+		repository = repository + "/" + repository
+	}
+	return "https://github.com/" + repository + ".git"
+}
+
+// sourceMetadata describes the source options of a gem or block: git, github, path, source and the git refs
+func sourceMetadata(options map[string]string) map[string]string {
+	metadata := make(map[string]string)
+	literal := func(key string) string {
+		value, _ := rubyutil.ParseLiteral(options[key])
+		return value
+	}
+
+	switch {
+	case literal("git") != "":
+		metadata["source"] = "git"
+		metadata["git"] = literal("git")
+	case literal("github") != "":
+		metadata["source"] = "git"
+		metadata["git"] = githubURL(literal("github"))
+	case literal("path") != "":
+		metadata["source"] = "path"
+		metadata["path"] = literal("path")
+	}
+	if metadata["source"] == "git" {
+		for _, ref := range []string{"branch", "tag", "ref"} {
+			if value := literal(ref); value != "" {
+				metadata[ref] = value
+			}
+		}
+	}
+	if registry := literal("source"); registry != "" {
+		metadata["registry"] = registry
+	}
+	return metadata
+}
+
+// lockFileFor returns the lock file Bundler writes for a Gemfile
+func lockFileFor(manifestFile string) string {
+	if filepath.Base(manifestFile) == GemsRb {
+		return filepath.Join(filepath.Dir(manifestFile), GemsLocked)
+	}
+	return manifestFile + ".lock"
+}
+
+// loadLockedVersions reads the lock file of a Gemfile and maps gem names to their versions
+func loadLockedVersions(manifestFile string) map[string]string {
+	lockFile := lockFileFor(manifestFile)
+	if _, err := os.Stat(lockFile); err != nil {
+		return nil
+	}
+	packages, err := (&RubyGemfileLockParser{}).Parse(lockFile)
+	if err != nil {
+		return nil
+	}
+
+	locked := make(map[string]string)
+	for _, pkg := range packages {
+		locked[pkg.PackageName] = pkg.Version
+	}
+	return locked
+}
+
+// Parse implements the Parser interface for Gemfile files
+func (p *RubyGemfileParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	locked := loadLockedVersions(manifestFile)
+	var packages []models.Package
+	var blocks []gemfileBlock
+
+	for _, stmt := range rubyutil.ReadStatements(strings.Split(string(content), "\n")) {
+		if rubyutil.IsEnd(stmt.Text) {
+			if len(blocks) > 0 {
+				blocks = blocks[:len(blocks)-1]
+			}
+			continue
+		}
+		if rubyutil.OpensKeywordBlock(stmt.Text) {
+			blocks = append(blocks, gemfileBlock{})
+			continue
+		}
+
+		method, args, opensBlock := rubyutil.ParseCall(stmt.Text)
+		positional, options := rubyutil.SplitOptions(args)
+
+		if opensBlock {
+			block := gemfileBlock{}
+			switch method {
+			case "group":
+				for _, arg := range positional {
+					block.groups = append(block.groups, rubyutil.ParseList(arg)...)
+				}
+			case "platforms", "platform":
+				for _, arg := range positional {
+					block.platforms = append(block.platforms, rubyutil.ParseList(arg)...)
+				}
+			case "source", "git", "github", "path":
+				// The first argument of a source block is its location, e.g. git "https://..." do
+				if len(positional) > 0 {
+					options[method] = positional[0]
+				}
+				block.metadata = sourceMetadata(options)
+			}
+			blocks = append(blocks, block)
+			continue
+		}
+
+		if method != "gem" || len(positional) == 0 {
+			continue
+		}
+		name, ok := rubyutil.ParseLiteral(positional[0])
+		if !ok {
+			continue
+		}
+
+		// Settings of the enclosing blocks apply first, the options of the gem itself override them
+		var groups, platforms []string
+		metadata := make(map[string]string)
+		for _, block := range blocks {
+			groups = append(groups, block.groups...)
+			platforms = append(platforms, block.platforms...)
+			maps.Copy(metadata, block.metadata)
+		}
+		for _, key := range []string{"group", "groups"} {
+			groups = append(groups, rubyutil.ParseList(options[key])...)
+		}
+		for _, key := range []string{"platform", "platforms"} {
+			platforms = append(platforms, rubyutil.ParseList(options[key])...)
+		}
+		if gemSource := sourceMetadata(options); len(gemSource) > 0 {
+			if _, ok := gemSource["source"]; ok {
+				// A git or path source of the gem replaces the source of its block
+				for _, key := range sourceKeys {
+					delete(metadata, key)
+				}
+			}
+			maps.Copy(metadata, gemSource)
+		}
+		if len(platforms) > 0 {
+			metadata["platforms"] = strings.Join(unique(platforms), ",")
+		}
+		if len(groups) == 0 {
+			groups = []string{defaultGroup}
+		}
+		if len(metadata) == 0 {
+			metadata = nil
+		}
+
+		var requirements []string
+		for _, arg := range positional[1:] {
+			requirements = append(requirements, rubyutil.ParseList(arg)...)
+		}
+		version := rubyutil.ParseVersion(requirements)
+		if lockedVersion, ok := locked[name]; ok {
+			version = lockedVersion
+		}
+
+		packages = append(packages, models.Package{
+			PackageManager: "gem",
+			PackageName:    name,
+			Version:        version,
+			FilePath:       manifestFile,
+			Locations:      stmt.Locations,
+			Scopes:         unique(groups),
+			Metadata:       metadata,
+		})
+	}
+
+	return packages, nil
+}
+
+// unique removes repeated values, keeping the first occurrence of each
+func unique(values []string) []string {
+	seen := make(map[string]bool)
+	var result []string
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,102 @@
+package ruby
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestRubyGemfileParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/Gemfile"
+
+	packages, err := (&RubyGemfileParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Versions are taken from the Gemfile.lock next to the Gemfile
+	expectedPackages := []models.Package{
+		{PackageManager: "gem", PackageName: "rails", Version: "7.1.3", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 0, EndIndex: 23}}},
+		{PackageManager: "gem", PackageName: "puma", Version: "6.4.0", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 0, EndIndex: 19}}},
+		{PackageManager: "gem", PackageName: "pg", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 0, EndIndex: 27}}},
+		{PackageManager: "gem", PackageName: "bootsnap", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 7, StartIndex: 0, EndIndex: 30}}},
+		{PackageManager: "gem", PackageName: "nokogiri", Version: "1.15.4", FilePath: manifestFile, Locations: []models.Location{
+			{Line: 8, StartIndex: 0, EndIndex: 15},
+			{Line: 9, StartIndex: 4, EndIndex: 13},
+			{Line: 10, StartIndex: 4, EndIndex: 31},
+		}},
+		{PackageManager: "gem", PackageName: "rspec-rails", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 13, StartIndex: 2, EndIndex: 29}}},
+		{PackageManager: "gem", PackageName: "debug", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 14, StartIndex: 2, EndIndex: 41}}},
+		{PackageManager: "gem", PackageName: "capybara", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 18, StartIndex: 2, EndIndex: 32}}},
+		{PackageManager: "gem", PackageName: "activerecord-jdbc-adapter", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 22, StartIndex: 2, EndIndex: 33}}},
+		{PackageManager: "gem", PackageName: "rack-attack", Version: "6.7.0", FilePath: manifestFile, Locations: []models.Location{{Line: 25, StartIndex: 0, EndIndex: 81}}},
+		{PackageManager: "gem", PackageName: "devise", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 26, StartIndex: 0, EndIndex: 56}}},
+		{PackageManager: "gem", PackageName: "my_engine", Version: "0.1.0", FilePath: manifestFile, Locations: []models.Location{{Line: 27, StartIndex: 0, EndIndex: 42}}},
+		{PackageManager: "gem", PackageName: "private-gem", Version: "2.0.1", FilePath: manifestFile, Locations: []models.Location{{Line: 30, StartIndex: 2, EndIndex: 28}}},
+		{PackageManager: "gem", PackageName: "sidekiq", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 34, StartIndex: 2, EndIndex: 34}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	wantScopes := map[string][]string{
+		"rails":       {"default"},
+		"rspec-rails": {"development", "test"},
+		"debug":       {"development", "test"},
+		"capybara":    {"test", "system"},
+	}
+	wantMetadata := map[string]map[string]string{
+		"nokogiri":                  {"platforms": "mri,windows"},
+		"debug":                     {"platforms": "mri,windows"},
+		"activerecord-jdbc-adapter": {"platforms": "jruby"},
+		"rack-attack":               {"source": "git", "git": "https://github.com/rack/rack-attack.git", "branch": "main"},
+		"devise":                    {"source": "git", "git": "https://github.com/heartcombo/devise.git", "tag": "v4.9.3"},
+		"my_engine":                 {"source": "path", "path": "engines/my_engine"},
+		"private-gem":               {"registry": "https://gems.example.com"},
+	}
+	for _, pkg := range packages {
+		if want, ok := wantScopes[pkg.PackageName]; ok && !reflect.DeepEqual(pkg.Scopes, want) {
+			t.Errorf("%s Scopes: got %v, want %v", pkg.PackageName, pkg.Scopes, want)
+		}
+		if want := wantMetadata[pkg.PackageName]; !reflect.DeepEqual(pkg.Metadata, want) && (len(want) > 0 || len(pkg.Metadata) > 0) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, want)
+		}
+	}
+}
+
+func TestRubyGemfileParser_GemsRbWithoutLock(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "gems.rb")
+	content := `source "https://rubygems.org"
+
+gem("rack", "= 3.0.8")
+gem "sinatra", "~> 4.0", :require => "sinatra/base"
+group(:test) do
+  gem "minitest", "5.20.0"
+end
+`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write gems.rb: %v", err)
+	}
+
+	packages, err := (&RubyGemfileParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "gem", PackageName: "rack", Version: "3.0.8", FilePath: manifestFile, Locations: []models.Location{{Line: 2, StartIndex: 0, EndIndex: 22}}},
+		{PackageManager: "gem", PackageName: "sinatra", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 3, StartIndex: 0, EndIndex: 51}}},
+		{PackageManager: "gem", PackageName: "minitest", Version: "5.20.0", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 2, EndIndex: 26}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) == 3 && !reflect.DeepEqual(packages[2].Scopes, []string{"test"}) {
+		t.Errorf("minitest Scopes: got %v, want [test]", packages[2].Scopes)
+	}
+}
@@ -0,0 +1,61 @@
+package ruby
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/rubyutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// RubyGemspecParser implements parsing of gem specifications (*.gemspec)
+type RubyGemspecParser struct{}
+
+// GemspecExtension is the extension of gem specifications
+const GemspecExtension = ".gemspec"
+
+// dependencyScopes maps the dependency methods of Gem::Specification to the scope of their gems
+var dependencyScopes = map[string]string{
+	"add_dependency":             "runtime",
+	"add_runtime_dependency":     "runtime",
+	"add_development_dependency": "development",
+}
+
+// Parse implements the Parser interface for gemspec files
+func (p *RubyGemspecParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	var packages []models.Package
+	for _, stmt := range rubyutil.ReadStatements(strings.Split(string(content), "\n")) {
+		method, args, _ := rubyutil.ParseCall(stmt.Text)
+		scope, ok := dependencyScopes[method]
+		if !ok || len(args) == 0 {
+			continue
+		}
+
+		// spec.add_dependency "rails", ">= 6.1", "< 8" or the generated form s.add_dependency(%q<rails>.freeze, [">= 6.1"])
+		name, ok := rubyutil.ParseLiteral(args[0])
+		if !ok {
+			continue
+		}
+		var requirements []string
+		for _, arg := range args[1:] {
+			requirements = append(requirements, rubyutil.ParseList(arg)...)
+		}
+
+		packages = append(packages, models.Package{
+			PackageManager: "gem",
+			PackageName:    name,
+			Version:        rubyutil.ParseVersion(requirements),
+			FilePath:       manifestFile,
+			Locations:      stmt.Locations,
+			Scopes:         []string{scope},
+		})
+	}
+
+	return packages, nil
+}
@@ -0,0 +1,37 @@
+package ruby
+
+import (
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestRubyGemspecParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/example.gemspec"
+
+	packages, err := (&RubyGemspecParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "gem", PackageName: "activesupport", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 2, EndIndex: 54}}},
+		{PackageManager: "gem", PackageName: "concurrent-ruby", Version: "1.2.2", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 2, EndIndex: 56}}},
+		{PackageManager: "gem", PackageName: "rake", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 2, EndIndex: 52}}},
+		{PackageManager: "gem", PackageName: "rubocop", Version: "1.57.2", FilePath: manifestFile, Locations: []models.Location{
+			{Line: 7, StartIndex: 2, EndIndex: 44},
+			{Line: 8, StartIndex: 34, EndIndex: 44},
+		}},
+		{PackageManager: "gem", PackageName: "zeitwerk", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 9, StartIndex: 2, EndIndex: 53}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+
+	wantScopes := []string{"runtime", "runtime", "development", "development", "runtime"}
+	for i, pkg := range packages {
+		if i < len(wantScopes) && (len(pkg.Scopes) != 1 || pkg.Scopes[0] != wantScopes[i]) {
+			t.Errorf("%s Scopes: got %v, want [%s]", pkg.PackageName, pkg.Scopes, wantScopes[i])
+		}
+	}
+}
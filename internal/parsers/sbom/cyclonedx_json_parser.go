@@ -0,0 +1,146 @@
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/jsonutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// CycloneDXJsonParser implements parsing of CycloneDX BOMs in JSON (bom.json, *.cdx.json)
+type CycloneDXJsonParser struct{}
+
+// cycloneDXFormat is the bomFormat of CycloneDX JSON documents
+const cycloneDXFormat = "CycloneDX"
+
+// cdxComponent represents a component of a CycloneDX JSON BOM, which may nest further components
+type cdxComponent struct {
+	BomRef  string `json:"bom-ref"`
+	Version string `json:"version"`
+	Purl    string `json:"purl"`
+	Scope   string `json:"scope"`
+	Hashes  []struct {
+		Alg     string `json:"alg"`
+		Content string `json:"content"`
+	} `json:"hashes"`
+	Evidence *struct {
+		Occurrences []struct {
+			Location string `json:"location"`
+			Line     int    `json:"line"`
+			Offset   int    `json:"offset"`
+			Symbol   string `json:"symbol"`
+		} `json:"occurrences"`
+	} `json:"evidence"`
+	Components []cdxComponent `json:"components"`
+}
+
+// cdxBOM represents the CycloneDX JSON structure
+type cdxBOM struct {
+	BomFormat string `json:"bomFormat"`
+	Metadata  struct {
+		Component *cdxComponent `json:"component"`
+	} `json:"metadata"`
+	Components   []cdxComponent `json:"components"`
+	Dependencies []struct {
+		Ref       string   `json:"ref"`
+		DependsOn []string `json:"dependsOn"`
+	} `json:"dependencies"`
+}
+
+// componentRef identifies a component by its bom-ref, or by its package URL if it has none
+func componentRef(bomRef, purl string) string {
+	if bomRef != "" {
+		return bomRef
+	}
+	return purl
+}
+
+// componentScopes returns the scope of a component: required, optional or excluded
+func componentScopes(scope string) []string {
+	if scope == "" {
+		return nil
+	}
+	return []string{scope}
+}
+
+// newOccurrence locates evidence of a component. Lines and offsets are 1-based; older BOMs append the line to the
+// location instead, e.g. src/index.js#12. The location spans the symbol that was found, if it is recorded.
+// Without a line only the file is known, so the location keeps just its FilePath.
+func newOccurrence(location string, line, offset int, symbol string) occurrence {
+	if file, fragment, ok := strings.Cut(location, "#"); ok && line == 0 {
+		if number, err := strconv.Atoi(fragment); err == nil {
+			location, line = file, number
+		}
+	}
+	found := occurrence{file: location}
+	if line > 0 {
+		found.location.Line = line - 1
+		if offset > 0 {
+			found.location.StartIndex = offset - 1
+			found.location.EndIndex = found.location.StartIndex + len(symbol)
+		}
+	}
+	return found
+}
+
+// readComponents flattens nested components in document order and locates each by its purl and version members
+func readComponents(list []cdxComponent, path []string, locations jsonutil.Locations) []component {
+	var components []component
+	for i, c := range list {
+		itemPath := append(append([]string(nil), path...), strconv.Itoa(i))
+
+		read := component{ref: componentRef(c.BomRef, c.Purl), purl: c.Purl, version: c.Version, scopes: componentScopes(c.Scope)}
+		for _, key := range []string{"purl", "version"} {
+			if location, ok := locations.Find(append(itemPath, key)...); ok {
+				read.locations = append(read.locations, location)
+			}
+		}
+		for _, hash := range c.Hashes {
+			read.checksums = append(read.checksums, checksum{algorithm: hash.Alg, value: hash.Content})
+		}
+		if c.Evidence != nil {
+			for _, found := range c.Evidence.Occurrences {
+				read.occurrences = append(read.occurrences, newOccurrence(found.Location, found.Line, found.Offset, found.Symbol))
+			}
+		}
+		components = append(components, read)
+		components = append(components, readComponents(c.Components, append(itemPath, "components"), locations)...)
+	}
+	return components
+}
+
+// Parse implements the Parser interface for CycloneDX JSON files
+func (p *CycloneDXJsonParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	var bom cdxBOM
+	if err := json.Unmarshal(content, &bom); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	if bom.BomFormat != cycloneDXFormat {
+		return nil, fmt.Errorf("unsupported BOM format %q", bom.BomFormat)
+	}
+	locations, err := jsonutil.FindLocations(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var graph dependencyGraph
+	if root := bom.Metadata.Component; root != nil {
+		graph.roots = []string{componentRef(root.BomRef, root.Purl)}
+	}
+	for _, dependency := range bom.Dependencies {
+		for _, ref := range dependency.DependsOn {
+			graph.addDependency(dependency.Ref, ref)
+		}
+	}
+
+	return buildPackages(manifestFile, readComponents(bom.Components, []string{"components"}, locations), graph), nil
+}
@@ -0,0 +1,122 @@
+package sbom
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestCycloneDXJsonParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/bom.cdx.json"
+
+	packages, err := (&CycloneDXJsonParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "npm", PackageName: "express", Version: "4.18.2", FilePath: manifestFile, Locations: []models.Location{{Line: 31, StartIndex: 6, EndIndex: 39}, {Line: 19, StartIndex: 6, EndIndex: 26}, {Line: 2, StartIndex: 16, EndIndex: 23, FilePath: "src/server.js"}, {Line: 11, StartIndex: 0, EndIndex: 0, FilePath: "src/app.js"}}},
+		{PackageManager: "npm", PackageName: "body-parser", Version: "1.20.1", FilePath: manifestFile, Locations: []models.Location{{Line: 51, StartIndex: 6, EndIndex: 42}, {Line: 50, StartIndex: 6, EndIndex: 26}}},
+		{PackageManager: "npm", PackageName: "@angular/core", Version: "16.2.0", FilePath: manifestFile, Locations: []models.Location{{Line: 60, StartIndex: 6, EndIndex: 46}, {Line: 58, StartIndex: 6, EndIndex: 26}}},
+		{PackageManager: "mvn", PackageName: "com.google.guava:guava", Version: "32.1.3-jre", FilePath: manifestFile, Locations: []models.Location{{Line: 68, StartIndex: 6, EndIndex: 69}, {Line: 67, StartIndex: 6, EndIndex: 30}}},
+		{PackageManager: "mvn", PackageName: "com.google.guava:failureaccess", Version: "1.0.1", FilePath: manifestFile, Locations: []models.Location{{Line: 76, StartIndex: 10, EndIndex: 66}, {Line: 75, StartIndex: 10, EndIndex: 29}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	// The application the BOM describes is not a package, components it does not depend on are transitive
+	for i, want := range []bool{false, true, false, false, true} {
+		if packages[i].Transitive != want {
+			t.Errorf("%s Transitive: got %v, want %v", packages[i].PackageName, packages[i].Transitive, want)
+		}
+	}
+
+	expectedDependencies := [][]string{{"body-parser"}, nil, nil, {"com.google.guava:failureaccess"}, nil}
+	for i, want := range expectedDependencies {
+		if !reflect.DeepEqual(packages[i].Dependencies, want) {
+			t.Errorf("%s Dependencies: got %v, want %v", packages[i].PackageName, packages[i].Dependencies, want)
+		}
+	}
+
+	if want := []string{"required"}; !reflect.DeepEqual(packages[0].Scopes, want) {
+		t.Errorf("express Scopes: got %v, want %v", packages[0].Scopes, want)
+	}
+	if want := []string{"sha512-6xtPQCUSpt8+nbktNn34Ey7lOwCEK+Q1S53JHtAG9g3kpuO8jXYUWIKQ/zqxGFK7UF/qL+1Lx5kPZRuA+SGJkw=="}; !reflect.DeepEqual(packages[0].Hashes, want) {
+		t.Errorf("express Hashes: got %v, want %v", packages[0].Hashes, want)
+	}
+	if packages[0].Metadata != nil {
+		t.Errorf("express Metadata: got %v, want nil", packages[0].Metadata)
+	}
+}
+
+func TestCycloneDXJsonParser_Parse_UnsupportedFormat(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "bom.json")
+	if err := os.WriteFile(manifestFile, []byte(`{"spdxVersion": "SPDX-2.3", "packages": []}`), 0644); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+
+	if _, err := (&CycloneDXJsonParser{}).Parse(manifestFile); err == nil {
+		t.Errorf("Expected an error for a document that is not a CycloneDX BOM")
+	}
+}
+
+func TestCycloneDXJsonParser_OccurrenceWithoutLine(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "bom.json")
+	content := `{
+  "bomFormat": "CycloneDX",
+  "specVersion": "1.6",
+  "components": [
+    {
+      "type": "library",
+      "name": "lodash",
+      "version": "4.17.21",
+      "purl": "pkg:npm/lodash@4.17.21",
+      "evidence": { "occurrences": [ { "location": "src/util.js", "offset": 10, "symbol": "lodash" } ] }
+    }
+  ]
+}`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+
+	packages, err := (&CycloneDXJsonParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(packages) != 1 {
+		t.Fatalf("Expected 1 package, got %d", len(packages))
+	}
+
+	// Without a line the occurrence only names the file
+	locations := packages[0].Locations
+	if want := (models.Location{FilePath: "src/util.js"}); len(locations) == 0 || locations[len(locations)-1] != want {
+		t.Errorf("lodash Locations: got %+v, want the last one to be %+v", locations, want)
+	}
+}
+
+func TestNewOccurrence(t *testing.T) {
+	tests := []struct {
+		location string
+		line     int
+		offset   int
+		symbol   string
+		want     occurrence
+	}{
+		{"src/server.js", 3, 17, "express", occurrence{file: "src/server.js", location: models.Location{Line: 2, StartIndex: 16, EndIndex: 23}}},
+		{"src/app.js#12", 0, 0, "", occurrence{file: "src/app.js", location: models.Location{Line: 11}}},
+		{"docs/page.html#intro", 0, 0, "", occurrence{file: "docs/page.html#intro"}},
+		{"src/util.js", 0, 5, "lodash", occurrence{file: "src/util.js"}},
+	}
+	for _, tt := range tests {
+		if got := newOccurrence(tt.location, tt.line, tt.offset, tt.symbol); got != tt.want {
+			t.Errorf("newOccurrence(%q, %d, %d, %q) = %+v; want %+v", tt.location, tt.line, tt.offset, tt.symbol, got, tt.want)
+		}
+	}
+}
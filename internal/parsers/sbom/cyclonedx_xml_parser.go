@@ -0,0 +1,202 @@
+package sbom
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// CycloneDXXmlParser implements parsing of CycloneDX BOMs in XML (bom.xml, *.cdx.xml)
+type CycloneDXXmlParser struct{}
+
+// cycloneDXNamespace is the prefix of the namespaces of the CycloneDX XML schemas, e.g. http://cyclonedx.org/schema/bom/1.6
+const cycloneDXNamespace = "http://cyclonedx.org/schema/bom/"
+
+// xmlElement is an element of an XML document with the byte offsets of its start and end
+type xmlElement struct {
+	name     string
+	space    string
+	attrs    map[string]string
+	text     string
+	children []*xmlElement
+	start    int
+	end      int
+}
+
+// child returns the first child element with a local name, or nil
+func (e *xmlElement) child(name string) *xmlElement {
+	if e == nil {
+		return nil
+	}
+	for _, child := range e.children {
+		if child.name == name {
+			return child
+		}
+	}
+	return nil
+}
+
+// childText returns the trimmed text of a child element, or "" if there is no such child
+func (e *xmlElement) childText(name string) string {
+	if child := e.child(name); child != nil {
+		return strings.TrimSpace(child.text)
+	}
+	return ""
+}
+
+// childrenNamed returns the child elements with a local name
+func (e *xmlElement) childrenNamed(name string) []*xmlElement {
+	if e == nil {
+		return nil
+	}
+	var children []*xmlElement
+	for _, child := range e.children {
+		if child.name == name {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// parseXML reads an XML document into a tree of elements and returns its root element
+func parseXML(content []byte) (*xmlElement, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	var root *xmlElement
+	var stack []*xmlElement
+	for {
+		offset := int(decoder.InputOffset())
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse XML: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			element := &xmlElement{name: t.Name.Local, space: t.Name.Space, attrs: make(map[string]string), start: offset, end: int(decoder.InputOffset())}
+			for _, attr := range t.Attr {
+				element.attrs[attr.Name.Local] = attr.Value
+			}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, element)
+			} else if root == nil {
+				root = element
+			}
+			stack = append(stack, element)
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text += string(t)
+			}
+		case xml.EndElement:
+			if len(stack) > 0 {
+				stack[len(stack)-1].end = int(decoder.InputOffset())
+				stack = stack[:len(stack)-1]
+			}
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("failed to parse XML: no root element")
+	}
+	return root, nil
+}
+
+// offsetLocator converts byte offsets of a document into locations
+type offsetLocator struct {
+	lineStarts []int
+}
+
+// newOffsetLocator indexes the starts of the lines of a document
+func newOffsetLocator(content []byte) offsetLocator {
+	starts := []int{0}
+	for i, b := range content {
+		if b == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+	return offsetLocator{lineStarts: starts}
+}
+
+// locate returns the location of an element from its start tag to its end tag. Elements that span lines end
+// with the line they start on.
+func (l offsetLocator) locate(element *xmlElement) models.Location {
+	line := 0
+	for line+1 < len(l.lineStarts) && l.lineStarts[line+1] <= element.start {
+		line++
+	}
+	location := models.Location{Line: line, StartIndex: element.start - l.lineStarts[line], EndIndex: element.end - l.lineStarts[line]}
+	if line+1 < len(l.lineStarts) && element.end >= l.lineStarts[line+1] {
+		location.EndIndex = l.lineStarts[line+1] - 1 - l.lineStarts[line]
+	}
+	return location
+}
+
+// attributeOrChild returns an attribute of an element, or the text of a child element of that name
+func attributeOrChild(element *xmlElement, name string) string {
+	if value, ok := element.attrs[name]; ok {
+		return value
+	}
+	return element.childText(name)
+}
+
+// readXMLComponents flattens nested components in document order and locates each by its purl and version elements
+func readXMLComponents(parent *xmlElement, locator offsetLocator) []component {
+	var components []component
+	for _, c := range parent.childrenNamed("component") {
+		purl := c.childText("purl")
+		read := component{ref: componentRef(c.attrs["bom-ref"], purl), purl: purl, version: c.childText("version"), scopes: componentScopes(c.childText("scope"))}
+		for _, key := range []string{"purl", "version"} {
+			if element := c.child(key); element != nil {
+				read.locations = append(read.locations, locator.locate(element))
+			}
+		}
+		for _, hash := range c.child("hashes").childrenNamed("hash") {
+			read.checksums = append(read.checksums, checksum{algorithm: hash.attrs["alg"], value: strings.TrimSpace(hash.text)})
+		}
+		for _, found := range c.child("evidence").child("occurrences").childrenNamed("occurrence") {
+			line, _ := strconv.Atoi(attributeOrChild(found, "line"))
+			offset, _ := strconv.Atoi(attributeOrChild(found, "offset"))
+			read.occurrences = append(read.occurrences, newOccurrence(attributeOrChild(found, "location"), line, offset, attributeOrChild(found, "symbol")))
+		}
+		components = append(components, read)
+		components = append(components, readXMLComponents(c.child("components"), locator)...)
+	}
+	return components
+}
+
+// Parse implements the Parser interface for CycloneDX XML files
+func (p *CycloneDXXmlParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	bom, err := parseXML(content)
+	if err != nil {
+		return nil, err
+	}
+	if bom.name != "bom" || !strings.HasPrefix(bom.space, cycloneDXNamespace) {
+		return nil, fmt.Errorf("unsupported BOM format %q", strings.TrimSpace(bom.space+" "+bom.name))
+	}
+
+	var graph dependencyGraph
+	if root := bom.child("metadata").child("component"); root != nil {
+		graph.roots = []string{componentRef(root.attrs["bom-ref"], root.childText("purl"))}
+	}
+	for _, dependency := range bom.child("dependencies").childrenNamed("dependency") {
+		for _, dependsOn := range dependency.childrenNamed("dependency") {
+			graph.addDependency(dependency.attrs["ref"], dependsOn.attrs["ref"])
+		}
+	}
+
+	return buildPackages(manifestFile, readXMLComponents(bom.child("components"), newOffsetLocator(content)), graph), nil
+}
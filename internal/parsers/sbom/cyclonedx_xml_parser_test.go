@@ -0,0 +1,94 @@
+package sbom
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestCycloneDXXmlParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/bom.cdx.xml"
+
+	packages, err := (&CycloneDXXmlParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "pypi", PackageName: "requests", Version: "2.31.0", FilePath: manifestFile, Locations: []models.Location{{Line: 15, StartIndex: 6, EndIndex: 43}, {Line: 11, StartIndex: 6, EndIndex: 31}, {Line: 0, StartIndex: 7, EndIndex: 15, FilePath: "app/client.py"}}},
+		{PackageManager: "pypi", PackageName: "urllib3", Version: "2.0.7", FilePath: manifestFile, Locations: []models.Location{{Line: 30, StartIndex: 6, EndIndex: 41}, {Line: 29, StartIndex: 6, EndIndex: 30}}},
+		{PackageManager: "docker", PackageName: "python", Version: "3.12-slim", FilePath: manifestFile, Locations: []models.Location{{Line: 35, StartIndex: 6, EndIndex: 79}, {Line: 34, StartIndex: 6, EndIndex: 34}}},
+		{PackageManager: "deb", PackageName: "debian/openssl", Version: "3.0.11-1", FilePath: manifestFile, Locations: []models.Location{{Line: 39, StartIndex: 10, EndIndex: 65}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	for i, want := range []bool{false, true, false, true} {
+		if packages[i].Transitive != want {
+			t.Errorf("%s Transitive: got %v, want %v", packages[i].PackageName, packages[i].Transitive, want)
+		}
+	}
+	if want := []string{"urllib3"}; !reflect.DeepEqual(packages[0].Dependencies, want) {
+		t.Errorf("requests Dependencies: got %v, want %v", packages[0].Dependencies, want)
+	}
+	if want := []string{"sha256-7HJCDfXfvc5BEfcVyWM43zt8t19Y5HjSRJyXIOVg3ow="}; !reflect.DeepEqual(packages[0].Hashes, want) {
+		t.Errorf("requests Hashes: got %v, want %v", packages[0].Hashes, want)
+	}
+	if packages[0].Metadata != nil {
+		t.Errorf("requests Metadata: got %v, want nil", packages[0].Metadata)
+	}
+}
+
+func TestCycloneDXXmlParser_OccurrenceWithoutLine(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "bom.xml")
+	content := `<?xml version="1.0" encoding="UTF-8"?>
+<bom xmlns="http://cyclonedx.org/schema/bom/1.6" version="1">
+  <components>
+    <component type="library" bom-ref="pkg:pypi/flask@3.0.0">
+      <name>flask</name>
+      <version>3.0.0</version>
+      <purl>pkg:pypi/flask@3.0.0</purl>
+      <evidence>
+        <occurrences>
+          <occurrence location="app/server.py" offset="6" symbol="flask"/>
+        </occurrences>
+      </evidence>
+    </component>
+  </components>
+</bom>`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+
+	packages, err := (&CycloneDXXmlParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(packages) != 1 {
+		t.Fatalf("Expected 1 package, got %d", len(packages))
+	}
+
+	// Without a line the occurrence only names the file
+	locations := packages[0].Locations
+	if want := (models.Location{FilePath: "app/server.py"}); len(locations) == 0 || locations[len(locations)-1] != want {
+		t.Errorf("flask Locations: got %+v, want the last one to be %+v", locations, want)
+	}
+}
+
+func TestCycloneDXXmlParser_Parse_UnsupportedFormat(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "bom.xml")
+	if err := os.WriteFile(manifestFile, []byte(`<project xmlns="http://maven.apache.org/POM/4.0.0"></project>`), 0644); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+
+	if _, err := (&CycloneDXXmlParser{}).Parse(manifestFile); err == nil {
+		t.Errorf("Expected an error for a document that is not a CycloneDX BOM")
+	}
+}
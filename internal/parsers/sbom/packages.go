@@ -0,0 +1,158 @@
+package sbom
+
+import (
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// sriAlgorithms maps the hash algorithms of CycloneDX (SHA-256) and SPDX (SHA256) to their subresource integrity prefix
+var sriAlgorithms = map[string]string{
+	"SHA1":   "sha1",
+	"SHA256": "sha256",
+	"SHA384": "sha384",
+	"SHA512": "sha512",
+}
+
+// checksum is a hash of a component as recorded in a BOM, e.g. SHA-256 with its hex encoded content
+type checksum struct {
+	algorithm string
+	value     string
+}
+
+// sriHashes converts hex encoded checksums into their subresource integrity form; other algorithms, e.g. MD5, are dropped
+func sriHashes(checksums []checksum) []string {
+	var hashes []string
+	for _, sum := range checksums {
+		prefix, ok := sriAlgorithms[strings.ToUpper(strings.ReplaceAll(sum.algorithm, "-", ""))]
+		if !ok {
+			continue
+		}
+		hashes = append(hashes, pkgutil.HexSRIHash(prefix, sum.value)...)
+	}
+	return hashes
+}
+
+// component is a component of a CycloneDX BOM or a package of an SPDX document, identified by its reference,
+// the bom-ref or the SPDXID
+type component struct {
+	ref       string
+	purl      string
+	version   string
+	scopes    []string
+	checksums []checksum
+	locations []models.Location
+	// occurrences are the files a component was found in, each with the location it was found at
+	occurrences []occurrence
+}
+
+// occurrence is evidence of where a component was found, e.g. a line of a file that imports it
+type occurrence struct {
+	file     string
+	location models.Location
+}
+
+// dependencyGraph holds the components that the components of a BOM depend on, and the roots the BOM describes
+type dependencyGraph struct {
+	roots        []string
+	dependencies map[string][]string
+}
+
+// addDependency records that a component depends on another
+func (g *dependencyGraph) addDependency(ref, dependency string) {
+	if g.dependencies == nil {
+		g.dependencies = make(map[string][]string)
+	}
+	g.dependencies[ref] = append(g.dependencies[ref], dependency)
+}
+
+// direct collects the components the roots depend on, or returns nil if the graph does not record them
+func (g *dependencyGraph) direct() map[string]bool {
+	direct := make(map[string]bool)
+	for _, root := range g.roots {
+		for _, dependency := range g.dependencies[root] {
+			direct[dependency] = true
+		}
+	}
+	if len(direct) == 0 {
+		return nil
+	}
+	return direct
+}
+
+// buildPackages converts components into packages by their package URLs. The roots a BOM describes, e.g. the
+// application itself, are not packages; components that the roots do not depend on directly are transitive.
+// Evidence occurrences follow the location of a component in the BOM, located in the files they were found in.
+func buildPackages(manifestFile string, components []component, graph dependencyGraph) []models.Package {
+	roots := make(map[string]bool)
+	for _, root := range graph.roots {
+		roots[root] = true
+	}
+
+	purls := make(map[string]packageURL)
+	for _, c := range components {
+		if c.purl == "" {
+			continue
+		}
+		if purl, ok := parsePackageURL(c.purl); ok {
+			purls[c.ref] = purl
+		} else {
+			log.Printf("Skipping component %q in %s: invalid package URL %q", c.ref, manifestFile, c.purl)
+		}
+	}
+
+	direct := graph.direct()
+
+	var packages []models.Package
+	for _, c := range components {
+		purl, ok := purls[c.ref]
+		if !ok || roots[c.ref] {
+			continue
+		}
+
+		version := purl.version
+		if version == "" {
+			version = c.version
+		}
+		if version == "" {
+			version = "latest"
+		}
+
+		seen := make(map[string]bool)
+		var dependencies []string
+		for _, ref := range graph.dependencies[c.ref] {
+			if dependency, ok := purls[ref]; ok && !seen[dependency.packageName()] {
+				seen[dependency.packageName()] = true
+				dependencies = append(dependencies, dependency.packageName())
+			}
+		}
+		sort.Strings(dependencies)
+
+		locations := append([]models.Location(nil), c.locations...)
+		for _, found := range c.occurrences {
+			found.location.FilePath = found.file
+			locations = append(locations, found.location)
+		}
+		metadata := purl.metadata()
+		if len(metadata) == 0 {
+			metadata = nil
+		}
+
+		packages = append(packages, models.Package{
+			PackageManager: purl.packageManager(),
+			PackageName:    purl.packageName(),
+			Version:        version,
+			FilePath:       manifestFile,
+			Locations:      locations,
+			Scopes:         c.scopes,
+			Transitive:     direct != nil && !direct[c.ref],
+			Hashes:         sriHashes(c.checksums),
+			Dependencies:   dependencies,
+			Metadata:       metadata,
+		})
+	}
+	return packages
+}
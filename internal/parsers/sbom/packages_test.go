@@ -0,0 +1,41 @@
+package sbom
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestSriHashes(t *testing.T) {
+	checksums := []checksum{
+		{algorithm: "SHA-256", value: "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"},
+		{algorithm: "SHA1", value: "0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33"},
+		{algorithm: "MD5", value: "acbd18db4cc2f85cedef654fccc4a4d8"},
+		{algorithm: "SHA-512", value: "not hex"},
+	}
+	want := []string{"sha256-LCa0a2j/xo/5m0U8HTBBNBNCLXBkg7+g+YpeiGJm564=", "sha1-C+7Hteo/D9vJXQ3UfzxbwnXaijM="}
+	if got := sriHashes(checksums); !reflect.DeepEqual(got, want) {
+		t.Errorf("sriHashes() = %v; want %v", got, want)
+	}
+}
+
+func TestBuildPackages_WithoutGraph(t *testing.T) {
+	components := []component{
+		{ref: "a", purl: "pkg:cargo/serde@1.0.193"},
+		{ref: "b", purl: "pkg:cargo/serde_json", version: "1.0.108"},
+		{ref: "c", purl: "pkg:cargo/rand"},
+		{ref: "d", purl: "not a purl"},
+	}
+
+	packages := buildPackages("bom.json", components, dependencyGraph{})
+
+	expected := []models.Package{
+		{PackageManager: "cargo", PackageName: "serde", Version: "1.0.193", FilePath: "bom.json"},
+		{PackageManager: "cargo", PackageName: "serde_json", Version: "1.0.108", FilePath: "bom.json"},
+		{PackageManager: "cargo", PackageName: "rand", Version: "latest", FilePath: "bom.json"},
+	}
+	if !reflect.DeepEqual(packages, expected) {
+		t.Errorf("buildPackages() = %+v; want %+v", packages, expected)
+	}
+}
@@ -0,0 +1,151 @@
+// Package sbom reads software bills of materials, CycloneDX and SPDX documents, as manifests.
+// Components and packages are identified by their package URLs (purl), which map them to the package managers
+// of the other parsers.
+package sbom
+
+import (
+	"net/url"
+	"strings"
+)
+
+// packageURL is a parsed package URL, pkg:type/namespace/name@version?qualifiers#subpath
+type packageURL struct {
+	kind       string
+	namespace  string
+	name       string
+	version    string
+	qualifiers map[string]string
+	subpath    string
+}
+
+// parsePackageURL parses a package URL. Its components are percent-decoded, e.g. pkg:npm/%40angular/core@16.2.0.
+func parsePackageURL(purl string) (packageURL, bool) {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(purl), "pkg:")
+	if !ok {
+		return packageURL{}, false
+	}
+	rest = strings.TrimLeft(rest, "/")
+
+	var p packageURL
+	rest, p.subpath, _ = strings.Cut(rest, "#")
+	rest, query, _ := strings.Cut(rest, "?")
+	if query != "" {
+		p.qualifiers = make(map[string]string)
+		for _, pair := range strings.Split(query, "&") {
+			key, value, _ := strings.Cut(pair, "=")
+			if decoded, err := url.PathUnescape(value); err == nil && value != "" {
+				p.qualifiers[strings.ToLower(key)] = decoded
+			}
+		}
+	}
+
+	// The version follows the last @ of the name, which may be an unencoded npm scope, e.g. pkg:npm/@angular/core@16.2.0
+	if slash := strings.LastIndex(rest, "/"); slash >= 0 {
+		if at := strings.LastIndex(rest[slash:], "@"); at >= 0 {
+			p.version, rest = rest[slash+at+1:], rest[:slash+at]
+		}
+	}
+
+	segments := strings.Split(strings.Trim(rest, "/"), "/")
+	if len(segments) < 2 {
+		return packageURL{}, false
+	}
+	for i, segment := range segments {
+		decoded, err := url.PathUnescape(segment)
+		if err != nil {
+			return packageURL{}, false
+		}
+		segments[i] = decoded
+	}
+	if version, err := url.PathUnescape(p.version); err == nil {
+		p.version = version
+	}
+
+	p.kind = strings.ToLower(segments[0])
+	p.name = segments[len(segments)-1]
+	p.namespace = strings.Join(segments[1:len(segments)-1], "/")
+	return p, p.kind != "" && p.name != ""
+}
+
+// purlTypes maps purl types to the package manager names of the other parsers and the separator
+// between the namespace and the name of their packages
+var purlTypes = map[string]struct {
+	packageManager string
+	separator      string
+}{
+	"bazel":         {"bazel", "/"},
+	"cargo":         {"cargo", "/"},
+	"cocoapods":     {"cocoapods", "/"},
+	"composer":      {"composer", "/"},
+	"conan":         {"conan", "/"},
+	"conda":         {"conda", "/"},
+	"docker":        {"docker", "/"},
+	"gem":           {"gem", "/"},
+	"github":        {"github", "/"},
+	"githubactions": {"github-actions", "/"},
+	"golang":        {"go", "/"},
+	"hex":           {"hex", "/"},
+	"maven":         {"mvn", ":"},
+	"npm":           {"npm", "/"},
+	"nuget":         {"nuget", "/"},
+	"pub":           {"pub", "/"},
+	"pypi":          {"pypi", "/"},
+	"swift":         {"swift", "/"},
+}
+
+// packageManager returns the package manager of a package URL; unknown types are used as they are
+func (p packageURL) packageManager() string {
+	if known, ok := purlTypes[p.kind]; ok {
+		return known.packageManager
+	}
+	return p.kind
+}
+
+// packageName returns the name of a package the way its package manager names it, e.g. group:artifact for Maven
+func (p packageURL) packageName() string {
+	switch p.kind {
+	case "docker":
+		// Images of Docker Hub are named without their library namespace, images of other registries with their host
+		name := p.name
+		if p.namespace != "" && p.namespace != "library" {
+			name = p.namespace + "/" + name
+		}
+		if registry := p.qualifiers["repository_url"]; registry != "" && registry != "docker.io" && registry != "index.docker.io" {
+			name = strings.TrimSuffix(registry, "/") + "/" + name
+		}
+		return name
+	case "hex":
+		// The namespace of hex packages is their organization
+		return p.name
+	}
+
+	if p.namespace == "" {
+		return p.name
+	}
+	separator := "/"
+	if known, ok := purlTypes[p.kind]; ok {
+		separator = known.separator
+	}
+	return p.namespace + separator + p.name
+}
+
+// metadata describes where a package is fetched from and which of its artifacts is meant
+func (p packageURL) metadata() map[string]string {
+	metadata := make(map[string]string)
+	if registry := p.qualifiers["repository_url"]; registry != "" && p.kind != "docker" {
+		metadata["registry"] = registry
+	}
+	if vcs := p.qualifiers["vcs_url"]; vcs != "" {
+		metadata["source"] = "git"
+		metadata["git"] = strings.TrimPrefix(vcs, "git+")
+	}
+	if p.kind == "maven" {
+		if classifier := p.qualifiers["classifier"]; classifier != "" {
+			metadata["classifier"] = classifier
+		}
+		if packaging := p.qualifiers["type"]; packaging != "" && packaging != "jar" {
+			metadata["packaging"] = packaging
+		}
+	}
+	return metadata
+}
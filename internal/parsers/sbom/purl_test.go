@@ -0,0 +1,67 @@
+package sbom
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePackageURL(t *testing.T) {
+	tests := []struct {
+		purl string
+		want packageURL
+		ok   bool
+	}{
+		{"pkg:npm/lodash@4.17.21", packageURL{kind: "npm", name: "lodash", version: "4.17.21"}, true},
+		{"pkg:npm/%40angular/core@16.2.0", packageURL{kind: "npm", namespace: "@angular", name: "core", version: "16.2.0"}, true},
+		{"pkg:npm/@angular/core@16.2.0", packageURL{kind: "npm", namespace: "@angular", name: "core", version: "16.2.0"}, true},
+		{"pkg:golang/github.com/go-playground/validator/v10@v10.14.0#pkg", packageURL{kind: "golang", namespace: "github.com/go-playground/validator", name: "v10", version: "v10.14.0", subpath: "pkg"}, true},
+		{"pkg:maven/org.apache.commons/commons-lang3@3.14.0?classifier=sources&type=jar", packageURL{kind: "maven", namespace: "org.apache.commons", name: "commons-lang3", version: "3.14.0", qualifiers: map[string]string{"classifier": "sources", "type": "jar"}}, true},
+		{"pkg:PyPI/requests", packageURL{kind: "pypi", name: "requests"}, true},
+		{"pkg:npm", packageURL{}, false},
+		{"cpe:2.3:a:lodash:lodash:4.17.21", packageURL{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := parsePackageURL(tt.purl)
+		if ok != tt.ok || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parsePackageURL(%q) = %+v, %v; want %+v, %v", tt.purl, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestPackageURL_Package(t *testing.T) {
+	tests := []struct {
+		purl           string
+		packageManager string
+		packageName    string
+	}{
+		{"pkg:npm/%40angular/core@16.2.0", "npm", "@angular/core"},
+		{"pkg:maven/com.google.guava/guava@32.1.3-jre", "mvn", "com.google.guava:guava"},
+		{"pkg:golang/github.com/gin-gonic/gin@v1.9.1", "go", "github.com/gin-gonic/gin"},
+		{"pkg:composer/monolog/monolog@3.5.0", "composer", "monolog/monolog"},
+		{"pkg:hex/acme/plug@1.15.0", "hex", "plug"},
+		{"pkg:docker/library/nginx@1.25", "docker", "nginx"},
+		{"pkg:docker/acme/runtime-base@1.4.2?repository_url=ghcr.io", "docker", "ghcr.io/acme/runtime-base"},
+		{"pkg:githubactions/actions/checkout@v4", "github-actions", "actions/checkout"},
+		{"pkg:deb/debian/openssl@3.0.11-1", "deb", "debian/openssl"},
+	}
+	for _, tt := range tests {
+		purl, ok := parsePackageURL(tt.purl)
+		if !ok {
+			t.Fatalf("parsePackageURL(%q) failed", tt.purl)
+		}
+		if got := purl.packageManager(); got != tt.packageManager {
+			t.Errorf("%s packageManager() = %q; want %q", tt.purl, got, tt.packageManager)
+		}
+		if got := purl.packageName(); got != tt.packageName {
+			t.Errorf("%s packageName() = %q; want %q", tt.purl, got, tt.packageName)
+		}
+	}
+}
+
+func TestPackageURL_Metadata(t *testing.T) {
+	purl, _ := parsePackageURL("pkg:maven/io.netty/netty-transport-native-epoll@4.1.100.Final?classifier=linux-x86_64&repository_url=https://maven.example.com/releases")
+	want := map[string]string{"classifier": "linux-x86_64", "registry": "https://maven.example.com/releases"}
+	if got := purl.metadata(); !reflect.DeepEqual(got, want) {
+		t.Errorf("metadata() = %v; want %v", got, want)
+	}
+}
@@ -0,0 +1,140 @@
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/jsonutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// SpdxJsonParser implements parsing of SPDX 2.x documents in JSON (*.spdx.json)
+type SpdxJsonParser struct{}
+
+// spdxVersionPrefix is the prefix of the versions of the supported SPDX specifications, e.g. SPDX-2.3
+const spdxVersionPrefix = "SPDX-2."
+
+// spdxDocumentID is the SPDXID of the document itself
+const spdxDocumentID = "SPDXRef-DOCUMENT"
+
+// purlReferenceType is the type of the external references of packages that hold their package URL
+const purlReferenceType = "purl"
+
+// spdxDocument represents the SPDX JSON structure
+type spdxDocument struct {
+	SpdxVersion       string   `json:"spdxVersion"`
+	DocumentDescribes []string `json:"documentDescribes"`
+	Packages          []struct {
+		SPDXID       string `json:"SPDXID"`
+		VersionInfo  string `json:"versionInfo"`
+		ExternalRefs []struct {
+			ReferenceType    string `json:"referenceType"`
+			ReferenceLocator string `json:"referenceLocator"`
+		} `json:"externalRefs"`
+		Checksums []struct {
+			Algorithm     string `json:"algorithm"`
+			ChecksumValue string `json:"checksumValue"`
+		} `json:"checksums"`
+	} `json:"packages"`
+	Relationships []struct {
+		SpdxElementID      string `json:"spdxElementId"`
+		RelationshipType   string `json:"relationshipType"`
+		RelatedSpdxElement string `json:"relatedSpdxElement"`
+	} `json:"relationships"`
+}
+
+// relationship is a relationship between two elements of an SPDX document, e.g. SPDXRef-app DEPENDS_ON SPDXRef-lodash
+type relationship struct {
+	element string
+	kind    string
+	related string
+}
+
+// spdxGraph builds the dependency graph of an SPDX document from the packages it describes and its relationships.
+// Qualified relationships, e.g. DEV_DEPENDENCY_OF, also return the scope of the dependency, e.g. dev.
+func spdxGraph(describes []string, relationships []relationship) (dependencyGraph, map[string][]string) {
+	graph := dependencyGraph{roots: describes}
+	scopes := make(map[string][]string)
+	for _, r := range relationships {
+		switch {
+		case r.kind == "DESCRIBES" && r.element == spdxDocumentID:
+			graph.roots = append(graph.roots, r.related)
+		case r.kind == "DESCRIBED_BY" && r.related == spdxDocumentID:
+			graph.roots = append(graph.roots, r.element)
+		case r.kind == "DEPENDS_ON":
+			graph.addDependency(r.element, r.related)
+		case r.kind == "DEPENDENCY_OF":
+			graph.addDependency(r.related, r.element)
+		case strings.HasSuffix(r.kind, "_DEPENDENCY_OF"):
+			graph.addDependency(r.related, r.element)
+			scope := strings.ToLower(strings.TrimSuffix(r.kind, "_DEPENDENCY_OF"))
+			if !contains(scopes[r.element], scope) {
+				scopes[r.element] = append(scopes[r.element], scope)
+			}
+		}
+	}
+	return graph, scopes
+}
+
+// contains reports whether a list holds a value
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Parse implements the Parser interface for SPDX JSON files
+func (p *SpdxJsonParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	var document spdxDocument
+	if err := json.Unmarshal(content, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	if !strings.HasPrefix(document.SpdxVersion, spdxVersionPrefix) {
+		return nil, fmt.Errorf("unsupported SPDX version %q", document.SpdxVersion)
+	}
+	locations, err := jsonutil.FindLocations(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var relationships []relationship
+	for _, r := range document.Relationships {
+		relationships = append(relationships, relationship{element: r.SpdxElementID, kind: r.RelationshipType, related: r.RelatedSpdxElement})
+	}
+	graph, scopes := spdxGraph(document.DocumentDescribes, relationships)
+
+	var components []component
+	for i, pkg := range document.Packages {
+		index := strconv.Itoa(i)
+		read := component{ref: pkg.SPDXID, version: pkg.VersionInfo, scopes: scopes[pkg.SPDXID]}
+		for j, ref := range pkg.ExternalRefs {
+			if ref.ReferenceType != purlReferenceType || read.purl != "" {
+				continue
+			}
+			read.purl = ref.ReferenceLocator
+			if location, ok := locations.Find("packages", index, "externalRefs", strconv.Itoa(j), "referenceLocator"); ok {
+				read.locations = append(read.locations, location)
+			}
+		}
+		if location, ok := locations.Find("packages", index, "versionInfo"); ok {
+			read.locations = append(read.locations, location)
+		}
+		for _, sum := range pkg.Checksums {
+			read.checksums = append(read.checksums, checksum{algorithm: sum.Algorithm, value: sum.ChecksumValue})
+		}
+		components = append(components, read)
+	}
+
+	return buildPackages(manifestFile, components, graph), nil
+}
@@ -0,0 +1,65 @@
+package sbom
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestSpdxJsonParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/sbom.spdx.json"
+
+	packages, err := (&SpdxJsonParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "go", PackageName: "github.com/gin-gonic/gin", Version: "v1.9.1", FilePath: manifestFile, Locations: []models.Location{{Line: 46, StartIndex: 10, EndIndex: 74}, {Line: 29, StartIndex: 6, EndIndex: 30}}},
+		{PackageManager: "go", PackageName: "github.com/go-playground/validator/v10", Version: "v10.14.0", FilePath: manifestFile, Locations: []models.Location{{Line: 59, StartIndex: 10, EndIndex: 90}, {Line: 53, StartIndex: 6, EndIndex: 32}}},
+		{PackageManager: "go", PackageName: "github.com/stretchr/testify", Version: "v1.8.4", FilePath: manifestFile, Locations: []models.Location{{Line: 72, StartIndex: 10, EndIndex: 77}, {Line: 66, StartIndex: 6, EndIndex: 30}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	for i, want := range []bool{false, true, false} {
+		if packages[i].Transitive != want {
+			t.Errorf("%s Transitive: got %v, want %v", packages[i].PackageName, packages[i].Transitive, want)
+		}
+	}
+	// DEPENDENCY_OF is the inverse of DEPENDS_ON
+	if want := []string{"github.com/go-playground/validator/v10"}; !reflect.DeepEqual(packages[0].Dependencies, want) {
+		t.Errorf("gin Dependencies: got %v, want %v", packages[0].Dependencies, want)
+	}
+	if want := []string{"sha256-mFrcTcfRFQ+zAKWL8eFhVoCiZxsh0Z8sHGnNZcy/UtQ="}; !reflect.DeepEqual(packages[0].Hashes, want) {
+		t.Errorf("gin Hashes: got %v, want %v", packages[0].Hashes, want)
+	}
+	if want := []string{"test"}; !reflect.DeepEqual(packages[2].Scopes, want) {
+		t.Errorf("testify Scopes: got %v, want %v", packages[2].Scopes, want)
+	}
+}
+
+func TestSpdxGraph(t *testing.T) {
+	graph, scopes := spdxGraph([]string{"SPDXRef-app"}, []relationship{
+		{"SPDXRef-lib", "DESCRIBED_BY", "SPDXRef-DOCUMENT"},
+		{"SPDXRef-app", "DEPENDS_ON", "SPDXRef-a"},
+		{"SPDXRef-b", "BUILD_DEPENDENCY_OF", "SPDXRef-app"},
+		{"SPDXRef-b", "DEV_DEPENDENCY_OF", "SPDXRef-lib"},
+		{"SPDXRef-app", "CONTAINS", "SPDXRef-c"},
+	})
+
+	if want := []string{"SPDXRef-app", "SPDXRef-lib"}; !reflect.DeepEqual(graph.roots, want) {
+		t.Errorf("roots = %v; want %v", graph.roots, want)
+	}
+	if want := map[string][]string{"SPDXRef-app": {"SPDXRef-a", "SPDXRef-b"}, "SPDXRef-lib": {"SPDXRef-b"}}; !reflect.DeepEqual(graph.dependencies, want) {
+		t.Errorf("dependencies = %v; want %v", graph.dependencies, want)
+	}
+	if want := map[string][]string{"SPDXRef-b": {"build", "dev"}}; !reflect.DeepEqual(scopes, want) {
+		t.Errorf("scopes = %v; want %v", scopes, want)
+	}
+}
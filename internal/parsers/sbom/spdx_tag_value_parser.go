@@ -0,0 +1,126 @@
+package sbom
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// SpdxTagValueParser implements parsing of SPDX 2.x documents in the tag-value format (*.spdx)
+type SpdxTagValueParser struct{}
+
+// elementTags start a new element of a tag-value document; package tags that follow them do not describe the package
+var elementTags = map[string]bool{
+	"FileName":            true,
+	"SnippetSPDXID":       true,
+	"LicenseID":           true,
+	"ExtractedText":       true,
+	"Annotator":           true,
+	"ExternalDocumentRef": true,
+}
+
+// tagValuePackage is a package of a tag-value document with the locations of its purl and version lines
+type tagValuePackage struct {
+	component
+	purlLocation    *models.Location
+	versionLocation *models.Location
+}
+
+// tagLocation returns the location of a tag line from the tag to the end of its value
+func tagLocation(lineNum int, raw string) *models.Location {
+	trimmed := strings.TrimRight(raw, " \t\r")
+	start := len(trimmed) - len(strings.TrimLeft(trimmed, " \t"))
+	return &models.Location{Line: lineNum, StartIndex: start, EndIndex: len(trimmed)}
+}
+
+// Parse implements the Parser interface for SPDX tag-value files
+func (p *SpdxTagValueParser) Parse(manifestFile string) ([]models.Package, error) {
+	file, err := os.Open(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+	defer file.Close()
+
+	var packages []*tagValuePackage
+	var current *tagValuePackage
+	var relationships []relationship
+	version := ""
+
+	scanner := bufio.NewScanner(file)
+	lineNum := -1
+	inText := false
+	for scanner.Scan() {
+		lineNum++
+		raw := scanner.Text()
+		// Multi-line values are enclosed in <text> and </text>
+		if inText {
+			inText = !strings.Contains(raw, "</text>")
+			continue
+		}
+
+		tag, value, ok := strings.Cut(strings.TrimSpace(raw), ":")
+		if !ok || strings.HasPrefix(tag, "#") {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if strings.HasPrefix(value, "<text>") && !strings.Contains(value, "</text>") {
+			inText = true
+			continue
+		}
+
+		switch {
+		case tag == "SPDXVersion":
+			version = value
+		case tag == "PackageName":
+			current = &tagValuePackage{}
+			packages = append(packages, current)
+		case elementTags[tag]:
+			current = nil
+		case tag == "Relationship":
+			if fields := strings.Fields(value); len(fields) == 3 {
+				relationships = append(relationships, relationship{element: fields[0], kind: fields[1], related: fields[2]})
+			}
+		case current == nil:
+			continue
+		case tag == "SPDXID":
+			current.ref = value
+		case tag == "PackageVersion":
+			current.version = value
+			current.versionLocation = tagLocation(lineNum, raw)
+		case tag == "PackageChecksum":
+			// PackageChecksum: SHA256: 2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae
+			if algorithm, sum, ok := strings.Cut(value, ":"); ok {
+				current.checksums = append(current.checksums, checksum{algorithm: strings.TrimSpace(algorithm), value: strings.TrimSpace(sum)})
+			}
+		case tag == "ExternalRef":
+			// ExternalRef: PACKAGE-MANAGER purl pkg:npm/lodash@4.17.21
+			if fields := strings.Fields(value); len(fields) == 3 && fields[1] == purlReferenceType && current.purl == "" {
+				current.purl = fields[2]
+				current.purlLocation = tagLocation(lineNum, raw)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(version, spdxVersionPrefix) {
+		return nil, fmt.Errorf("unsupported SPDX version %q", version)
+	}
+
+	graph, scopes := spdxGraph(nil, relationships)
+	components := make([]component, 0, len(packages))
+	for _, pkg := range packages {
+		for _, location := range []*models.Location{pkg.purlLocation, pkg.versionLocation} {
+			if location != nil {
+				pkg.locations = append(pkg.locations, *location)
+			}
+		}
+		pkg.scopes = scopes[pkg.ref]
+		components = append(components, pkg.component)
+	}
+
+	return buildPackages(manifestFile, components, graph), nil
+}
@@ -0,0 +1,57 @@
+package sbom
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestSpdxTagValueParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/sbom.spdx"
+
+	packages, err := (&SpdxTagValueParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "gem", PackageName: "rails", Version: "7.1.2", FilePath: manifestFile, Locations: []models.Location{{Line: 28, StartIndex: 0, EndIndex: 53}, {Line: 22, StartIndex: 0, EndIndex: 21}}},
+		{PackageManager: "gem", PackageName: "rack", Version: "3.0.8", FilePath: manifestFile, Locations: []models.Location{{Line: 36, StartIndex: 0, EndIndex: 52}, {Line: 34, StartIndex: 0, EndIndex: 21}}},
+		{PackageManager: "gem", PackageName: "rspec", Version: "3.12.0", FilePath: manifestFile, Locations: []models.Location{{Line: 44, StartIndex: 0, EndIndex: 54}, {Line: 42, StartIndex: 0, EndIndex: 22}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	for i, want := range []bool{false, true, false} {
+		if packages[i].Transitive != want {
+			t.Errorf("%s Transitive: got %v, want %v", packages[i].PackageName, packages[i].Transitive, want)
+		}
+	}
+	if want := []string{"rack"}; !reflect.DeepEqual(packages[0].Dependencies, want) {
+		t.Errorf("rails Dependencies: got %v, want %v", packages[0].Dependencies, want)
+	}
+	if want := []string{"sha256-Lh1ZC+f5kkRWHfpTzmfsVFuJEC49HFzN18ZfJhX+Nuc="}; !reflect.DeepEqual(packages[0].Hashes, want) {
+		t.Errorf("rails Hashes: got %v, want %v", packages[0].Hashes, want)
+	}
+	if want := []string{"dev"}; !reflect.DeepEqual(packages[2].Scopes, want) {
+		t.Errorf("rspec Scopes: got %v, want %v", packages[2].Scopes, want)
+	}
+}
+
+func TestSpdxTagValueParser_Parse_UnsupportedVersion(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "sbom.spdx")
+	if err := os.WriteFile(manifestFile, []byte("SPDXVersion: SPDX-3.0\nPackageName: rails\n"), 0644); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+
+	if _, err := (&SpdxTagValueParser{}).Parse(manifestFile); err == nil {
+		t.Errorf("Expected an error for an unsupported SPDX version")
+	}
+}
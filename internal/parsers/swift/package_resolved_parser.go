@@ -0,0 +1,168 @@
+package swift
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/jsonutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// SwiftPackageResolvedParser implements parsing of Swift Package Manager lock files (Package.resolved), versions 1 to 3
+type SwiftPackageResolvedParser struct{}
+
+// pinState is the resolved state of a pin
+type pinState struct {
+	Version  string `json:"version"`
+	Revision string `json:"revision"`
+	Branch   string `json:"branch"`
+}
+
+// pin is a resolved package. Version 1 names it by package and repositoryURL, later versions by identity and location.
+type pin struct {
+	Package       string   `json:"package"`
+	RepositoryURL string   `json:"repositoryURL"`
+	Identity      string   `json:"identity"`
+	Kind          string   `json:"kind"`
+	Location      string   `json:"location"`
+	State         pinState `json:"state"`
+}
+
+// packageResolved represents the Package.resolved structure
+type packageResolved struct {
+	Version int   `json:"version"`
+	Pins    []pin `json:"pins"`
+	Object  struct {
+		Pins []pin `json:"pins"`
+	} `json:"object"`
+}
+
+// Kinds of the pins of version 2 and later
+const (
+	registryKind           = "registry"
+	localSourceControlKind = "localSourceControl"
+)
+
+// packageName returns the name of a pin: its repository, its local directory or, for registry pins, its identifier
+func (p pin) packageName() string {
+	switch {
+	case p.Kind == registryKind:
+		return p.Identity
+	case p.Kind == localSourceControlKind:
+		return filepath.Base(p.Location)
+	case p.Location != "":
+		return PackageName(p.Location)
+	case p.RepositoryURL != "":
+		return PackageName(p.RepositoryURL)
+	}
+	return p.Identity
+}
+
+// metadata describes where a pin comes from and the revision it is resolved to
+func (p pin) metadata() map[string]string {
+	metadata := make(map[string]string)
+	switch {
+	case p.Kind == registryKind:
+		metadata["source"] = "registry"
+	case p.Kind == localSourceControlKind:
+		metadata["source"] = "git"
+		metadata["path"] = p.Location
+	default:
+		metadata["source"] = "git"
+		metadata["git"] = p.Location
+		if p.Location == "" {
+			metadata["git"] = p.RepositoryURL
+		}
+	}
+	if p.State.Revision != "" {
+		metadata["rev"] = p.State.Revision
+	}
+	if p.State.Branch != "" {
+		metadata["branch"] = p.State.Branch
+	}
+	return metadata
+}
+
+// loadDeclaredPackages reads the Package.swift next to a Package.resolved and collects the lower case names it declares
+func loadDeclaredPackages(resolvedFile string) map[string]bool {
+	content, err := os.ReadFile(filepath.Join(filepath.Dir(resolvedFile), PackageSwift))
+	if err != nil {
+		return nil
+	}
+
+	declared := make(map[string]bool)
+	for _, dep := range findDeclarations(content) {
+		declared[strings.ToLower(dep.packageName())] = true
+	}
+	return declared
+}
+
+// Parse implements the Parser interface for Package.resolved files
+func (p *SwiftPackageResolvedParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	var resolved packageResolved
+	if err := json.Unmarshal(content, &resolved); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	locations, err := jsonutil.FindLocations(content)
+	if err != nil {
+		return nil, err
+	}
+
+	// Version 1 nests the pins in an object and names their repository repositoryURL
+	pins, pinsPath, locationKey := resolved.Pins, []string{"pins"}, "location"
+	if resolved.Version == 1 {
+		pins, pinsPath, locationKey = resolved.Object.Pins, []string{"object", "pins"}, "repositoryURL"
+	}
+
+	declared := loadDeclaredPackages(manifestFile)
+
+	var packages []models.Package
+	for i, pin := range pins {
+		name := pin.packageName()
+		if name == "" {
+			continue
+		}
+
+		// Pins of branches and revisions have no version, the revision identifies them
+		version := pin.State.Version
+		if version == "" {
+			version = pin.State.Revision
+		}
+
+		// The member naming the pin and the member of its resolved version or revision
+		nameKey, stateKey := locationKey, "version"
+		if pin.Kind == registryKind {
+			nameKey = "identity"
+		}
+		if pin.State.Version == "" {
+			stateKey = "revision"
+		}
+		index := strconv.Itoa(i)
+		var pinLocations []models.Location
+		for _, member := range [][]string{{nameKey}, {"state", stateKey}} {
+			if location, ok := locations.Find(append(append(append([]string{}, pinsPath...), index), member...)...); ok {
+				pinLocations = append(pinLocations, location)
+			}
+		}
+
+		packages = append(packages, models.Package{
+			PackageManager: "swift",
+			PackageName:    name,
+			Version:        version,
+			FilePath:       manifestFile,
+			Locations:      pinLocations,
+			Transitive:     declared != nil && !declared[strings.ToLower(name)],
+			Metadata:       pin.metadata(),
+		})
+	}
+	return packages, nil
+}
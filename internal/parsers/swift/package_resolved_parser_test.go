@@ -0,0 +1,120 @@
+package swift
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestSwiftPackageResolvedParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/Package.resolved"
+
+	packages, err := (&SwiftPackageResolvedParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "swift", PackageName: "github.com/apple/swift-argument-parser", Version: "1.3.0", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 6, EndIndex: 72}, {Line: 9, StartIndex: 8, EndIndex: 27}}},
+		{PackageManager: "swift", PackageName: "github.com/apple/swift-log", Version: "1.5.4", FilePath: manifestFile, Locations: []models.Location{{Line: 15, StartIndex: 6, EndIndex: 56}, {Line: 18, StartIndex: 8, EndIndex: 27}}},
+		{PackageManager: "swift", PackageName: "github.com/pointfreeco/swift-snapshot-testing", Version: "59b663f68e69f27a87b45de48cb63264b8194605", FilePath: manifestFile, Locations: []models.Location{{Line: 24, StartIndex: 6, EndIndex: 75}, {Line: 27, StartIndex: 8, EndIndex: 63}}},
+		{PackageManager: "swift", PackageName: "github.com/apple/swift-nio", Version: "2.62.0", FilePath: manifestFile, Locations: []models.Location{{Line: 33, StartIndex: 6, EndIndex: 60}, {Line: 36, StartIndex: 8, EndIndex: 28}}},
+		{PackageManager: "swift", PackageName: "mona.linkedlist", Version: "1.1.0", FilePath: manifestFile, Locations: []models.Location{{Line: 40, StartIndex: 6, EndIndex: 37}, {Line: 44, StartIndex: 8, EndIndex: 27}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	// swift-nio is not declared in the sibling Package.swift
+	for _, pkg := range packages {
+		if pkg.Transitive != (pkg.PackageName == "github.com/apple/swift-nio") {
+			t.Errorf("%s Transitive: got %v", pkg.PackageName, pkg.Transitive)
+		}
+	}
+	snapshot := packages[2]
+	if snapshot.Metadata["branch"] != "main" || snapshot.Metadata["rev"] != "59b663f68e69f27a87b45de48cb63264b8194605" {
+		t.Errorf("swift-snapshot-testing Metadata: got %v", snapshot.Metadata)
+	}
+	if packages[4].Metadata["source"] != "registry" {
+		t.Errorf("mona.linkedlist source: got %q, want registry", packages[4].Metadata["source"])
+	}
+}
+
+func TestSwiftPackageResolvedParser_Version1(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "Package.resolved")
+	content := `{
+  "object": {
+    "pins": [
+      {
+        "package": "swift-argument-parser",
+        "repositoryURL": "https://github.com/apple/swift-argument-parser",
+        "state": {
+          "branch": null,
+          "revision": "fee6933f37fde9a5e12a1e4aeaa93fe60116ff2a",
+          "version": "1.2.2"
+        }
+      }
+    ]
+  },
+  "version": 1
+}
+`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write Package.resolved: %v", err)
+	}
+
+	packages, err := (&SwiftPackageResolvedParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "swift", PackageName: "github.com/apple/swift-argument-parser", Version: "1.2.2", FilePath: manifestFile, Locations: []models.Location{{Line: 5, StartIndex: 8, EndIndex: 74}, {Line: 9, StartIndex: 10, EndIndex: 28}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) == 1 && packages[0].Transitive {
+		t.Errorf("Pins must not be transitive without a Package.swift")
+	}
+}
+
+func TestSwiftPackageResolvedParser_Version3(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "Package.resolved")
+	content := `{
+  "originHash" : "0d1f0c4b",
+  "pins" : [
+    {
+      "identity" : "localkit",
+      "kind" : "localSourceControl",
+      "location" : "/Users/dev/LocalKit",
+      "state" : {
+        "revision" : "3b1c2d4e"
+      }
+    }
+  ],
+  "version" : 3
+}
+`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write Package.resolved: %v", err)
+	}
+
+	packages, err := (&SwiftPackageResolvedParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "swift", PackageName: "LocalKit", Version: "3b1c2d4e", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 6, EndIndex: 41}, {Line: 8, StartIndex: 8, EndIndex: 31}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) == 1 && packages[0].Metadata["path"] != "/Users/dev/LocalKit" {
+		t.Errorf("localkit path: got %q", packages[0].Metadata["path"])
+	}
+}
@@ -0,0 +1,335 @@
+package swift
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// SwiftPackageParser implements parsing of Swift Package Manager manifests (Package.swift)
+type SwiftPackageParser struct{}
+
+// Swift Package Manager file names
+const (
+	PackageSwift    = "Package.swift"
+	PackageResolved = "Package.resolved"
+)
+
+// packageCallPattern matches the start of a package dependency, e.g. .package(url: "...", from: "1.0.0")
+var packageCallPattern = regexp.MustCompile(`\.package\s*\(`)
+
+// stringPattern matches a string literal
+var stringPattern = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"`)
+
+// labeledArgumentPattern matches a labeled argument, e.g. from: "1.0.0"
+var labeledArgumentPattern = regexp.MustCompile(`^([a-zA-Z_]\w*)\s*:\s*(.*)$`)
+
+// requirementCallPattern matches the requirement functions of PackageDescription, e.g. .exact("1.2.3")
+var requirementCallPattern = regexp.MustCompile(`^\.(\w+)\s*\((.*)\)$`)
+
+// blankComments replaces the comments of Swift code with spaces, keeping the offsets of the code intact
+func blankComments(content []byte) []byte {
+	code := make([]byte, len(content))
+	copy(code, content)
+
+	inString := false
+	for i := 0; i < len(code); i++ {
+		switch {
+		case inString:
+			if code[i] == '\\' {
+				i++
+			} else if code[i] == '"' {
+				inString = false
+			}
+		case code[i] == '"':
+			inString = true
+		case code[i] == '/' && i+1 < len(code) && code[i+1] == '/':
+			for ; i < len(code) && code[i] != '\n'; i++ {
+				code[i] = ' '
+			}
+		case code[i] == '/' && i+1 < len(code) && code[i+1] == '*':
+			stop := len(code)
+			if end := bytes.Index(code[i+2:], []byte("*/")); end >= 0 {
+				stop = i + 2 + end + 2
+			}
+			for j := i; j < stop; j++ {
+				if code[j] != '\n' {
+					code[j] = ' '
+				}
+			}
+			i = stop - 1
+		}
+	}
+	return code
+}
+
+// closingParen finds the parenthesis that closes the one before start, skipping string literals
+func closingParen(code []byte, start int) int {
+	depth := 1
+	inString := false
+	for i := start; i < len(code); i++ {
+		c := code[i]
+		switch {
+		case inString:
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == '(' || c == '[':
+			depth++
+		case c == ')' || c == ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// splitArguments splits the arguments of a call on the commas between them
+func splitArguments(code string) []string {
+	var args []string
+	depth, start := 0, 0
+	inString := false
+	for i := 0; i < len(code); i++ {
+		c := code[i]
+		switch {
+		case inString:
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == '(' || c == '[':
+			depth++
+		case c == ')' || c == ']':
+			depth--
+		case c == ',' && depth == 0:
+			args = append(args, strings.TrimSpace(code[start:i]))
+			start = i + 1
+		}
+	}
+	if last := strings.TrimSpace(code[start:]); last != "" {
+		args = append(args, last)
+	}
+	return args
+}
+
+// stringLiteral returns the value of the first string literal of an expression
+func stringLiteral(expr string) string {
+	if match := stringPattern.FindStringSubmatch(expr); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// spanLocations returns the locations of a span of content, one per line, without the indentation of its lines
+func spanLocations(content []byte, start, end int) []models.Location {
+	line := bytes.Count(content[:start], []byte("\n"))
+	column := start - (bytes.LastIndexByte(content[:start], '\n') + 1)
+
+	var locations []models.Location
+	for i, part := range strings.Split(string(content[start:end]), "\n") {
+		if i > 0 {
+			column = 0
+		}
+		trimmed := strings.TrimRight(part, " \t\r")
+		if strings.TrimSpace(trimmed) == "" {
+			continue
+		}
+		indent := len(trimmed) - len(strings.TrimLeft(trimmed, " \t"))
+		locations = append(locations, models.Location{Line: line + i, StartIndex: column + indent, EndIndex: column + len(trimmed)})
+	}
+	return locations
+}
+
+// PackageName derives the name of a package from its repository URL, e.g. github.com/apple/swift-nio
+// for https://github.com/apple/swift-nio.git or git@github.com:apple/swift-nio.git
+func PackageName(location string) string {
+	name := strings.TrimSuffix(strings.TrimSuffix(strings.TrimSpace(location), "/"), ".git")
+	if scheme, rest, found := strings.Cut(name, "://"); found && !strings.Contains(scheme, "/") {
+		name = rest
+	}
+	if user, rest, found := strings.Cut(name, "@"); found && !strings.Contains(user, "/") {
+		name = strings.Replace(rest, ":", "/", 1)
+	}
+	return name
+}
+
+// dependency is a package dependency declared in Package.swift
+type dependency struct {
+	name     string
+	url      string
+	path     string
+	id       string
+	exact    string
+	revision string
+	branch   string
+}
+
+// parseDependency reads the arguments of a .package(...) declaration
+func parseDependency(args []string) dependency {
+	var dep dependency
+	for _, arg := range args {
+		label, value := "", arg
+		if match := labeledArgumentPattern.FindStringSubmatch(arg); match != nil {
+			label, value = match[1], match[2]
+		}
+		// Requirement functions, e.g. .exact("1.2.3"), .branch("main") or .upToNextMajor(from: "1.0.0")
+		if match := requirementCallPattern.FindStringSubmatch(value); match != nil && label == "" {
+			label, value = match[1], match[2]
+		}
+
+		switch label {
+		case "name":
+			dep.name = stringLiteral(value)
+		case "url":
+			dep.url = stringLiteral(value)
+		case "path":
+			dep.path = stringLiteral(value)
+		case "id":
+			dep.id = stringLiteral(value)
+		case "exact":
+			dep.exact = stringLiteral(value)
+		case "revision":
+			dep.revision = stringLiteral(value)
+		case "branch":
+			dep.branch = stringLiteral(value)
+		}
+		// from:, ranges such as "1.0.0"..<"2.0.0" and .upToNextMajor(from:) are left to Package.resolved
+	}
+	return dep
+}
+
+// packageName returns the name of a declared dependency: its repository, registry identifier or local directory
+func (d dependency) packageName() string {
+	switch {
+	case d.url != "":
+		return PackageName(d.url)
+	case d.id != "":
+		return d.id
+	case d.path != "":
+		return filepath.Base(d.path)
+	}
+	return d.name
+}
+
+// metadata describes where a declared dependency comes from
+func (d dependency) metadata() map[string]string {
+	metadata := make(map[string]string)
+	switch {
+	case d.url != "":
+		metadata["source"] = "git"
+		metadata["git"] = d.url
+	case d.id != "":
+		metadata["source"] = "registry"
+	case d.path != "":
+		metadata["source"] = "path"
+		metadata["path"] = d.path
+	}
+	if d.branch != "" {
+		metadata["branch"] = d.branch
+	}
+	if d.revision != "" {
+		metadata["rev"] = d.revision
+	}
+	return metadata
+}
+
+// loadResolvedPins reads the Package.resolved next to a manifest and maps lower case package names to their pins
+func loadResolvedPins(manifestFile string) map[string]models.Package {
+	resolvedFile := filepath.Join(filepath.Dir(manifestFile), PackageResolved)
+	if _, err := os.Stat(resolvedFile); err != nil {
+		return nil
+	}
+	packages, err := (&SwiftPackageResolvedParser{}).Parse(resolvedFile)
+	if err != nil {
+		return nil
+	}
+
+	pins := make(map[string]models.Package)
+	for _, pkg := range packages {
+		pins[strings.ToLower(pkg.PackageName)] = pkg
+	}
+	return pins
+}
+
+// declaration is a .package(...) declaration with the offsets of its span
+type declaration struct {
+	dependency
+	start int
+	end   int
+}
+
+// findDeclarations finds the package dependencies declared in the content of a Package.swift
+func findDeclarations(content []byte) []declaration {
+	code := blankComments(content)
+
+	var declarations []declaration
+	for _, match := range packageCallPattern.FindAllIndex(code, -1) {
+		end := closingParen(code, match[1])
+		if end < 0 {
+			continue
+		}
+		dep := parseDependency(splitArguments(string(code[match[1]:end])))
+		if dep.packageName() == "" {
+			continue
+		}
+		declarations = append(declarations, declaration{dependency: dep, start: match[0], end: end + 1})
+	}
+	return declarations
+}
+
+// Parse implements the Parser interface for Package.swift files
+func (p *SwiftPackageParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	pins := loadResolvedPins(manifestFile)
+
+	var packages []models.Package
+	for _, dep := range findDeclarations(content) {
+		name := dep.packageName()
+
+		// Exact versions and revisions pin the package, everything else is resolved by Package.resolved
+		version := "latest"
+		switch {
+		case dep.exact != "":
+			version = dep.exact
+		case dep.revision != "":
+			version = dep.revision
+		default:
+			if pin, ok := pins[strings.ToLower(name)]; ok {
+				version = pin.Version
+			}
+		}
+
+		metadata := dep.metadata()
+		if len(metadata) == 0 {
+			metadata = nil
+		}
+
+		packages = append(packages, models.Package{
+			PackageManager: "swift",
+			PackageName:    name,
+			Version:        version,
+			FilePath:       manifestFile,
+			Locations:      spanLocations(content, dep.start, dep.end),
+			Metadata:       metadata,
+		})
+	}
+	return packages, nil
+}
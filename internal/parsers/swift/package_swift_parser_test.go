@@ -0,0 +1,101 @@
+package swift
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestSwiftPackageParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/Package.swift"
+
+	packages, err := (&SwiftPackageParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Ranges and branches are resolved from the Package.resolved next to Package.swift
+	expectedPackages := []models.Package{
+		{PackageManager: "swift", PackageName: "github.com/apple/swift-argument-parser", Version: "1.3.0", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 8, EndIndex: 90}}},
+		{PackageManager: "swift", PackageName: "github.com/vapor/vapor", Version: "4.89.0", FilePath: manifestFile, Locations: []models.Location{{Line: 7, StartIndex: 8, EndIndex: 72}}},
+		{PackageManager: "swift", PackageName: "github.com/pointfreeco/swift-snapshot-testing", Version: "59b663f68e69f27a87b45de48cb63264b8194605", FilePath: manifestFile, Locations: []models.Location{
+			{Line: 9, StartIndex: 8, EndIndex: 17},
+			{Line: 10, StartIndex: 12, EndIndex: 73},
+			{Line: 11, StartIndex: 12, EndIndex: 26},
+			{Line: 12, StartIndex: 8, EndIndex: 9},
+		}},
+		{PackageManager: "swift", PackageName: "github.com/apple/swift-log", Version: "1.5.4", FilePath: manifestFile, Locations: []models.Location{{Line: 13, StartIndex: 8, EndIndex: 90}}},
+		{PackageManager: "swift", PackageName: "LocalKit", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 14, StartIndex: 8, EndIndex: 37}}},
+		{PackageManager: "swift", PackageName: "mona.LinkedList", Version: "1.1.0", FilePath: manifestFile, Locations: []models.Location{{Line: 15, StartIndex: 8, EndIndex: 58}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	wantMetadata := []map[string]string{
+		{"source": "git", "git": "https://github.com/apple/swift-argument-parser.git"},
+		{"source": "git", "git": "https://github.com/vapor/vapor"},
+		{"source": "git", "git": "git@github.com:pointfreeco/swift-snapshot-testing.git", "branch": "main"},
+		{"source": "git", "git": "https://github.com/apple/swift-log"},
+		{"source": "path", "path": "../LocalKit"},
+		{"source": "registry"},
+	}
+	for i, want := range wantMetadata {
+		for key, value := range want {
+			if packages[i].Metadata[key] != value {
+				t.Errorf("%s Metadata[%s]: got %q, want %q", packages[i].PackageName, key, packages[i].Metadata[key], value)
+			}
+		}
+	}
+}
+
+func TestSwiftPackageParser_WithoutResolved(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "Package.swift")
+	content := `let package = Package(
+    dependencies: [
+        .package(url: "https://github.com/apple/swift-nio.git", from: "2.0.0"),
+        .package(url: "https://github.com/apple/swift-crypto.git", .revision("a1b2c3")), /* pinned */
+        .package(url: "https://github.com/apple/swift-algorithms", .exact("1.2.0")),
+    ]
+)
+`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write Package.swift: %v", err)
+	}
+
+	packages, err := (&SwiftPackageParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "swift", PackageName: "github.com/apple/swift-nio", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 2, StartIndex: 8, EndIndex: 78}}},
+		{PackageManager: "swift", PackageName: "github.com/apple/swift-crypto", Version: "a1b2c3", FilePath: manifestFile, Locations: []models.Location{{Line: 3, StartIndex: 8, EndIndex: 87}}},
+		{PackageManager: "swift", PackageName: "github.com/apple/swift-algorithms", Version: "1.2.0", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 8, EndIndex: 83}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) == 3 && packages[1].Metadata["rev"] != "a1b2c3" {
+		t.Errorf("swift-crypto rev: got %q, want a1b2c3", packages[1].Metadata["rev"])
+	}
+}
+
+func TestPackageName(t *testing.T) {
+	tests := map[string]string{
+		"https://github.com/apple/swift-nio.git":   "github.com/apple/swift-nio",
+		"https://github.com/apple/swift-nio/":      "github.com/apple/swift-nio",
+		"git@github.com:apple/swift-nio.git":       "github.com/apple/swift-nio",
+		"ssh://git@gitlab.com/group/repo.git":      "gitlab.com/group/repo",
+		"https://user@bitbucket.org/team/repo.git": "bitbucket.org/team/repo",
+	}
+	for location, want := range tests {
+		if got := PackageName(location); got != want {
+			t.Errorf("PackageName(%q) = %q; want %q", location, got, want)
+		}
+	}
+}
@@ -0,0 +1,327 @@
+package terraform
+
+import (
+	"strings"
+)
+
+// tokenKind is the kind of a token of an HCL file
+type tokenKind int
+
+const (
+	identToken tokenKind = iota
+	stringToken
+	punctToken
+	newlineToken
+	otherToken
+)
+
+// hclToken is a token of an HCL file with its position. The text of strings is their unquoted value.
+type hclToken struct {
+	kind  tokenKind
+	text  string
+	line  int
+	start int
+	end   int
+}
+
+// valueKind is the kind of the value of an attribute
+type valueKind int
+
+const (
+	stringValue valueKind = iota
+	objectValue
+	listValue
+	otherValue
+)
+
+// hclValue is the value of an attribute: a string, an object, a list or another expression, which is not read
+type hclValue struct {
+	kind  valueKind
+	text  string
+	attrs []*hclAttribute
+	items []*hclValue
+	line  int
+	end   int
+}
+
+// hclAttribute is an attribute of a block or an object, e.g. version = "5.31.0"
+type hclAttribute struct {
+	name  string
+	value *hclValue
+	line  int
+	start int
+	end   int
+}
+
+// hclBlock is a block of an HCL file, e.g. provider "registry.terraform.io/hashicorp/aws" { ... }
+type hclBlock struct {
+	kind   string
+	labels []string
+	attrs  []*hclAttribute
+	blocks []*hclBlock
+	line   int
+	start  int
+	end    int
+}
+
+// findAttribute returns an attribute of a block or an object by its name
+func findAttribute(attrs []*hclAttribute, name string) *hclAttribute {
+	for _, attr := range attrs {
+		if attr.name == name {
+			return attr
+		}
+	}
+	return nil
+}
+
+// stringAttribute returns the value of a string attribute, or "" if the attribute is missing or not a string
+func stringAttribute(attrs []*hclAttribute, name string) string {
+	if attr := findAttribute(attrs, name); attr != nil && attr.value.kind == stringValue {
+		return attr.value.text
+	}
+	return ""
+}
+
+// childBlocks returns the blocks of a kind nested in a block
+func (b *hclBlock) childBlocks(kind string) []*hclBlock {
+	var blocks []*hclBlock
+	for _, block := range b.blocks {
+		if block.kind == kind {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+// tokenize splits HCL content into tokens. Comments are dropped and heredocs are read as a single token.
+func tokenize(content string) []hclToken {
+	lines := strings.Split(content, "\n")
+	var tokens []hclToken
+	inComment := false
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], "\r")
+		for col := 0; col < len(line); {
+			c := line[col]
+			switch {
+			case inComment:
+				if end := strings.Index(line[col:], "*/"); end >= 0 {
+					col += end + 2
+					inComment = false
+				} else {
+					col = len(line)
+				}
+			case c == ' ' || c == '\t':
+				col++
+			case c == '#' || strings.HasPrefix(line[col:], "//"):
+				col = len(line)
+			case strings.HasPrefix(line[col:], "/*"):
+				inComment = true
+				col += 2
+			case c == '"':
+				end := col + 1
+				var text strings.Builder
+				for end < len(line) && line[end] != '"' {
+					if line[end] == '\\' && end+1 < len(line) {
+						end++
+					}
+					text.WriteByte(line[end])
+					end++
+				}
+				end = min(end+1, len(line))
+				tokens = append(tokens, hclToken{kind: stringToken, text: text.String(), line: i, start: col, end: end})
+				col = end
+			case strings.HasPrefix(line[col:], "<<"):
+				// Heredocs end with a line that holds only their delimiter
+				delimiter := strings.TrimSpace(strings.TrimPrefix(line[col+2:], "-"))
+				tokens = append(tokens, hclToken{kind: otherToken, text: line[col:], line: i, start: col, end: len(line)})
+				for i+1 < len(lines) && strings.TrimSpace(lines[i+1]) != delimiter {
+					i++
+				}
+				i++
+				col = len(line)
+			case isIdentChar(c):
+				end := col
+				for end < len(line) && (isIdentChar(line[end]) || line[end] == '.') {
+					end++
+				}
+				tokens = append(tokens, hclToken{kind: identToken, text: line[col:end], line: i, start: col, end: end})
+				col = end
+			default:
+				tokens = append(tokens, hclToken{kind: punctToken, text: line[col : col+1], line: i, start: col, end: col + 1})
+				col++
+			}
+		}
+		tokens = append(tokens, hclToken{kind: newlineToken, line: i, start: len(line), end: len(line)})
+	}
+	return tokens
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '-' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// hclParser reads the blocks and attributes of tokens
+type hclParser struct {
+	tokens []hclToken
+	pos    int
+}
+
+// parseHCL reads the top level blocks and attributes of HCL content
+func parseHCL(content string) *hclBlock {
+	p := &hclParser{tokens: tokenize(content)}
+	root := &hclBlock{}
+	root.attrs, root.blocks = p.parseBody()
+	return root
+}
+
+func (p *hclParser) peek() *hclToken {
+	if p.pos >= len(p.tokens) {
+		return nil
+	}
+	return &p.tokens[p.pos]
+}
+
+func (p *hclParser) isPunct(text string) bool {
+	token := p.peek()
+	return token != nil && token.kind == punctToken && token.text == text
+}
+
+func (p *hclParser) skipNewlines() {
+	for token := p.peek(); token != nil && token.kind == newlineToken; token = p.peek() {
+		p.pos++
+	}
+}
+
+// parseBody reads attributes and blocks until the closing brace of the body or the end of the file
+func (p *hclParser) parseBody() ([]*hclAttribute, []*hclBlock) {
+	var attrs []*hclAttribute
+	var blocks []*hclBlock
+	for {
+		p.skipNewlines()
+		token := p.peek()
+		if token == nil {
+			return attrs, blocks
+		}
+		if p.isPunct("}") {
+			p.pos++
+			return attrs, blocks
+		}
+		if token.kind != identToken {
+			p.pos++
+			continue
+		}
+
+		name := *token
+		p.pos++
+		if p.isPunct("=") {
+			p.pos++
+			attrs = append(attrs, p.newAttribute(name, p.parseExpression()))
+			continue
+		}
+
+		// Blocks have a type and labels, e.g. module "vpc" {
+		block := &hclBlock{kind: name.text, line: name.line, start: name.start, end: name.end}
+		for token := p.peek(); token != nil && (token.kind == stringToken || token.kind == identToken); token = p.peek() {
+			block.labels = append(block.labels, token.text)
+			block.end = token.end
+			p.pos++
+		}
+		if !p.isPunct("{") {
+			continue
+		}
+		block.end = p.peek().end
+		p.pos++
+		block.attrs, block.blocks = p.parseBody()
+		blocks = append(blocks, block)
+	}
+}
+
+// newAttribute locates an attribute from its name to the end of its value, or to the end of its first line
+// for objects and lists that span several lines
+func (p *hclParser) newAttribute(name hclToken, value *hclValue) *hclAttribute {
+	attr := &hclAttribute{name: name.text, value: value, line: name.line, start: name.start, end: name.end}
+	if value.line == name.line {
+		attr.end = value.end
+	}
+	return attr
+}
+
+// parseExpression reads a string, an object or a list and skips other expressions
+func (p *hclParser) parseExpression() *hclValue {
+	token := p.peek()
+	if token == nil {
+		return &hclValue{kind: otherValue}
+	}
+	value := &hclValue{kind: otherValue, line: token.line, end: token.end}
+
+	switch {
+	case token.kind == stringToken:
+		value.kind, value.text = stringValue, token.text
+		p.pos++
+	case p.isPunct("{"):
+		// { source = "hashicorp/aws", version = "~> 5.0" }
+		value.kind = objectValue
+		p.pos++
+		for {
+			for p.isPunct(",") || (p.peek() != nil && p.peek().kind == newlineToken) {
+				p.pos++
+			}
+			key := p.peek()
+			if key == nil {
+				return value
+			}
+			if p.isPunct("}") {
+				if key.line == value.line {
+					value.end = key.end
+				}
+				p.pos++
+				return value
+			}
+			p.pos++
+			if (key.kind == identToken || key.kind == stringToken) && (p.isPunct("=") || p.isPunct(":")) {
+				p.pos++
+				value.attrs = append(value.attrs, p.newAttribute(*key, p.parseExpression()))
+			}
+		}
+	case p.isPunct("["):
+		value.kind = listValue
+		p.pos++
+		for {
+			for p.isPunct(",") || (p.peek() != nil && p.peek().kind == newlineToken) {
+				p.pos++
+			}
+			item := p.peek()
+			if item == nil {
+				return value
+			}
+			if p.isPunct("]") {
+				if item.line == value.line {
+					value.end = item.end
+				}
+				p.pos++
+				return value
+			}
+			value.items = append(value.items, p.parseExpression())
+		}
+	}
+
+	// The rest of the expression, e.g. "a" + var.b or var.region, is skipped up to its end
+	depth := 0
+	for token := p.peek(); token != nil; token = p.peek() {
+		if depth == 0 && (token.kind == newlineToken || p.isPunct(",") || p.isPunct("}") || p.isPunct("]")) {
+			break
+		}
+		switch {
+		case p.isPunct("(") || p.isPunct("[") || p.isPunct("{"):
+			depth++
+		case p.isPunct(")") || p.isPunct("]") || p.isPunct("}"):
+			depth--
+		}
+		value.kind = otherValue
+		if token.line == value.line {
+			value.end = token.end
+		}
+		p.pos++
+	}
+	return value
+}
@@ -0,0 +1,59 @@
+package terraform
+
+import (
+	"testing"
+)
+
+func TestParseHCL(t *testing.T) {
+	content := `# comment
+locals {
+  description = <<-EOT
+    module "ignored" {
+      source = "not/a/module"
+    }
+  EOT
+  region = var.region // comment
+  tags   = merge(var.tags, { "Name" = "x" })
+}
+
+/* block
+comment */
+provider "aws" {
+  region = local.region
+}
+`
+	root := parseHCL(content)
+	if len(root.blocks) != 2 {
+		t.Fatalf("Expected 2 blocks, got %d", len(root.blocks))
+	}
+
+	locals := root.blocks[0]
+	if locals.kind != "locals" || len(locals.attrs) != 3 {
+		t.Fatalf("Expected locals with 3 attributes, got %s with %d", locals.kind, len(locals.attrs))
+	}
+	if attr := findAttribute(locals.attrs, "tags"); attr == nil || attr.value.kind != otherValue || attr.line != 8 {
+		t.Errorf("Expected tags to be an expression on line 8, got %+v", attr)
+	}
+
+	provider := root.blocks[1]
+	if provider.kind != "provider" || len(provider.labels) != 1 || provider.labels[0] != "aws" {
+		t.Errorf("Expected provider \"aws\", got %s %v", provider.kind, provider.labels)
+	}
+	if provider.line != 13 || provider.start != 0 || provider.end != 16 {
+		t.Errorf("Unexpected provider location: line %d, %d-%d", provider.line, provider.start, provider.end)
+	}
+}
+
+func TestParseHCL_Object(t *testing.T) {
+	root := parseHCL(`aws = { source = "hashicorp/aws", version = "~> 5.0" }`)
+	attr := findAttribute(root.attrs, "aws")
+	if attr == nil || attr.value.kind != objectValue {
+		t.Fatalf("Expected aws to be an object, got %+v", attr)
+	}
+	if source := stringAttribute(attr.value.attrs, "source"); source != "hashicorp/aws" {
+		t.Errorf("Expected source hashicorp/aws, got %q", source)
+	}
+	if attr.start != 0 || attr.end != 54 {
+		t.Errorf("Unexpected location: %d-%d", attr.start, attr.end)
+	}
+}
@@ -0,0 +1,133 @@
+package terraform
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// TerraformLockParser implements parsing of Terraform dependency lock files (.terraform.lock.hcl)
+type TerraformLockParser struct{}
+
+// TerraformLock is the file name of Terraform dependency lock files
+const TerraformLock = ".terraform.lock.hcl"
+
+// defaultRegistry is the host of provider and module addresses without a host
+const defaultRegistry = "registry.terraform.io"
+
+// providerAddress is the source address of a provider, e.g. registry.terraform.io/hashicorp/aws
+type providerAddress struct {
+	host string
+	name string
+}
+
+// parseProviderAddress reads a provider source address, e.g. hashicorp/aws or registry.opentofu.org/hashicorp/aws
+func parseProviderAddress(source string) (providerAddress, bool) {
+	parts := strings.Split(strings.ToLower(strings.TrimSpace(source)), "/")
+	switch len(parts) {
+	case 2:
+		return providerAddress{host: defaultRegistry, name: parts[0] + "/" + parts[1]}, parts[0] != "" && parts[1] != ""
+	case 3:
+		return providerAddress{host: parts[0], name: parts[1] + "/" + parts[2]}, parts[0] != "" && parts[1] != "" && parts[2] != ""
+	}
+	return providerAddress{}, false
+}
+
+// String returns the fully qualified address, which identifies the provider in the lock file
+func (a providerAddress) String() string {
+	return a.host + "/" + a.name
+}
+
+// metadata names the registry of providers that are not installed from the default registry
+func (a providerAddress) metadata() map[string]string {
+	if a.host == defaultRegistry {
+		return nil
+	}
+	return map[string]string{"registry": a.host}
+}
+
+// lockedProvider is a provider block of the lock file
+type lockedProvider struct {
+	address   providerAddress
+	version   string
+	hashes    []string
+	locations []models.Location
+}
+
+// providerHashes converts the hashes of a provider: zh: hashes are the hex encoded SHA-256 of the release archives,
+// h1: hashes of the unpacked archives have no subresource integrity form and are kept as they are
+func providerHashes(hashes []string) []string {
+	var converted []string
+	for _, hash := range hashes {
+		if encoded, ok := strings.CutPrefix(hash, "zh:"); ok {
+			converted = append(converted, pkgutil.HexSRIHash("sha256", encoded)...)
+			continue
+		}
+		converted = append(converted, hash)
+	}
+	return converted
+}
+
+// readLockedProviders reads the provider blocks of a lock file
+func readLockedProviders(lockFile string) ([]lockedProvider, error) {
+	content, err := os.ReadFile(lockFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	var providers []lockedProvider
+	for _, block := range parseHCL(string(content)).childBlocks("provider") {
+		if len(block.labels) == 0 {
+			continue
+		}
+		address, ok := parseProviderAddress(block.labels[0])
+		if !ok {
+			continue
+		}
+
+		locked := lockedProvider{
+			address:   address,
+			version:   stringAttribute(block.attrs, "version"),
+			locations: []models.Location{{Line: block.line, StartIndex: block.start, EndIndex: block.end}},
+		}
+		if version := findAttribute(block.attrs, "version"); version != nil {
+			locked.locations = append(locked.locations, models.Location{Line: version.line, StartIndex: version.start, EndIndex: version.end})
+		}
+		if hashes := findAttribute(block.attrs, "hashes"); hashes != nil {
+			var values []string
+			for _, item := range hashes.value.items {
+				if item.kind == stringValue {
+					values = append(values, item.text)
+				}
+			}
+			locked.hashes = providerHashes(values)
+		}
+		providers = append(providers, locked)
+	}
+	return providers, nil
+}
+
+// Parse implements the Parser interface for .terraform.lock.hcl files
+func (p *TerraformLockParser) Parse(manifestFile string) ([]models.Package, error) {
+	providers, err := readLockedProviders(manifestFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var packages []models.Package
+	for _, provider := range providers {
+		packages = append(packages, models.Package{
+			PackageManager: providerPackageManager,
+			PackageName:    provider.address.name,
+			Version:        provider.version,
+			FilePath:       manifestFile,
+			Locations:      provider.locations,
+			Hashes:         provider.hashes,
+			Metadata:       provider.address.metadata(),
+		})
+	}
+	return packages, nil
+}
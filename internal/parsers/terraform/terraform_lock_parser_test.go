@@ -0,0 +1,57 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestTerraformLockParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/.terraform.lock.hcl"
+
+	packages, err := (&TerraformLockParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "terraform-provider", PackageName: "hashicorp/aws", Version: "5.31.0", FilePath: manifestFile, Locations: []models.Location{{Line: 3, StartIndex: 0, EndIndex: 48}, {Line: 4, StartIndex: 2, EndIndex: 24}}},
+		{PackageManager: "terraform-provider", PackageName: "vancluever/acme", Version: "2.19.0", FilePath: manifestFile, Locations: []models.Location{{Line: 12, StartIndex: 0, EndIndex: 50}, {Line: 13, StartIndex: 2, EndIndex: 20}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	expectedHashes := []string{"h1:ltxyuBWIy9cq0kIKDJH1jeWJy/y7XJLjS4QrsQK4plA=", "sha256-DNucIIO/CQJEI4T3MJNneR5GQFgWUt2kVvLW16vw3o0="}
+	if len(packages[0].Hashes) != len(expectedHashes) || packages[0].Hashes[0] != expectedHashes[0] || packages[0].Hashes[1] != expectedHashes[1] {
+		t.Errorf("aws Hashes: got %v, want %v", packages[0].Hashes, expectedHashes)
+	}
+	if packages[0].Metadata != nil {
+		t.Errorf("aws Metadata: got %v, want nil", packages[0].Metadata)
+	}
+	if registry := packages[1].Metadata["registry"]; registry != "registry.opentofu.org" {
+		t.Errorf("acme registry: got %q, want %q", registry, "registry.opentofu.org")
+	}
+}
+
+func TestParseProviderAddress(t *testing.T) {
+	tests := []struct {
+		source string
+		want   providerAddress
+		ok     bool
+	}{
+		{"hashicorp/aws", providerAddress{host: "registry.terraform.io", name: "hashicorp/aws"}, true},
+		{"Registry.OpenTofu.org/Vancluever/ACME", providerAddress{host: "registry.opentofu.org", name: "vancluever/acme"}, true},
+		{"aws", providerAddress{}, false},
+		{"a/b/c/d", providerAddress{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseProviderAddress(tt.source)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("parseProviderAddress(%q) = %+v, %v; want %+v, %v", tt.source, got, ok, tt.want, tt.ok)
+		}
+	}
+}
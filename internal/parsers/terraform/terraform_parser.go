@@ -0,0 +1,210 @@
+package terraform
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// TerraformParser implements parsing of the providers and modules of Terraform configuration files (*.tf)
+type TerraformParser struct{}
+
+// Package managers of providers and modules, which are published to separate namespaces of the registries
+const (
+	providerPackageManager = "terraform-provider"
+	modulePackageManager   = "terraform-module"
+)
+
+// registryModulePattern matches registry module sources, e.g. terraform-aws-modules/vpc/aws or app.terraform.io/acme/vpc/aws//modules/endpoints
+var registryModulePattern = regexp.MustCompile(`^(?:([a-zA-Z0-9.-]+\.[a-zA-Z]+(?::\d+)?)/)?([\w-]+/[\w-]+/[\w-]+)(?://(.+))?$`)
+
+// gitHosts are the prefixes of module sources that Terraform fetches with git
+var gitHosts = []string{"git::", "github.com/", "bitbucket.org/", "git@"}
+
+// exactVersion returns the version a constraint pins, e.g. 5.31.0 or = 5.31.0, or "" for constraints such as ~> 5.0
+func exactVersion(constraint string) string {
+	constraint = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(constraint), "="))
+	if constraint == "" || strings.ContainsAny(constraint, "~<>!=, ") {
+		return ""
+	}
+	return constraint
+}
+
+// loadLockedVersions reads the .terraform.lock.hcl next to a configuration file and maps provider addresses to their locked versions
+func loadLockedVersions(manifestFile string) map[string]string {
+	lockFile := filepath.Join(filepath.Dir(manifestFile), TerraformLock)
+	if _, err := os.Stat(lockFile); err != nil {
+		return nil
+	}
+	providers, err := readLockedProviders(lockFile)
+	if err != nil {
+		return nil
+	}
+
+	locked := make(map[string]string)
+	for _, provider := range providers {
+		locked[provider.address.String()] = provider.version
+	}
+	return locked
+}
+
+// attributeLocation returns the location of an attribute
+func attributeLocation(attr *hclAttribute) models.Location {
+	return models.Location{Line: attr.line, StartIndex: attr.start, EndIndex: attr.end}
+}
+
+// providerPackages reads required_providers, e.g. aws = { source = "hashicorp/aws", version = "~> 5.0" }.
+// Providers required by their version only, e.g. aws = "~> 2.0", are published by HashiCorp.
+func providerPackages(manifestFile string, requirements *hclBlock, locked map[string]string) []models.Package {
+	var packages []models.Package
+	for _, attr := range requirements.attrs {
+		source, constraint := "hashicorp/"+attr.name, ""
+		locations := []models.Location{attributeLocation(attr)}
+		switch attr.value.kind {
+		case stringValue:
+			constraint = attr.value.text
+		case objectValue:
+			if value := stringAttribute(attr.value.attrs, "source"); value != "" {
+				source = value
+			}
+			constraint = stringAttribute(attr.value.attrs, "version")
+			if version := findAttribute(attr.value.attrs, "version"); version != nil && version.line != attr.line {
+				locations = append(locations, attributeLocation(version))
+			}
+		default:
+			continue
+		}
+
+		address, ok := parseProviderAddress(source)
+		if !ok {
+			continue
+		}
+
+		// Exact versions pin the provider, constraints are resolved by the lock file
+		version := exactVersion(constraint)
+		if lockedVersion, ok := locked[address.String()]; ok && version == "" {
+			version = lockedVersion
+		}
+		if version == "" {
+			version = "latest"
+		}
+
+		packages = append(packages, models.Package{
+			PackageManager: providerPackageManager,
+			PackageName:    address.name,
+			Version:        version,
+			FilePath:       manifestFile,
+			Locations:      locations,
+			Metadata:       address.metadata(),
+		})
+	}
+	return packages
+}
+
+// gitModule describes a module fetched with git, e.g. git::https://example.com/network.git//vpc?ref=v1.2.0,
+// and returns its repository and ref
+func gitModule(source string, metadata map[string]string) (string, string, bool) {
+	matched := false
+	for _, prefix := range gitHosts {
+		matched = matched || strings.HasPrefix(source, prefix)
+	}
+	if !matched {
+		return "", "", false
+	}
+
+	repository, query, _ := strings.Cut(strings.TrimPrefix(source, "git::"), "?")
+	// A double slash after the scheme separates the repository from a directory within it
+	schemeEnd := 0
+	if i := strings.Index(repository, "://"); i >= 0 {
+		schemeEnd = i + 3
+	}
+	if i := strings.Index(repository[schemeEnd:], "//"); i >= 0 {
+		metadata["gitPath"] = repository[schemeEnd+i+2:]
+		repository = repository[:schemeEnd+i]
+	}
+
+	var ref string
+	for _, param := range strings.Split(query, "&") {
+		if value, ok := strings.CutPrefix(param, "ref="); ok {
+			ref = value
+		}
+	}
+
+	metadata["source"] = "git"
+	metadata["git"] = repository
+	if ref != "" {
+		metadata["ref"] = ref
+	}
+	return repository, ref, true
+}
+
+// modulePackage reads a module call from a registry or a git repository. Modules in local directories are not packages.
+func modulePackage(manifestFile string, block *hclBlock) (models.Package, bool) {
+	source := findAttribute(block.attrs, "source")
+	if source == nil || source.value.kind != stringValue || len(block.labels) == 0 {
+		return models.Package{}, false
+	}
+
+	metadata := map[string]string{"module": block.labels[0]}
+	var name, version string
+	if match := registryModulePattern.FindStringSubmatch(source.value.text); match != nil {
+		// Registry modules are versioned by a constraint, which is not locked
+		name, version = match[2], exactVersion(stringAttribute(block.attrs, "version"))
+		if match[1] != "" && match[1] != defaultRegistry {
+			metadata["registry"] = match[1]
+		}
+		if match[3] != "" {
+			metadata["path"] = match[3]
+		}
+	} else if repository, ref, ok := gitModule(source.value.text, metadata); ok {
+		name, version = repository, ref
+	} else {
+		return models.Package{}, false
+	}
+	if version == "" {
+		version = "latest"
+	}
+
+	locations := []models.Location{{Line: block.line, StartIndex: block.start, EndIndex: block.end}, attributeLocation(source)}
+	if versionAttr := findAttribute(block.attrs, "version"); versionAttr != nil {
+		locations = append(locations, attributeLocation(versionAttr))
+	}
+
+	return models.Package{
+		PackageManager: modulePackageManager,
+		PackageName:    name,
+		Version:        version,
+		FilePath:       manifestFile,
+		Locations:      locations,
+		Metadata:       metadata,
+	}, true
+}
+
+// Parse implements the Parser interface for Terraform configuration files
+func (p *TerraformParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	locked := loadLockedVersions(manifestFile)
+
+	var packages []models.Package
+	for _, block := range parseHCL(string(content)).blocks {
+		switch block.kind {
+		case "terraform":
+			for _, requirements := range block.childBlocks("required_providers") {
+				packages = append(packages, providerPackages(manifestFile, requirements, locked)...)
+			}
+		case "module":
+			if pkg, ok := modulePackage(manifestFile, block); ok {
+				packages = append(packages, pkg)
+			}
+		}
+	}
+	return packages, nil
+}
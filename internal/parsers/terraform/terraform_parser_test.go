@@ -0,0 +1,101 @@
+package terraform
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestTerraformParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/main.tf"
+
+	packages, err := (&TerraformParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "terraform-provider", PackageName: "hashicorp/aws", Version: "5.31.0", FilePath: manifestFile, Locations: []models.Location{{Line: 4, StartIndex: 4, EndIndex: 11}, {Line: 6, StartIndex: 6, EndIndex: 24}}},
+		{PackageManager: "terraform-provider", PackageName: "hashicorp/random", Version: "3.6.0", FilePath: manifestFile, Locations: []models.Location{{Line: 8, StartIndex: 4, EndIndex: 14}, {Line: 10, StartIndex: 6, EndIndex: 23}}},
+		{PackageManager: "terraform-provider", PackageName: "vancluever/acme", Version: "2.19.0", FilePath: manifestFile, Locations: []models.Location{{Line: 12, StartIndex: 4, EndIndex: 12}}},
+		{PackageManager: "terraform-provider", PackageName: "hashicorp/legacy", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 15, StartIndex: 4, EndIndex: 21}}},
+		{PackageManager: "terraform-module", PackageName: "terraform-aws-modules/vpc/aws", Version: "5.5.1", FilePath: manifestFile, Locations: []models.Location{{Line: 20, StartIndex: 0, EndIndex: 14}, {Line: 21, StartIndex: 2, EndIndex: 43}, {Line: 22, StartIndex: 2, EndIndex: 19}}},
+		{PackageManager: "terraform-module", PackageName: "acme/vpc/aws", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 25, StartIndex: 0, EndIndex: 20}, {Line: 26, StartIndex: 2, EndIndex: 62}, {Line: 27, StartIndex: 2, EndIndex: 20}}},
+		{PackageManager: "terraform-module", PackageName: "https://example.com/network.git", Version: "v1.2.0", FilePath: manifestFile, Locations: []models.Location{{Line: 30, StartIndex: 0, EndIndex: 18}, {Line: 31, StartIndex: 2, EndIndex: 65}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	expectedMetadata := []map[string]string{
+		nil,
+		nil,
+		{"registry": "registry.opentofu.org"},
+		nil,
+		{"module": "vpc"},
+		{"module": "endpoints", "registry": "app.terraform.io", "path": "modules/endpoints"},
+		{"module": "network", "source": "git", "git": "https://example.com/network.git", "gitPath": "vpc", "ref": "v1.2.0"},
+	}
+	for i, want := range expectedMetadata {
+		if !reflect.DeepEqual(packages[i].Metadata, want) {
+			t.Errorf("%s Metadata: got %v, want %v", packages[i].PackageName, packages[i].Metadata, want)
+		}
+	}
+}
+
+func TestTerraformParser_Parse_WithoutLockFile(t *testing.T) {
+	manifestFile := filepath.Join(t.TempDir(), "versions.tf")
+	content := `terraform {
+  required_providers {
+    aws    = { source = "hashicorp/aws", version = "~> 5.0" }
+    google = { source = "hashicorp/google", version = "= 5.10.0" }
+  }
+}
+
+module "consul" {
+  source = "github.com/hashicorp/example?ref=v0.1.0"
+}
+
+module "bucket" {
+  source = "s3::https://s3-eu-west-1.amazonaws.com/examplecorp/vpc.zip"
+}
+`
+	if err := os.WriteFile(manifestFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+
+	packages, err := (&TerraformParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedPackages := []models.Package{
+		{PackageManager: "terraform-provider", PackageName: "hashicorp/aws", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 2, StartIndex: 4, EndIndex: 61}}},
+		{PackageManager: "terraform-provider", PackageName: "hashicorp/google", Version: "5.10.0", FilePath: manifestFile, Locations: []models.Location{{Line: 3, StartIndex: 4, EndIndex: 66}}},
+		{PackageManager: "terraform-module", PackageName: "github.com/hashicorp/example", Version: "v0.1.0", FilePath: manifestFile, Locations: []models.Location{{Line: 7, StartIndex: 0, EndIndex: 17}, {Line: 8, StartIndex: 2, EndIndex: 52}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+}
+
+func TestExactVersion(t *testing.T) {
+	tests := map[string]string{
+		"5.31.0":        "5.31.0",
+		"= 5.31.0":      "5.31.0",
+		"~> 5.0":        "",
+		">= 1.0, < 2.0": "",
+		"!= 1.2.0":      "",
+		"":              "",
+	}
+	for constraint, want := range tests {
+		if got := exactVersion(constraint); got != want {
+			t.Errorf("exactVersion(%q) = %q; want %q", constraint, got, want)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package vcpkg
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/internal/jsonutil"
+	"github.com/Checkmarx/manifest-parser/internal/pkgutil"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// VcpkgJsonParser implements parsing of vcpkg manifests (vcpkg.json)
+type VcpkgJsonParser struct{}
+
+// VcpkgJson is the file name of vcpkg manifests
+const VcpkgJson = "vcpkg.json"
+
+// dependenciesSection is the scope of the dependencies of the manifest itself, features are scoped by their name
+const dependenciesSection = "dependencies"
+
+// overridesSection is the scope of overrides of ports that are not dependencies of the manifest
+const overridesSection = "overrides"
+
+// versionKeys are the members an override pins its version with, one per versioning scheme
+var versionKeys = []string{"version", "version-semver", "version-date", "version-string"}
+
+// dependency is a dependency of vcpkg.json, either a port name or an object
+type dependency struct {
+	Name            string            `json:"name"`
+	MinimumVersion  string            `json:"version>="`
+	Features        []json.RawMessage `json:"features"`
+	DefaultFeatures *bool             `json:"default-features"`
+	Host            bool              `json:"host"`
+	Platform        string            `json:"platform"`
+
+	// byName is set for dependencies written as their port name
+	byName bool
+}
+
+// UnmarshalJSON reads a dependency written as its port name, e.g. "fmt"
+func (d *dependency) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*d = dependency{Name: name, byName: true}
+		return nil
+	}
+	type object dependency
+	return json.Unmarshal(data, (*object)(d))
+}
+
+// featureNames returns the names of the requested features, written as names or as objects with a platform
+func (d dependency) featureNames() []string {
+	var names []string
+	for _, raw := range d.Features {
+		var feature struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(raw, &feature.Name); err != nil {
+			if err := json.Unmarshal(raw, &feature); err != nil {
+				continue
+			}
+		}
+		if feature.Name != "" {
+			names = append(names, feature.Name)
+		}
+	}
+	return names
+}
+
+// vcpkgJSON represents the vcpkg.json structure
+type vcpkgJSON struct {
+	BuiltinBaseline string       `json:"builtin-baseline"`
+	Dependencies    []dependency `json:"dependencies"`
+	Overrides       []struct {
+		Name          string `json:"name"`
+		Version       string `json:"version"`
+		VersionSemver string `json:"version-semver"`
+		VersionDate   string `json:"version-date"`
+		VersionString string `json:"version-string"`
+		PortVersion   int    `json:"port-version"`
+	} `json:"overrides"`
+	Features map[string]struct {
+		Dependencies []dependency `json:"dependencies"`
+	} `json:"features"`
+}
+
+// pinnedVersion is the version of an override and the member that pins it
+type pinnedVersion struct {
+	index       int
+	version     string
+	versionKey  string
+	portVersion int
+}
+
+// Parse implements the Parser interface for vcpkg.json files
+func (p *VcpkgJsonParser) Parse(manifestFile string) ([]models.Package, error) {
+	content, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	var manifest vcpkgJSON
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	locations, err := jsonutil.FindLocations(content)
+	if err != nil {
+		return nil, err
+	}
+
+	// Overrides pin a port to an exact version, wherever it is required from
+	overrides := make(map[string]pinnedVersion)
+	for i, o := range manifest.Overrides {
+		for j, version := range []string{o.Version, o.VersionSemver, o.VersionDate, o.VersionString} {
+			if version != "" {
+				overrides[o.Name] = pinnedVersion{index: i, version: version, versionKey: versionKeys[j], portVersion: o.PortVersion}
+				break
+			}
+		}
+	}
+
+	var packages []models.Package
+	declared := make(map[string]bool)
+	addPackage := func(dep dependency, path []string, scope string) {
+		if dep.Name == "" {
+			return
+		}
+		declared[dep.Name] = true
+
+		// Ports written as their name are located by the name, objects by their name and minimum version
+		members := [][]string{{"name"}, {"version>="}}
+		if dep.byName {
+			members = [][]string{nil}
+		}
+		var depLocations []models.Location
+		for _, member := range members {
+			if location, ok := locations.Find(append(append([]string{}, path...), member...)...); ok {
+				depLocations = append(depLocations, location)
+			}
+		}
+
+		metadata := make(map[string]string)
+		if manifest.BuiltinBaseline != "" {
+			metadata["baseline"] = manifest.BuiltinBaseline
+		}
+		if features := dep.featureNames(); len(features) > 0 {
+			metadata["features"] = strings.Join(features, ",")
+		}
+		if dep.DefaultFeatures != nil && !*dep.DefaultFeatures {
+			metadata["defaultFeatures"] = "false"
+		}
+		if dep.Host {
+			metadata["host"] = "true"
+		}
+		if dep.Platform != "" {
+			metadata["platform"] = dep.Platform
+		}
+		if dep.MinimumVersion != "" {
+			metadata["minimumVersion"] = dep.MinimumVersion
+		}
+
+		// vcpkg resolves the version from the baseline, it is only known when an override pins it
+		version := "latest"
+		if pinned, ok := overrides[dep.Name]; ok {
+			version = pinned.version
+			metadata["overridden"] = "true"
+			if pinned.portVersion > 0 {
+				metadata["portVersion"] = strconv.Itoa(pinned.portVersion)
+			}
+			if location, ok := locations.Find(overridesSection, strconv.Itoa(pinned.index), pinned.versionKey); ok {
+				depLocations = append(depLocations, location)
+			}
+		}
+		if len(metadata) == 0 {
+			metadata = nil
+		}
+
+		packages = append(packages, models.Package{
+			PackageManager: "vcpkg",
+			PackageName:    dep.Name,
+			Version:        version,
+			FilePath:       manifestFile,
+			Locations:      depLocations,
+			Scopes:         []string{scope},
+			Metadata:       metadata,
+		})
+	}
+
+	for i, dep := range manifest.Dependencies {
+		addPackage(dep, []string{dependenciesSection, strconv.Itoa(i)}, dependenciesSection)
+	}
+	for feature, definition := range manifest.Features {
+		for i, dep := range definition.Dependencies {
+			addPackage(dep, []string{"features", feature, dependenciesSection, strconv.Itoa(i)}, feature)
+		}
+	}
+
+	// Overrides of ports that are not declared pin dependencies of dependencies
+	for i, o := range manifest.Overrides {
+		pinned, ok := overrides[o.Name]
+		if !ok || pinned.index != i || declared[o.Name] {
+			continue
+		}
+		metadata := map[string]string{"overridden": "true"}
+		if manifest.BuiltinBaseline != "" {
+			metadata["baseline"] = manifest.BuiltinBaseline
+		}
+		if pinned.portVersion > 0 {
+			metadata["portVersion"] = strconv.Itoa(pinned.portVersion)
+		}
+		var overrideLocations []models.Location
+		for _, member := range []string{"name", pinned.versionKey} {
+			if location, ok := locations.Find(overridesSection, strconv.Itoa(i), member); ok {
+				overrideLocations = append(overrideLocations, location)
+			}
+		}
+		packages = append(packages, models.Package{
+			PackageManager: "vcpkg",
+			PackageName:    o.Name,
+			Version:        pinned.version,
+			FilePath:       manifestFile,
+			Locations:      overrideLocations,
+			Scopes:         []string{overridesSection},
+			Metadata:       metadata,
+		})
+	}
+
+	// Features are read from an object, the packages are put back in the order of the manifest
+	pkgutil.SortByFirstLine(packages)
+	return packages, nil
+}
@@ -0,0 +1,56 @@
+package vcpkg
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestVcpkgJsonParser_Parse(t *testing.T) {
+	manifestFile := "../../../internal/testdata/vcpkg.json"
+
+	packages, err := (&VcpkgJsonParser{}).Parse(manifestFile)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Versions come from the baseline, only overrides pin them
+	expectedPackages := []models.Package{
+		{PackageManager: "vcpkg", PackageName: "fmt", Version: "10.1.1", FilePath: manifestFile, Locations: []models.Location{{Line: 6, StartIndex: 4, EndIndex: 10}, {Line: 26, StartIndex: 21, EndIndex: 40}}},
+		{PackageManager: "vcpkg", PackageName: "boost-asio", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 8, StartIndex: 6, EndIndex: 27}, {Line: 9, StartIndex: 6, EndIndex: 27}}},
+		{PackageManager: "vcpkg", PackageName: "curl", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 12, StartIndex: 6, EndIndex: 21}}},
+		{PackageManager: "vcpkg", PackageName: "protobuf", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 16, StartIndex: 6, EndIndex: 25}}},
+		{PackageManager: "vcpkg", PackageName: "openssl", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 17, StartIndex: 6, EndIndex: 24}}},
+		{PackageManager: "vcpkg", PackageName: "gtest", Version: "latest", FilePath: manifestFile, Locations: []models.Location{{Line: 22, StartIndex: 23, EndIndex: 30}}},
+		{PackageManager: "vcpkg", PackageName: "zlib", Version: "1.3", FilePath: manifestFile, Locations: []models.Location{{Line: 27, StartIndex: 6, EndIndex: 21}, {Line: 27, StartIndex: 22, EndIndex: 39}}},
+	}
+
+	testdata.ValidatePackages(t, packages, expectedPackages)
+	if len(packages) != len(expectedPackages) {
+		return
+	}
+
+	wantScopes := map[string]string{"fmt": "dependencies", "gtest": "tests", "zlib": "overrides"}
+	for _, pkg := range packages {
+		if want, ok := wantScopes[pkg.PackageName]; ok && !reflect.DeepEqual(pkg.Scopes, []string{want}) {
+			t.Errorf("%s Scopes: got %v, want [%s]", pkg.PackageName, pkg.Scopes, want)
+		}
+	}
+
+	baseline := "3426db05b996481ca31e95fff3734cf23e0f51bc"
+	wantMetadata := map[string]map[string]string{
+		"fmt":        {"baseline": baseline, "overridden": "true"},
+		"boost-asio": {"baseline": baseline, "minimumVersion": "1.83.0"},
+		"curl":       {"baseline": baseline, "features": "ssl,http2", "defaultFeatures": "false"},
+		"protobuf":   {"baseline": baseline, "host": "true"},
+		"openssl":    {"baseline": baseline, "platform": "linux | osx"},
+		"zlib":       {"baseline": baseline, "overridden": "true", "portVersion": "1"},
+	}
+	for _, pkg := range packages {
+		if want, ok := wantMetadata[pkg.PackageName]; ok && !reflect.DeepEqual(pkg.Metadata, want) {
+			t.Errorf("%s Metadata: got %v, want %v", pkg.PackageName, pkg.Metadata, want)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package pkgutil
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+// SRIHash returns the subresource integrity form of a base64 encoded digest, e.g. sha512-<base64>, or nil if it is empty
+func SRIHash(algorithm, digest string) []string {
+	if digest == "" {
+		return nil
+	}
+	return []string{algorithm + "-" + digest}
+}
+
+// HexSRIHash converts a hex encoded digest into its subresource integrity form, or returns nil if it is not valid hex
+func HexSRIHash(algorithm, digest string) []string {
+	raw, err := hex.DecodeString(strings.TrimSpace(digest))
+	if err != nil || len(raw) == 0 {
+		return nil
+	}
+	return SRIHash(algorithm, base64.StdEncoding.EncodeToString(raw))
+}
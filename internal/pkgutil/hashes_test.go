@@ -0,0 +1,31 @@
+package pkgutil
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSRIHash(t *testing.T) {
+	if got := SRIHash("sha512", ""); got != nil {
+		t.Errorf("SRIHash() = %v, want nil", got)
+	}
+	if got, want := SRIHash("sha512", "q1w2e3=="), []string{"sha512-q1w2e3=="}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SRIHash() = %v, want %v", got, want)
+	}
+}
+
+func TestHexSRIHash(t *testing.T) {
+	tests := []struct {
+		digest string
+		want   []string
+	}{
+		{"2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", []string{"sha256-LCa0a2j/xo/5m0U8HTBBNBNCLXBkg7+g+YpeiGJm564="}},
+		{"", nil},
+		{"not-hex", nil},
+	}
+	for _, tt := range tests {
+		if got := HexSRIHash("sha256", tt.digest); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("HexSRIHash(%q) = %v, want %v", tt.digest, got, tt.want)
+		}
+	}
+}
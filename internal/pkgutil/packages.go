@@ -0,0 +1,33 @@
+// Package pkgutil holds the helpers the parsers share to build packages: ordering them and converting their hashes.
+package pkgutil
+
+import (
+	"sort"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// FirstLine returns the line of the first location, or -1 if there is none
+func FirstLine(locations []models.Location) int {
+	if len(locations) == 0 {
+		return -1
+	}
+	return locations[0].Line
+}
+
+// SortByFirstLine orders packages by the line they are first located at, keeping the order of packages on the same line
+func SortByFirstLine(packages []models.Package) {
+	sort.SliceStable(packages, func(i, j int) bool {
+		return FirstLine(packages[i].Locations) < FirstLine(packages[j].Locations)
+	})
+}
+
+// SortedKeys returns the keys of a map in a stable order
+func SortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,42 @@
+package pkgutil
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestFirstLine(t *testing.T) {
+	if got := FirstLine(nil); got != -1 {
+		t.Errorf("FirstLine(nil) = %d, want -1", got)
+	}
+	if got := FirstLine([]models.Location{{Line: 4}, {Line: 2}}); got != 4 {
+		t.Errorf("FirstLine() = %d, want 4", got)
+	}
+}
+
+func TestSortByFirstLine(t *testing.T) {
+	packages := []models.Package{
+		{PackageName: "c", Locations: []models.Location{{Line: 7}}},
+		{PackageName: "a", Locations: []models.Location{{Line: 2}}},
+		{PackageName: "none"},
+		{PackageName: "b", Locations: []models.Location{{Line: 2}}},
+	}
+	SortByFirstLine(packages)
+
+	var names []string
+	for _, pkg := range packages {
+		names = append(names, pkg.PackageName)
+	}
+	if want := []string{"none", "a", "b", "c"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("SortByFirstLine() order = %v, want %v", names, want)
+	}
+}
+
+func TestSortedKeys(t *testing.T) {
+	keys := SortedKeys(map[string]int{"net8.0": 1, "net6.0": 2, "netstandard2.0": 3})
+	if want := []string{"net6.0", "net8.0", "netstandard2.0"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("SortedKeys() = %v, want %v", keys, want)
+	}
+}
@@ -0,0 +1,226 @@
+// Package rubyutil reads the Ruby DSL of Gemfiles, gemspecs and Podfiles.
+// Only method calls with literal arguments are understood, the code is not evaluated.
+package rubyutil
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// Statement is a Ruby Statement of a Gemfile or gemspec, joined from its lines when it spans several
+type Statement struct {
+	Text      string
+	Locations []models.Location
+}
+
+// callPattern matches a method call with an optional receiver, e.g. gem "rails" or spec.add_dependency("rack")
+var callPattern = regexp.MustCompile(`^(?:[A-Za-z_][\w:]*\.)?([a-z_]\w*[!?]?)(?:\((.*)\)|\s+(.*)|)$`)
+
+// doPattern matches the opening of a do block at the end of a Statement, e.g. group :test do |g|
+var doPattern = regexp.MustCompile(`(?:^|[\s)])do(?:\s*\|[^|]*\|)?$`)
+
+// Option patterns of a method call: key: value, :key => value and "key" => value
+var (
+	keywordOptionPattern = regexp.MustCompile(`^([A-Za-z_]\w*):\s*(.*)$`)
+	hashRocketPattern    = regexp.MustCompile(`^:?["']?([A-Za-z_]\w*)["']?\s*=>\s*(.*)$`)
+)
+
+// blockKeywords start statements that are closed by "end"
+var blockKeywords = map[string]bool{
+	"if": true, "unless": true, "case": true, "while": true, "until": true,
+	"begin": true, "def": true, "class": true, "module": true, "for": true,
+}
+
+// scan walks the characters of Ruby code outside of string literals.
+// It stops when visit returns false and reports the index it stopped at, or -1.
+func scan(code string, visit func(i int, depth int) bool) int {
+	var quote byte
+	depth := 0
+	for i := 0; i < len(code); i++ {
+		c := code[i]
+		if quote != 0 {
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '"', '\'', '`':
+			quote = c
+			continue
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		}
+		if !visit(i, depth) {
+			return i
+		}
+	}
+	return -1
+}
+
+// StripComment removes a trailing # comment and trailing whitespace from a line
+func StripComment(line string) string {
+	if idx := scan(line, func(i int, _ int) bool { return line[i] != '#' }); idx >= 0 {
+		line = line[:idx]
+	}
+	return strings.TrimRight(line, " \t\r")
+}
+
+// isContinued reports whether a Statement continues on the next line
+func isContinued(code string) bool {
+	depth := 0
+	scan(code, func(_ int, d int) bool {
+		depth = d
+		return true
+	})
+	return depth > 0 || strings.HasSuffix(code, ",") || strings.HasSuffix(code, "\\")
+}
+
+// ReadStatements splits Ruby code into statements, joining lines that continue the Statement above
+func ReadStatements(lines []string) []Statement {
+	var statements []Statement
+	var current *Statement
+
+	for i, raw := range lines {
+		code := StripComment(raw)
+		content := strings.TrimSpace(code)
+		if content == "" {
+			continue
+		}
+		location := models.Location{Line: i, StartIndex: strings.Index(code, content), EndIndex: len(code)}
+
+		if current == nil {
+			statements = append(statements, Statement{})
+			current = &statements[len(statements)-1]
+		} else {
+			current.Text += " "
+		}
+		current.Text += strings.TrimSuffix(content, "\\")
+		current.Locations = append(current.Locations, location)
+
+		if !isContinued(content) {
+			current = nil
+		}
+	}
+	return statements
+}
+
+// ParseCall splits a Statement into the called method, its arguments and whether it opens a do block
+func ParseCall(text string) (method string, args []string, opensBlock bool) {
+	if loc := doPattern.FindStringIndex(text); loc != nil {
+		text = strings.TrimSpace(text[:loc[0]+strings.Index(text[loc[0]:], "do")])
+		opensBlock = true
+	}
+
+	match := callPattern.FindStringSubmatch(text)
+	if match == nil {
+		return "", nil, opensBlock
+	}
+	return match[1], SplitArguments(match[2] + match[3]), opensBlock
+}
+
+// SplitArguments splits the arguments of a method call on the commas between them
+func SplitArguments(code string) []string {
+	var args []string
+	start := 0
+	scan(code, func(i int, depth int) bool {
+		if code[i] == ',' && depth == 0 {
+			args = append(args, strings.TrimSpace(code[start:i]))
+			start = i + 1
+		}
+		return true
+	})
+	if last := strings.TrimSpace(code[start:]); last != "" {
+		args = append(args, last)
+	}
+	return args
+}
+
+// SplitOptions separates the positional arguments of a call from its hash options
+func SplitOptions(args []string) (positional []string, options map[string]string) {
+	options = make(map[string]string)
+	for _, arg := range args {
+		if match := hashRocketPattern.FindStringSubmatch(arg); match != nil {
+			options[match[1]] = match[2]
+		} else if match := keywordOptionPattern.FindStringSubmatch(arg); match != nil {
+			options[match[1]] = match[2]
+		} else {
+			positional = append(positional, arg)
+		}
+	}
+	return positional, options
+}
+
+// ParseLiteral returns the value of a string or symbol literal, e.g. "rails", 'rails', :rails or %q<rails>.freeze
+func ParseLiteral(expr string) (string, bool) {
+	expr = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(expr), ".freeze"))
+	switch {
+	case len(expr) >= 2 && (expr[0] == '"' || expr[0] == '\'') && expr[len(expr)-1] == expr[0]:
+		return expr[1 : len(expr)-1], true
+	case len(expr) >= 2 && expr[0] == ':':
+		return strings.Trim(expr[1:], `"'`), true
+	case len(expr) >= 4 && (strings.HasPrefix(expr, "%q") || strings.HasPrefix(expr, "%Q")):
+		return expr[3 : len(expr)-1], true
+	}
+	return "", false
+}
+
+// ParseList returns the values of a literal or an array of literals, e.g. [:mri, :jruby] or %w[mri jruby]
+func ParseList(expr string) []string {
+	expr = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(expr), ".freeze"))
+	if len(expr) >= 4 && (strings.HasPrefix(expr, "%w") || strings.HasPrefix(expr, "%i")) {
+		return strings.Fields(expr[3 : len(expr)-1])
+	}
+
+	items := []string{expr}
+	if strings.HasPrefix(expr, "[") && strings.HasSuffix(expr, "]") {
+		items = SplitArguments(expr[1 : len(expr)-1])
+	}
+
+	var values []string
+	for _, item := range items {
+		if value, ok := ParseLiteral(item); ok {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// ParseVersion handles version resolution for RubyGems requirements, which CocoaPods uses as well
+// - Returns the version for exact ("= 1.2.3") and bare ("1.2.3") requirements
+// - Returns "latest" for no requirement, operators ("~> 1.2", ">= 1.0") and multiple requirements
+func ParseVersion(requirements []string) string {
+	if len(requirements) != 1 {
+		return "latest"
+	}
+	requirement := strings.TrimSpace(requirements[0])
+	if exact, found := strings.CutPrefix(requirement, "="); found {
+		requirement = strings.TrimSpace(exact)
+	}
+	if requirement == "" || strings.ContainsAny(requirement, "<>~!=, ") {
+		return "latest"
+	}
+	return requirement
+}
+
+// OpensKeywordBlock reports whether a Statement starts with a keyword that is closed by "end", e.g. if or unless
+func OpensKeywordBlock(text string) bool {
+	fields := strings.Fields(text)
+	if len(fields) == 0 || !blockKeywords[fields[0]] {
+		return false
+	}
+	// One line forms such as "if x then y end" close themselves
+	return fields[len(fields)-1] != "end"
+}
+
+// IsEnd reports whether a Statement closes a block
+func IsEnd(text string) bool {
+	rest, found := strings.CutPrefix(text, "end")
+	return found && (rest == "" || strings.ContainsAny(rest[:1], ".) "))
+}
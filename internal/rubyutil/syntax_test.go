@@ -0,0 +1,88 @@
+package rubyutil
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestReadStatements(t *testing.T) {
+	lines := []string{
+		`gem "rails", "~> 7.1" # framework`,
+		``,
+		`pod 'Firebase',`,
+		`    :subspecs => ['Core', "Auth"]`,
+		`url = "https://example.com/#anchor"`,
+	}
+
+	statements := ReadStatements(lines)
+	if len(statements) != 3 {
+		t.Fatalf("Expected 3 statements, got %d", len(statements))
+	}
+
+	if statements[0].Text != `gem "rails", "~> 7.1"` {
+		t.Errorf("Text: got %q", statements[0].Text)
+	}
+	testdata.CompareLocations(t, statements[1].Locations, []models.Location{
+		{Line: 2, StartIndex: 0, EndIndex: 15},
+		{Line: 3, StartIndex: 4, EndIndex: 33},
+	})
+	if statements[2].Text != `url = "https://example.com/#anchor"` {
+		t.Errorf("A # inside a string must not start a comment, got %q", statements[2].Text)
+	}
+}
+
+func TestParseCall(t *testing.T) {
+	tests := []struct {
+		text       string
+		method     string
+		args       []string
+		opensBlock bool
+	}{
+		{`gem "rails", "~> 7.1", require: false`, "gem", []string{`"rails"`, `"~> 7.1"`, `require: false`}, false},
+		{`spec.add_dependency(%q<rack>.freeze, [">= 2", "< 4"])`, "add_dependency", []string{`%q<rack>.freeze`, `[">= 2", "< 4"]`}, false},
+		{`group :development, :test do`, "group", []string{`:development`, `:test`}, true},
+		{`target('App') do |t|`, "target", []string{`'App'`}, true},
+		{`use_frameworks!`, "use_frameworks!", nil, false},
+	}
+	for _, tt := range tests {
+		method, args, opensBlock := ParseCall(tt.text)
+		if method != tt.method || !reflect.DeepEqual(args, tt.args) || opensBlock != tt.opensBlock {
+			t.Errorf("ParseCall(%q) = %q, %q, %v; want %q, %q, %v", tt.text, method, args, opensBlock, tt.method, tt.args, tt.opensBlock)
+		}
+	}
+}
+
+func TestSplitOptionsAndParseList(t *testing.T) {
+	positional, options := SplitOptions([]string{`'Alamofire'`, `'~> 5.8'`, `:configurations => ['Debug', 'Beta']`, `platforms: %i[mri windows]`})
+	if !reflect.DeepEqual(positional, []string{`'Alamofire'`, `'~> 5.8'`}) {
+		t.Errorf("positional: got %q", positional)
+	}
+	if got := ParseList(options["configurations"]); !reflect.DeepEqual(got, []string{"Debug", "Beta"}) {
+		t.Errorf("configurations: got %q", got)
+	}
+	if got := ParseList(options["platforms"]); !reflect.DeepEqual(got, []string{"mri", "windows"}) {
+		t.Errorf("platforms: got %q", got)
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	tests := map[string][]string{
+		"1.2.3":  {"1.2.3"},
+		"2.0.1":  {"= 2.0.1"},
+		"latest": {"~> 1.2"},
+	}
+	for want, requirements := range tests {
+		if got := ParseVersion(requirements); got != want {
+			t.Errorf("ParseVersion(%q) = %q; want %q", requirements, got, want)
+		}
+	}
+	if got := ParseVersion([]string{">= 1.0", "< 2.0"}); got != "latest" {
+		t.Errorf("Multiple requirements: got %q, want latest", got)
+	}
+	if got := ParseVersion(nil); got != "latest" {
+		t.Errorf("No requirement: got %q, want latest", got)
+	}
+}
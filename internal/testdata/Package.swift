@@ -0,0 +1,24 @@
+// swift-tools-version:5.9
+import PackageDescription
+
+let package = Package(
+    name: "MyTool",
+    dependencies: [
+        .package(url: "https://github.com/apple/swift-argument-parser.git", from: "1.2.0"),
+        .package(url: "https://github.com/vapor/vapor", exact: "4.89.0"),
+        // .package(url: "https://github.com/commented/out", from: "1.0.0"),
+        .package(
+            url: "git@github.com:pointfreeco/swift-snapshot-testing.git",
+            branch: "main"
+        ),
+        .package(url: "https://github.com/apple/swift-log", .upToNextMinor(from: "1.5.0")),
+        .package(path: "../LocalKit"),
+        .package(id: "mona.LinkedList", "1.0.0"..<"2.0.0"),
+    ],
+    targets: [
+        .executableTarget(
+            name: "MyTool",
+            dependencies: [.product(name: "ArgumentParser", package: "swift-argument-parser")]
+        ),
+    ]
+)
@@ -0,0 +1,18 @@
+from conan import ConanFile
+
+
+class NativeCoreConan(ConanFile):
+    name = "native-core"
+    settings = "os", "compiler", "build_type", "arch"
+    requires = "zlib/1.2.13", "openssl/[>=3.0 <4]"
+    tool_requires = ("cmake/3.27.7",
+                     "ninja/1.11.1")  # build tools
+    # requires = "commented/1.0"
+
+    def requirements(self):
+        self.requires("boost/1.83.0@acme/stable")
+        self.requires("fmt/10.1.1", override=True)
+        self.requires(f"spdlog/{self.version}")
+
+    def build_requirements(self):
+        self.test_requires("gtest/1.14.0")
@@ -23,6 +23,9 @@ func CompareLocations(t *testing.T, got, want []models.Location) {
 		if loc.EndIndex != want[i].EndIndex {
 			t.Errorf("Location[%d].EndIndex: got %d, want %d", i, loc.EndIndex, want[i].EndIndex)
 		}
+		if loc.FilePath != want[i].FilePath {
+			t.Errorf("Location[%d].FilePath: got %q, want %q", i, loc.FilePath, want[i].FilePath)
+		}
 	}
 }
 
@@ -0,0 +1,37 @@
+terraform {
+  required_version = ">= 1.5.0"
+
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.0"
+    }
+    random = {
+      source  = "hashicorp/random"
+      version = "3.6.0"
+    }
+    acme = {
+      source = "registry.opentofu.org/vancluever/acme"
+    }
+    legacy = "~> 1.0"
+  }
+}
+
+# Modules
+module "vpc" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "5.5.1"
+}
+
+module "endpoints" {
+  source  = "app.terraform.io/acme/vpc/aws//modules/endpoints"
+  version = "~> 2.0"
+}
+
+module "network" {
+  source = "git::https://example.com/network.git//vpc?ref=v1.2.0"
+}
+
+module "local" {
+  source = "./modules/local"
+}
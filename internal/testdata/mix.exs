@@ -0,0 +1,31 @@
+defmodule Messaging.MixProject do
+  use Mix.Project
+
+  @version "0.4.0"
+
+  def project do
+    [
+      app: :messaging,
+      version: @version,
+      elixir: "~> 1.15",
+      deps: deps()
+    ]
+  end
+
+  # Run "mix help deps" to learn about dependencies.
+  defp deps do
+    [
+      {:phoenix, "~> 1.7.10"},
+      {:jason, "1.4.1"},
+      {:plug_cowboy, ">= 2.6.0", override: true},
+      {:ex_doc, "~> 0.31", only: :dev, runtime: false},
+      {:mox, "~> 1.1", only: [:test]},
+      {:broadway, git: "https://github.com/dashbitco/broadway.git", tag: "v1.0.7"},
+      {:gen_rmq,
+       github: "meltwater/gen_rmq",
+       branch: "main"},
+      {:shared, path: "../shared"},
+      {:my_uuid, "~> 2.0", hex: :uuid_utils, organization: "acme"}
+    ]
+  end
+end
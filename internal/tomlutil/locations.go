@@ -0,0 +1,166 @@
+// Package tomlutil locates TOML keys in the lines of a file.
+// The TOML decoder does not expose positions, so locations are found by scanning the lines.
+package tomlutil
+
+import (
+	"strings"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// StripComment removes a trailing TOML comment, ignoring '#' characters inside strings
+func StripComment(line string) string {
+	var quote rune
+	for i, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '#':
+			return strings.TrimSpace(line[:i])
+		}
+	}
+	return strings.TrimSpace(line)
+}
+
+// SplitKey splits a dotted TOML key into its parts, e.g. target.'cfg(unix)'.dependencies
+func SplitKey(key string) []string {
+	var parts []string
+	var current strings.Builder
+	var quote rune
+	for _, r := range key {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '.':
+			parts = append(parts, strings.TrimSpace(current.String()))
+			current.Reset()
+		case r == ' ' || r == '\t':
+			// Whitespace around dots is allowed
+		default:
+			current.WriteRune(r)
+		}
+	}
+	return append(parts, strings.TrimSpace(current.String()))
+}
+
+// HeaderPath returns the key path of a table header such as [dependencies.serde] or [[package]]
+func HeaderPath(line string) ([]string, bool) {
+	line = StripComment(line)
+	if !strings.HasPrefix(line, "[") || !strings.HasSuffix(line, "]") {
+		return nil, false
+	}
+	return SplitKey(strings.Trim(line, "[]")), true
+}
+
+// keyPath returns the key path of a key/value line such as serde = "1" or serde.workspace = true
+func keyPath(line string) ([]string, bool) {
+	var quote rune
+	for i, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '=':
+			return SplitKey(line[:i]), true
+		}
+	}
+	return nil, false
+}
+
+// hasPrefix reports whether path starts with prefix
+func hasPrefix(path, prefix []string) bool {
+	if len(path) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if path[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// bracketDepth returns the change of nesting of arrays and inline tables on a line, ignoring strings
+func bracketDepth(line string) int {
+	depth := 0
+	var quote rune
+	for _, r := range StripComment(line) {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '[' || r == '{':
+			depth++
+		case r == ']' || r == '}':
+			depth--
+		}
+	}
+	return depth
+}
+
+// lineLocation returns the location of the content of a line, without indentation and comments
+func lineLocation(lines []string, lineNum int) models.Location {
+	raw := lines[lineNum]
+	content := StripComment(raw)
+	startIdx := strings.Index(raw, content)
+	return models.Location{
+		Line:       lineNum,
+		StartIndex: startIdx,
+		EndIndex:   startIdx + len(content),
+	}
+}
+
+// FindEntryLocations finds the locations of a key inside a TOML table.
+// Inline entries (key = ...), dotted keys (key.version = ...) and sub-tables ([table.key]) are supported.
+// Values spanning several lines, such as multi-line arrays, get a location for every line.
+func FindEntryLocations(lines []string, table []string, key string) []models.Location {
+	target := append(append([]string{}, table...), key)
+	var currentTable []string
+
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if header, ok := HeaderPath(line); ok {
+			if hasPrefix(header, target) {
+				return []models.Location{lineLocation(lines, i)}
+			}
+			currentTable = header
+			continue
+		}
+
+		path, ok := keyPath(line)
+		if !ok || !hasPrefix(append(append([]string{}, currentTable...), path...), target) {
+			continue
+		}
+
+		locations := []models.Location{lineLocation(lines, i)}
+		depth := bracketDepth(line)
+		for j := i + 1; depth > 0 && j < len(lines); j++ {
+			depth += bracketDepth(lines[j])
+			if strings.TrimSpace(StripComment(lines[j])) != "" {
+				locations = append(locations, lineLocation(lines, j))
+			}
+		}
+		return locations
+	}
+	return nil
+}
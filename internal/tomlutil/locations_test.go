@@ -0,0 +1,55 @@
+package tomlutil
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestSplitKey(t *testing.T) {
+	tests := map[string][]string{
+		"dependencies":                    {"dependencies"},
+		"target.'cfg(unix)'.dependencies": {"target", "cfg(unix)", "dependencies"},
+		`workspace . "dependencies"`:      {"workspace", "dependencies"},
+		`plugins."com.example.plugin"`:    {"plugins", "com.example.plugin"},
+	}
+	for key, want := range tests {
+		if got := SplitKey(key); !reflect.DeepEqual(got, want) {
+			t.Errorf("SplitKey(%q) = %v; want %v", key, got, want)
+		}
+	}
+}
+
+func TestFindEntryLocations(t *testing.T) {
+	lines := strings.Split(`[dependencies]
+serde = "1" # comment
+tokio.version = "1"
+clap = { version = "4", features = [
+    "derive",
+] }
+
+[dependencies.rand]
+version = "0.8"`, "\n")
+
+	tests := []struct {
+		key  string
+		want []models.Location
+	}{
+		{"serde", []models.Location{{Line: 1, StartIndex: 0, EndIndex: 11}}},
+		{"tokio", []models.Location{{Line: 2, StartIndex: 0, EndIndex: 19}}},
+		{"clap", []models.Location{
+			{Line: 3, StartIndex: 0, EndIndex: 36},
+			{Line: 4, StartIndex: 4, EndIndex: 13},
+			{Line: 5, StartIndex: 0, EndIndex: 3},
+		}},
+		{"rand", []models.Location{{Line: 7, StartIndex: 0, EndIndex: 19}}},
+		{"missing", nil},
+	}
+	for _, tt := range tests {
+		if got := FindEntryLocations(lines, []string{"dependencies"}, tt.key); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("FindEntryLocations(%q) = %v; want %v", tt.key, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Package yamlutil reads YAML documents as node trees, which keep the positions of keys and values.
+package yamlutil
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+// Document is a parsed YAML document together with its lines
+type Document struct {
+	Root  *yaml.Node
+	Lines []string
+}
+
+// Parse parses the first document of YAML content. Empty content yields an empty mapping.
+func Parse(content []byte) (*Document, error) {
+	var file yaml.Node
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	if file.Kind == yaml.DocumentNode && len(file.Content) > 0 {
+		root = file.Content[0]
+	}
+	return &Document{Root: root, Lines: strings.Split(string(content), "\n")}, nil
+}
+
+// Pair is a key of a mapping with its value
+type Pair struct {
+	Key   *yaml.Node
+	Value *yaml.Node
+}
+
+// Pairs returns the key value pairs of a mapping node in document order, or nil for other nodes
+func Pairs(node *yaml.Node) []Pair {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	pairs := make([]Pair, 0, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		pairs = append(pairs, Pair{Key: node.Content[i], Value: resolveAlias(node.Content[i+1])})
+	}
+	return pairs
+}
+
+// Lookup returns the key and value of a mapping entry, or nils if the mapping has no such key
+func Lookup(node *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	for _, pair := range Pairs(node) {
+		if pair.Key.Value == key {
+			return pair.Key, pair.Value
+		}
+	}
+	return nil, nil
+}
+
+// Value returns the scalar value of a mapping entry, or "" if the entry is missing or not a scalar
+func Value(node *yaml.Node, key string) string {
+	_, value := Lookup(node, key)
+	if value == nil || value.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return value.Value
+}
+
+// resolveAlias follows an alias node to the node it refers to
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	if node != nil && node.Kind == yaml.AliasNode && node.Alias != nil {
+		return node.Alias
+	}
+	return node
+}
+
+// StripComment removes a trailing comment and trailing whitespace from a line.
+// A # starts a comment at the start of the line or after whitespace, outside of quoted strings.
+func StripComment(line string) string {
+	var quote rune
+	for i, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return strings.TrimRight(line[:i], " \t\r")
+		}
+	}
+	return strings.TrimRight(line, " \t\r")
+}
+
+// NodeLocation returns the location of a scalar node, including the quotes of quoted values
+func (d *Document) NodeLocation(node *yaml.Node) models.Location {
+	line, start := node.Line-1, node.Column-1
+	end := start + len(node.Value)
+	if node.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle) != 0 && line >= 0 && line < len(d.Lines) {
+		// Escapes make the source longer than the value, so the closing quote is searched for
+		text := d.Lines[line]
+		end = start + len(node.Value) + 2
+		if start < len(text) {
+			if closing := strings.IndexByte(text[start+1:], text[start]); closing >= 0 {
+				end = start + 1 + closing + 1
+			}
+		}
+	}
+	return models.Location{Line: line, StartIndex: start, EndIndex: end}
+}
+
+// EntryLocation returns the location of a mapping entry, from its key to the end of a value on the same line.
+// Entries whose value starts on a later line span their key and colon.
+func (d *Document) EntryLocation(key, value *yaml.Node) models.Location {
+	location := d.NodeLocation(key)
+	if value != nil && value.Line == key.Line && location.Line < len(d.Lines) {
+		location.EndIndex = len(StripComment(d.Lines[location.Line]))
+	} else if location.Line < len(d.Lines) {
+		location.EndIndex = min(location.EndIndex+1, len(d.Lines[location.Line]))
+	}
+	return location
+}
@@ -0,0 +1,75 @@
+package yamlutil
+
+import (
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/testdata"
+	"github.com/Checkmarx/manifest-parser/pkg/parser/models"
+)
+
+func TestDocumentLocations(t *testing.T) {
+	content := `defaults: &defaults
+  image: "nginx:1.25" # pinned
+services:
+  web:
+    <<: *defaults
+    ports: ['80:80']
+  db: *defaults
+`
+	doc, err := Parse([]byte(content))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	defaultsKey, defaults := Lookup(doc.Root, "defaults")
+	imageKey, image := Lookup(defaults, "image")
+	_, services := Lookup(doc.Root, "services")
+	webKey, web := Lookup(services, "web")
+	_, db := Lookup(services, "db")
+
+	testdata.CompareLocations(t,
+		[]models.Location{
+			doc.NodeLocation(image),
+			doc.EntryLocation(imageKey, image),
+			doc.EntryLocation(webKey, web),
+			doc.EntryLocation(defaultsKey, defaults),
+		},
+		[]models.Location{
+			{Line: 1, StartIndex: 9, EndIndex: 21},
+			{Line: 1, StartIndex: 2, EndIndex: 21},
+			{Line: 3, StartIndex: 2, EndIndex: 6},
+			{Line: 0, StartIndex: 0, EndIndex: 19},
+		})
+
+	if got := Value(db, "image"); got != "nginx:1.25" {
+		t.Errorf("Aliases must be resolved, got %q", got)
+	}
+	if got := len(Pairs(services)); got != 2 {
+		t.Errorf("Pairs: got %d pairs, want 2", got)
+	}
+}
+
+func TestStripComment(t *testing.T) {
+	tests := map[string]string{
+		"key: value # comment":     "key: value",
+		"url: http://host/#anchor": "url: http://host/#anchor",
+		`name: "a # b"   `:         `name: "a # b"`,
+		"# whole line":             "",
+		"tag: 'v1' #comment\r":     "tag: 'v1'",
+	}
+	for line, want := range tests {
+		if got := StripComment(line); got != want {
+			t.Errorf("StripComment(%q) = %q; want %q", line, got, want)
+		}
+	}
+}
+
+func TestParse_Empty(t *testing.T) {
+	doc, err := Parse(nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if Pairs(doc.Root) == nil && doc.Root == nil {
+		t.Errorf("Expected an empty mapping")
+	}
+}
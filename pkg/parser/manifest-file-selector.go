@@ -15,6 +15,54 @@ const (
 	DotnetPackagesConfig
 	MavenPom
 	GoMod
+	GradleVersionCatalog
+	GradleLockfile
+	DotnetPackagesLockJson
+	DotnetProjectAssetsJson
+	DotnetPaketDependencies
+	DotnetPaketLock
+	GoWork
+	GoSum
+	GoVendorModules
+	CargoToml
+	CargoLock
+	RubyGemfile
+	RubyGemfileLock
+	RubyGemspec
+	ComposerJson
+	ComposerLock
+	SwiftPackage
+	SwiftPackageResolved
+	CocoaPodsPodfile
+	CocoaPodsPodfileLock
+	PubspecYaml
+	PubspecLock
+	MixExs
+	MixLock
+	RebarConfig
+	RebarLock
+	VcpkgJson
+	ConanfileTxt
+	ConanfilePy
+	ConanLock
+	CondaEnvironment
+	CondaLock
+	DenoJson
+	DenoLock
+	BunLock
+	Dockerfile
+	DockerCompose
+	GitHubWorkflow
+	HelmChart
+	HelmChartLock
+	Terraform
+	TerraformLock
+	BazelModule
+	BazelMavenInstall
+	CycloneDXJson
+	CycloneDXXml
+	SpdxJson
+	SpdxTagValue
 )
 
 // selectManifestFile a method to select a manifest file type by its name
@@ -23,10 +71,26 @@ func selectManifestFile(manifest string) Manifest {
 	manifestFileName := filepath.Base(manifest)
 
 	manifestFileExtension := filepath.Ext(manifestFileName)
-	if manifestFileExtension == ".csproj" {
+	switch manifestFileExtension {
+	case ".csproj", ".fsproj", ".vbproj", ".vcxproj":
 		return DotnetCsproj
 	}
 
+	// Directory.Build.props and Directory.Build.targets may declare PackageReference items for every project below them
+	if manifestFileName == "Directory.Build.props" || manifestFileName == "Directory.Build.targets" {
+		return DotnetCsproj
+	}
+
+	if manifestFileName == "modules.txt" && filepath.Base(filepath.Dir(manifest)) == "vendor" {
+		return GoVendorModules
+	}
+
+	// Workflows may have any name, e.g. .github/workflows/compose.yml must not be read as a Compose file
+	if (manifestFileExtension == ".yml" || manifestFileExtension == ".yaml") &&
+		filepath.Base(filepath.Dir(manifest)) == "workflows" && filepath.Base(filepath.Dir(filepath.Dir(manifest))) == ".github" {
+		return GitHubWorkflow
+	}
+
 	if manifestFileExtension == ".txt" {
 		//check if file name starts with "requirement" or "packages"
 		if strings.HasPrefix(manifestFileName, "requirement") ||
@@ -51,9 +115,205 @@ func selectManifestFile(manifest string) Manifest {
 		return DotnetPackagesConfig
 	}
 
+	if manifestFileName == "packages.lock.json" {
+		return DotnetPackagesLockJson
+	}
+
+	if manifestFileName == "project.assets.json" {
+		return DotnetProjectAssetsJson
+	}
+
+	if manifestFileName == "paket.dependencies" {
+		return DotnetPaketDependencies
+	}
+
+	if manifestFileName == "paket.lock" {
+		return DotnetPaketLock
+	}
+
 	if manifestFileName == "go.mod" {
 		return GoMod
 	}
 
+	if manifestFileName == "go.work" {
+		return GoWork
+	}
+
+	if manifestFileName == "go.sum" {
+		return GoSum
+	}
+
+	if manifestFileName == "Cargo.toml" {
+		return CargoToml
+	}
+
+	if manifestFileName == "Cargo.lock" {
+		return CargoLock
+	}
+
+	if manifestFileName == "composer.json" {
+		return ComposerJson
+	}
+
+	if manifestFileName == "composer.lock" {
+		return ComposerLock
+	}
+
+	if manifestFileName == "Package.swift" {
+		return SwiftPackage
+	}
+
+	if manifestFileName == "Package.resolved" {
+		return SwiftPackageResolved
+	}
+
+	if manifestFileName == "Podfile" {
+		return CocoaPodsPodfile
+	}
+
+	if manifestFileName == "Podfile.lock" {
+		return CocoaPodsPodfileLock
+	}
+
+	if manifestFileName == "pubspec.yaml" {
+		return PubspecYaml
+	}
+
+	if manifestFileName == "pubspec.lock" {
+		return PubspecLock
+	}
+
+	if manifestFileName == "mix.exs" {
+		return MixExs
+	}
+
+	if manifestFileName == "mix.lock" {
+		return MixLock
+	}
+
+	if manifestFileName == "rebar.config" {
+		return RebarConfig
+	}
+
+	if manifestFileName == "rebar.lock" {
+		return RebarLock
+	}
+
+	if manifestFileName == "vcpkg.json" {
+		return VcpkgJson
+	}
+
+	if manifestFileName == "conanfile.txt" {
+		return ConanfileTxt
+	}
+
+	if manifestFileName == "conanfile.py" {
+		return ConanfilePy
+	}
+
+	if manifestFileName == "conan.lock" {
+		return ConanLock
+	}
+
+	if manifestFileName == "environment.yml" || manifestFileName == "environment.yaml" {
+		return CondaEnvironment
+	}
+
+	// conda-lock names lock files of additional environments <name>.conda-lock.yml
+	if manifestFileName == "conda-lock.yml" || strings.HasSuffix(manifestFileName, ".conda-lock.yml") {
+		return CondaLock
+	}
+
+	if manifestFileName == "deno.json" || manifestFileName == "deno.jsonc" {
+		return DenoJson
+	}
+
+	if manifestFileName == "deno.lock" {
+		return DenoLock
+	}
+
+	if manifestFileName == "bun.lock" {
+		return BunLock
+	}
+
+	// Dockerfiles may be named for their target, e.g. Dockerfile.dev or dev.Dockerfile
+	lowerFileName := strings.ToLower(manifestFileName)
+	for _, name := range []string{"dockerfile", "containerfile"} {
+		if lowerFileName == name || strings.HasPrefix(lowerFileName, name+".") || strings.HasSuffix(lowerFileName, "."+name) {
+			return Dockerfile
+		}
+	}
+
+	// Compose files may be split by environment, e.g. docker-compose.override.yml or compose.prod.yaml
+	if (strings.HasPrefix(manifestFileName, "docker-compose.") || strings.HasPrefix(manifestFileName, "compose.")) &&
+		(manifestFileExtension == ".yml" || manifestFileExtension == ".yaml") {
+		return DockerCompose
+	}
+
+	if manifestFileName == "Chart.yaml" {
+		return HelmChart
+	}
+
+	if manifestFileName == "Chart.lock" {
+		return HelmChartLock
+	}
+
+	if manifestFileName == ".terraform.lock.hcl" {
+		return TerraformLock
+	}
+
+	if manifestFileExtension == ".tf" {
+		return Terraform
+	}
+
+	if manifestFileName == "MODULE.bazel" {
+		return BazelModule
+	}
+
+	// Pin files of further maven.install repositories are usually named after them, e.g. android_maven_install.json
+	if manifestFileName == "maven_install.json" || strings.HasSuffix(manifestFileName, "_maven_install.json") {
+		return BazelMavenInstall
+	}
+
+	// SBOMs are recognized by the names their generators recommend, e.g. bom.json, app.cdx.json or app.spdx.json
+	if manifestFileName == "bom.json" || strings.HasSuffix(manifestFileName, ".cdx.json") || strings.HasSuffix(manifestFileName, ".cyclonedx.json") {
+		return CycloneDXJson
+	}
+
+	if manifestFileName == "bom.xml" || strings.HasSuffix(manifestFileName, ".cdx.xml") || strings.HasSuffix(manifestFileName, ".cyclonedx.xml") {
+		return CycloneDXXml
+	}
+
+	if strings.HasSuffix(manifestFileName, ".spdx.json") {
+		return SpdxJson
+	}
+
+	if manifestFileExtension == ".spdx" {
+		return SpdxTagValue
+	}
+
+	if manifestFileName == "Gemfile" || manifestFileName == "gems.rb" {
+		return RubyGemfile
+	}
+
+	if manifestFileName == "Gemfile.lock" || manifestFileName == "gems.locked" {
+		return RubyGemfileLock
+	}
+
+	if manifestFileExtension == ".gemspec" {
+		return RubyGemspec
+	}
+
+	if strings.HasSuffix(manifestFileName, ".versions.toml") {
+		return GradleVersionCatalog
+	}
+
+	// gradle.lockfile, buildscript-gradle.lockfile and legacy gradle/dependency-locks/*.lockfile
+	if manifestFileName == "gradle.lockfile" ||
+		strings.HasSuffix(manifestFileName, "-gradle.lockfile") ||
+		(manifestFileExtension == ".lockfile" && filepath.Base(filepath.Dir(manifest)) == "dependency-locks") {
+		return GradleLockfile
+	}
+
 	return -1
 }
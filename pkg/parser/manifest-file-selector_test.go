@@ -22,6 +22,16 @@ func TestManifestFileSelector_ExpectCsproj(t *testing.T) {
 	}
 }
 
+func TestManifestFileSelector_ExpectMSBuildProjects(t *testing.T) {
+	for _, manifest := range []string{"App.fsproj", "App.vbproj", "Native.vcxproj", "Directory.Build.props", "Directory.Build.targets"} {
+		got := selectManifestFile(manifest)
+		want := DotnetCsproj
+		if got != want {
+			t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+		}
+	}
+}
+
 func TestManifestFileSelector_ExpectPypiRequirements(t *testing.T) {
 	manifest := "requirement-dev.txt"
 	got := selectManifestFile(manifest)
@@ -66,3 +76,468 @@ func TestManifestFileSelector_ExpectGoMod(t *testing.T) {
 		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
 	}
 }
+
+func TestManifestFileSelector_ExpectGradleVersionCatalog(t *testing.T) {
+	manifest := "gradle/libs.versions.toml"
+	got := selectManifestFile(manifest)
+	want := GradleVersionCatalog
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectGradleLockfile(t *testing.T) {
+	for _, manifest := range []string{"gradle.lockfile", "buildscript-gradle.lockfile", "gradle/dependency-locks/compileClasspath.lockfile"} {
+		got := selectManifestFile(manifest)
+		want := GradleLockfile
+		if got != want {
+			t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+		}
+	}
+}
+
+func TestManifestFileSelector_ExpectDotnetPackagesLockJson(t *testing.T) {
+	manifest := "src/App/packages.lock.json"
+	got := selectManifestFile(manifest)
+	want := DotnetPackagesLockJson
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectDotnetProjectAssetsJson(t *testing.T) {
+	manifest := "src/App/obj/project.assets.json"
+	got := selectManifestFile(manifest)
+	want := DotnetProjectAssetsJson
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectDotnetPaketDependencies(t *testing.T) {
+	manifest := "paket.dependencies"
+	got := selectManifestFile(manifest)
+	want := DotnetPaketDependencies
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectDotnetPaketLock(t *testing.T) {
+	manifest := "paket.lock"
+	got := selectManifestFile(manifest)
+	want := DotnetPaketLock
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectGoWork(t *testing.T) {
+	manifest := "go.work"
+	got := selectManifestFile(manifest)
+	want := GoWork
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectGoSum(t *testing.T) {
+	manifest := "go.sum"
+	got := selectManifestFile(manifest)
+	want := GoSum
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectGoVendorModules(t *testing.T) {
+	manifest := "vendor/modules.txt"
+	got := selectManifestFile(manifest)
+	want := GoVendorModules
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectUnknown(t *testing.T) {
+	manifest := "README.md"
+	got := selectManifestFile(manifest)
+	if got != -1 {
+		t.Errorf("selectManifestFile(%q) = %v; want -1", manifest, got)
+	}
+}
+
+func TestManifestFileSelector_ExpectCargoToml(t *testing.T) {
+	manifest := "Cargo.toml"
+	got := selectManifestFile(manifest)
+	want := CargoToml
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectCargoLock(t *testing.T) {
+	manifest := "Cargo.lock"
+	got := selectManifestFile(manifest)
+	want := CargoLock
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectRubyGemfile(t *testing.T) {
+	manifest := "Gemfile"
+	got := selectManifestFile(manifest)
+	want := RubyGemfile
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectRubyGemfile_GemsRb(t *testing.T) {
+	manifest := "gems.rb"
+	got := selectManifestFile(manifest)
+	want := RubyGemfile
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectRubyGemfileLock(t *testing.T) {
+	manifest := "Gemfile.lock"
+	got := selectManifestFile(manifest)
+	want := RubyGemfileLock
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectRubyGemfileLock_GemsLocked(t *testing.T) {
+	manifest := "gems.locked"
+	got := selectManifestFile(manifest)
+	want := RubyGemfileLock
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectRubyGemspec(t *testing.T) {
+	manifest := "rails.gemspec"
+	got := selectManifestFile(manifest)
+	want := RubyGemspec
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectComposerJson(t *testing.T) {
+	manifest := "composer.json"
+	got := selectManifestFile(manifest)
+	want := ComposerJson
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectComposerLock(t *testing.T) {
+	manifest := "composer.lock"
+	got := selectManifestFile(manifest)
+	want := ComposerLock
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectSwiftPackage(t *testing.T) {
+	manifest := "Package.swift"
+	got := selectManifestFile(manifest)
+	want := SwiftPackage
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectSwiftPackageResolved(t *testing.T) {
+	manifest := "Package.resolved"
+	got := selectManifestFile(manifest)
+	want := SwiftPackageResolved
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectCocoaPodsPodfile(t *testing.T) {
+	manifest := "Podfile"
+	got := selectManifestFile(manifest)
+	want := CocoaPodsPodfile
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectCocoaPodsPodfileLock(t *testing.T) {
+	manifest := "Podfile.lock"
+	got := selectManifestFile(manifest)
+	want := CocoaPodsPodfileLock
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectPubspecYaml(t *testing.T) {
+	manifest := "pubspec.yaml"
+	got := selectManifestFile(manifest)
+	want := PubspecYaml
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectPubspecLock(t *testing.T) {
+	manifest := "pubspec.lock"
+	got := selectManifestFile(manifest)
+	want := PubspecLock
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectMixExs(t *testing.T) {
+	manifest := "mix.exs"
+	got := selectManifestFile(manifest)
+	want := MixExs
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectMixLock(t *testing.T) {
+	manifest := "mix.lock"
+	got := selectManifestFile(manifest)
+	want := MixLock
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectRebarConfig(t *testing.T) {
+	manifest := "rebar.config"
+	got := selectManifestFile(manifest)
+	want := RebarConfig
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectRebarLock(t *testing.T) {
+	manifest := "rebar.lock"
+	got := selectManifestFile(manifest)
+	want := RebarLock
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectVcpkgJson(t *testing.T) {
+	manifest := "vcpkg.json"
+	got := selectManifestFile(manifest)
+	want := VcpkgJson
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectConanfileTxt(t *testing.T) {
+	manifest := "conanfile.txt"
+	got := selectManifestFile(manifest)
+	want := ConanfileTxt
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectConanfilePy(t *testing.T) {
+	manifest := "conanfile.py"
+	got := selectManifestFile(manifest)
+	want := ConanfilePy
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectConanLock(t *testing.T) {
+	manifest := "conan.lock"
+	got := selectManifestFile(manifest)
+	want := ConanLock
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectCondaEnvironment(t *testing.T) {
+	manifest := "environment.yml"
+	got := selectManifestFile(manifest)
+	want := CondaEnvironment
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectCondaLock(t *testing.T) {
+	manifest := "conda-lock.yml"
+	got := selectManifestFile(manifest)
+	want := CondaLock
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectDenoJson(t *testing.T) {
+	manifest := "deno.jsonc"
+	got := selectManifestFile(manifest)
+	want := DenoJson
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectDenoLock(t *testing.T) {
+	manifest := "deno.lock"
+	got := selectManifestFile(manifest)
+	want := DenoLock
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectBunLock(t *testing.T) {
+	manifest := "bun.lock"
+	got := selectManifestFile(manifest)
+	want := BunLock
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectDockerfile(t *testing.T) {
+	for _, manifest := range []string{"Dockerfile", "build/api.Dockerfile", "Containerfile.dev"} {
+		got := selectManifestFile(manifest)
+		want := Dockerfile
+		if got != want {
+			t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+		}
+	}
+}
+
+func TestManifestFileSelector_ExpectDockerCompose(t *testing.T) {
+	for _, manifest := range []string{"docker-compose.yml", "compose.prod.yaml"} {
+		got := selectManifestFile(manifest)
+		want := DockerCompose
+		if got != want {
+			t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+		}
+	}
+}
+
+func TestManifestFileSelector_ExpectGitHubWorkflow(t *testing.T) {
+	manifest := "repo/.github/workflows/compose.yml"
+	got := selectManifestFile(manifest)
+	want := GitHubWorkflow
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectHelmChart(t *testing.T) {
+	manifest := "charts/app/Chart.yaml"
+	got := selectManifestFile(manifest)
+	want := HelmChart
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectHelmChartLock(t *testing.T) {
+	manifest := "charts/app/Chart.lock"
+	got := selectManifestFile(manifest)
+	want := HelmChartLock
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectTerraform(t *testing.T) {
+	for _, manifest := range []string{"main.tf", "infra/versions.tf"} {
+		got := selectManifestFile(manifest)
+		want := Terraform
+		if got != want {
+			t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+		}
+	}
+}
+
+func TestManifestFileSelector_ExpectTerraformLock(t *testing.T) {
+	manifest := "infra/.terraform.lock.hcl"
+	got := selectManifestFile(manifest)
+	want := TerraformLock
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectBazelModule(t *testing.T) {
+	manifest := "repo/MODULE.bazel"
+	got := selectManifestFile(manifest)
+	want := BazelModule
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectBazelMavenInstall(t *testing.T) {
+	for _, manifest := range []string{"maven_install.json", "repo/android_maven_install.json"} {
+		got := selectManifestFile(manifest)
+		want := BazelMavenInstall
+		if got != want {
+			t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+		}
+	}
+}
+
+func TestManifestFileSelector_ExpectCycloneDXJson(t *testing.T) {
+	for _, manifest := range []string{"bom.json", "sboms/app.cdx.json", "app.cyclonedx.json"} {
+		got := selectManifestFile(manifest)
+		want := CycloneDXJson
+		if got != want {
+			t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+		}
+	}
+}
+
+func TestManifestFileSelector_ExpectCycloneDXXml(t *testing.T) {
+	for _, manifest := range []string{"bom.xml", "sboms/app.cdx.xml"} {
+		got := selectManifestFile(manifest)
+		want := CycloneDXXml
+		if got != want {
+			t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+		}
+	}
+}
+
+func TestManifestFileSelector_ExpectSpdxJson(t *testing.T) {
+	manifest := "sboms/app.spdx.json"
+	got := selectManifestFile(manifest)
+	want := SpdxJson
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
+
+func TestManifestFileSelector_ExpectSpdxTagValue(t *testing.T) {
+	manifest := "sboms/app.spdx"
+	got := selectManifestFile(manifest)
+	want := SpdxTagValue
+	if got != want {
+		t.Errorf("selectManifestFile(%q) = %v; want %v", manifest, got, want)
+	}
+}
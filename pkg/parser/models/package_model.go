@@ -4,6 +4,8 @@ type Location struct {
 	Line       int
 	StartIndex int
 	EndIndex   int
+	// FilePath is set when the location is in a different file than the package's FilePath
+	FilePath string `json:",omitempty"`
 }
 
 type Package struct {
@@ -12,4 +14,14 @@ type Package struct {
 	Version        string
 	FilePath       string
 	Locations      []Location
+	// Scopes lists the configurations or dependency groups the package belongs to
+	Scopes []string `json:",omitempty"`
+	// Transitive is set for packages that are not declared directly by the project
+	Transitive bool `json:",omitempty"`
+	// Hashes holds integrity hashes of the resolved package, e.g. "sha512-..." or "h1:..."
+	Hashes []string `json:",omitempty"`
+	// Dependencies lists the names of the packages this package depends on, when the manifest records them
+	Dependencies []string `json:",omitempty"`
+	// Metadata holds ecosystem specific details that have no dedicated field
+	Metadata map[string]string `json:",omitempty"`
 }
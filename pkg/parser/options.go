@@ -0,0 +1,26 @@
+package parser
+
+// options holds the settings that parsers evaluating build logic need, e.g. the MSBuild conditions of .NET projects
+type options struct {
+	targetFramework string
+	configuration   string
+}
+
+// Option configures the parsers returned by ParsersFactory
+type Option func(*options)
+
+// WithTargetFramework selects the .NET target framework, e.g. "net48", that MSBuild conditions of project and
+// Directory.Packages.props files are evaluated for
+func WithTargetFramework(targetFramework string) Option {
+	return func(o *options) {
+		o.targetFramework = targetFramework
+	}
+}
+
+// WithConfiguration selects the .NET build configuration, e.g. "Release", that MSBuild conditions of project and
+// Directory.Packages.props files are evaluated for
+func WithConfiguration(configuration string) Option {
+	return func(o *options) {
+		o.configuration = configuration
+	}
+}
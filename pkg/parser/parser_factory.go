@@ -1,23 +1,48 @@
 package parser
 
 import (
+	"github.com/Checkmarx/manifest-parser/internal/parsers/actions"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/bazel"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/bun"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/cargo"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/cocoapods"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/composer"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/conan"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/conda"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/deno"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/docker"
 	"github.com/Checkmarx/manifest-parser/internal/parsers/dotnet"
 	"github.com/Checkmarx/manifest-parser/internal/parsers/golang"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/gradle"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/helm"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/hex"
 	"github.com/Checkmarx/manifest-parser/internal/parsers/maven"
 	"github.com/Checkmarx/manifest-parser/internal/parsers/npm"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/pub"
 	"github.com/Checkmarx/manifest-parser/internal/parsers/pypi"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/ruby"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/sbom"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/swift"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/terraform"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/vcpkg"
 )
 
-func ParsersFactory(manifest string) Parser {
+// ParsersFactory returns the parser for a manifest file by its name, or nil if the manifest is not supported
+func ParsersFactory(manifest string, opts ...Option) Parser {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	manifestType := selectManifestFile(manifest)
 
 	switch manifestType {
 	case MavenPom:
 		return &maven.MavenPomParser{}
 	case DotnetCsproj:
-		return &dotnet.DotnetCsprojParser{}
+		return &dotnet.DotnetCsprojParser{TargetFramework: o.targetFramework, Configuration: o.configuration}
 	case DotnetDirectoryPackagesProps:
-		return &dotnet.DotnetDirectoryPackagesPropsParser{}
+		return &dotnet.DotnetDirectoryPackagesPropsParser{TargetFramework: o.targetFramework, Configuration: o.configuration}
 	case PypiRequirements:
 		return &pypi.PypiParser{}
 	case NpmPackageJson:
@@ -26,7 +51,112 @@ func ParsersFactory(manifest string) Parser {
 		return &dotnet.DotnetPackagesConfigParser{}
 	case GoMod:
 		return &golang.GoModParser{}
+	case GoWork:
+		return &golang.GoWorkParser{}
+	case GoSum:
+		return &golang.GoSumParser{}
+	case GoVendorModules:
+		return &golang.GoVendorModulesParser{}
+	case DotnetPackagesLockJson:
+		return &dotnet.DotnetPackagesLockParser{}
+	case DotnetProjectAssetsJson:
+		return &dotnet.DotnetProjectAssetsParser{}
+	case DotnetPaketDependencies:
+		return &dotnet.DotnetPaketDependenciesParser{}
+	case DotnetPaketLock:
+		return &dotnet.DotnetPaketLockParser{}
+	case GradleVersionCatalog:
+		return &gradle.GradleVersionCatalogParser{}
+	case GradleLockfile:
+		return &gradle.GradleLockfileParser{}
+	case CargoToml:
+		return &cargo.CargoTomlParser{}
+	case CargoLock:
+		return &cargo.CargoLockParser{}
+	case RubyGemfile:
+		return &ruby.RubyGemfileParser{}
+	case RubyGemfileLock:
+		return &ruby.RubyGemfileLockParser{}
+	case RubyGemspec:
+		return &ruby.RubyGemspecParser{}
+	case ComposerJson:
+		return &composer.ComposerJsonParser{}
+	case ComposerLock:
+		return &composer.ComposerLockParser{}
+	case SwiftPackage:
+		return &swift.SwiftPackageParser{}
+	case SwiftPackageResolved:
+		return &swift.SwiftPackageResolvedParser{}
+	case CocoaPodsPodfile:
+		return &cocoapods.CocoaPodsPodfileParser{}
+	case CocoaPodsPodfileLock:
+		return &cocoapods.CocoaPodsPodfileLockParser{}
+	case PubspecYaml:
+		return &pub.PubspecYamlParser{}
+	case PubspecLock:
+		return &pub.PubspecLockParser{}
+	case MixExs:
+		return &hex.MixExsParser{}
+	case MixLock:
+		return &hex.MixLockParser{}
+	case RebarConfig:
+		return &hex.RebarConfigParser{}
+	case RebarLock:
+		return &hex.RebarLockParser{}
+	case VcpkgJson:
+		return &vcpkg.VcpkgJsonParser{}
+	case ConanfileTxt:
+		return &conan.ConanfileTxtParser{}
+	case ConanfilePy:
+		return &conan.ConanfilePyParser{}
+	case ConanLock:
+		return &conan.ConanLockParser{}
+	case CondaEnvironment:
+		return &conda.CondaEnvironmentParser{}
+	case CondaLock:
+		return &conda.CondaLockParser{}
+	case DenoJson:
+		return &deno.DenoJsonParser{}
+	case DenoLock:
+		return &deno.DenoLockParser{}
+	case BunLock:
+		return &bun.BunLockParser{}
+	case Dockerfile:
+		return &docker.DockerfileParser{}
+	case DockerCompose:
+		return &docker.ComposeParser{}
+	case GitHubWorkflow:
+		return &actions.WorkflowParser{}
+	case HelmChart:
+		return &helm.ChartYamlParser{}
+	case HelmChartLock:
+		return &helm.ChartLockParser{}
+	case Terraform:
+		return &terraform.TerraformParser{}
+	case TerraformLock:
+		return &terraform.TerraformLockParser{}
+	case BazelModule:
+		return &bazel.ModuleBazelParser{}
+	case BazelMavenInstall:
+		return &bazel.MavenInstallParser{}
+	case CycloneDXJson:
+		return &sbom.CycloneDXJsonParser{}
+	case CycloneDXXml:
+		return &sbom.CycloneDXXmlParser{}
+	case SpdxJson:
+		return &sbom.SpdxJsonParser{}
+	case SpdxTagValue:
+		return &sbom.SpdxTagValueParser{}
 	default:
 		return nil
 	}
 }
+
+// BinaryParsersFactory returns the parser for a compiled executable, or nil if the file is not a supported binary.
+// Executables have no well known name, so unlike ParsersFactory it reads the file to recognize them.
+func BinaryParsersFactory(file string) Parser {
+	if golang.IsGoBinary(file) {
+		return &golang.GoBinaryParser{}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package parser
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Checkmarx/manifest-parser/internal/parsers/dotnet"
+	"github.com/Checkmarx/manifest-parser/internal/parsers/golang"
+)
+
+func TestParsersFactory_UnsupportedManifest(t *testing.T) {
+	if p := ParsersFactory("notes.txt"); p != nil {
+		t.Errorf("ParsersFactory(%q) = %T; want nil", "notes.txt", p)
+	}
+}
+
+func TestParsersFactory_DotnetOptions(t *testing.T) {
+	opts := []Option{WithTargetFramework("net48"), WithConfiguration("Release")}
+
+	csproj, ok := ParsersFactory("App.csproj", opts...).(*dotnet.DotnetCsprojParser)
+	if !ok {
+		t.Fatalf("ParsersFactory(%q) did not return a csproj parser", "App.csproj")
+	}
+	if csproj.TargetFramework != "net48" || csproj.Configuration != "Release" {
+		t.Errorf("csproj parser TargetFramework = %q, Configuration = %q; want net48, Release", csproj.TargetFramework, csproj.Configuration)
+	}
+
+	props, ok := ParsersFactory("Directory.Packages.props", opts...).(*dotnet.DotnetDirectoryPackagesPropsParser)
+	if !ok {
+		t.Fatalf("ParsersFactory(%q) did not return a Directory.Packages.props parser", "Directory.Packages.props")
+	}
+	if props.TargetFramework != "net48" || props.Configuration != "Release" {
+		t.Errorf("props parser TargetFramework = %q, Configuration = %q; want net48, Release", props.TargetFramework, props.Configuration)
+	}
+
+	if csproj, ok := ParsersFactory("App.csproj").(*dotnet.DotnetCsprojParser); !ok || csproj.TargetFramework != "" || csproj.Configuration != "" {
+		t.Errorf("ParsersFactory(%q) without options = %+v; want a zero-valued csproj parser", "App.csproj", csproj)
+	}
+}
+
+func TestBinaryParsersFactory_ExpectGoBinary(t *testing.T) {
+	// The test binary is a Go executable with embedded build information
+	binary, err := os.Executable()
+	if err != nil {
+		t.Skipf("Test executable not available: %v", err)
+	}
+	if _, ok := BinaryParsersFactory(binary).(*golang.GoBinaryParser); !ok {
+		t.Errorf("BinaryParsersFactory(%q) did not return a Go binary parser", binary)
+	}
+	if p := ParsersFactory(binary); p != nil {
+		t.Errorf("ParsersFactory(%q) = %T; want nil, binaries are not selected by name", binary, p)
+	}
+}
+
+func TestBinaryParsersFactory_NotABinary(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "app")
+	if err := os.WriteFile(file, []byte("#!/bin/sh\necho hello\n"), 0755); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	if p := BinaryParsersFactory(file); p != nil {
+		t.Errorf("BinaryParsersFactory(%q) = %T; want nil", file, p)
+	}
+}